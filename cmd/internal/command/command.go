@@ -13,8 +13,12 @@ import (
 	"github.com/frk/valid/cmd/internal/config"
 	"github.com/frk/valid/cmd/internal/generator"
 	"github.com/frk/valid/cmd/internal/global"
+	"github.com/frk/valid/cmd/internal/jsonschema"
+	"github.com/frk/valid/cmd/internal/openapi"
+	"github.com/frk/valid/cmd/internal/postgres"
 	"github.com/frk/valid/cmd/internal/rules"
 	"github.com/frk/valid/cmd/internal/search"
+	"github.com/frk/valid/cmd/internal/zod"
 )
 
 type Command struct {
@@ -51,13 +55,18 @@ func parseFlags(c *config.Config, fs *flag.FlagSet, osArgs []string) error {
 	fs.Var(&c.FileList, "f", "")
 	fs.Var(&c.FilePatternList, "rx", "")
 	fs.Var(&c.OutNameFormat, "o", "")
+	fs.Var(&c.Partial, "partial", "")
+	fs.Var(&c.Output, "output", "")
+	fs.Var(&c.DBTag, "db.tag", "")
 
 	fs.Var(&c.ErrorHandling.FieldKey.Tag, "fk.tag", "")
 	fs.Var(&c.ErrorHandling.FieldKey.Join, "fk.join", "")
 	fs.Var(&c.ErrorHandling.FieldKey.Separator, "fk.sep", "")
+	fs.Var(&c.ErrorHandling.FieldKey.IndexStyle, "fk.index", "")
 
 	fs.Var(&c.ErrorHandling.Constructor, "error.constructor", "")
 	fs.Var(&c.ErrorHandling.Aggregator, "error.aggregator", "")
+	fs.Var(&c.ErrorHandling.FieldErrors, "error.field_errors", "")
 
 	if err := fs.Parse(osArgs); err != nil {
 		return err
@@ -91,12 +100,10 @@ func (cmd *Command) Run() error {
 
 	result := make([][]*outFile, len(pkgs))
 	for i, pkg := range pkgs {
-		outFiles := make([]*outFile, len(pkg.Files))
-
-		for j, file := range pkg.Files {
-			out := new(outFile)
-			out.path = cmd.outFilePath(file.Path)
+		var outFiles []*outFile
+		var pkgInfos []*rules.Info
 
+		for _, file := range pkg.Files {
 			infos := make([]*rules.Info, len(file.Matches))
 			for k, match := range file.Matches {
 				// 4. rule-check the matched validator structs
@@ -109,14 +116,52 @@ func (cmd *Command) Run() error {
 				infos[k] = info
 			}
 
-			// 5. generate code
-			code, err := generator.Generate(pkg.Pkg(), infos)
-			if err != nil {
-				return err
+			// 5. generate output
+			switch cmd.Cfg.Output.Value {
+			case "go":
+				out := new(outFile)
+				out.path = cmd.outFilePath(file.Path)
+				if out.code, err = generator.Generate(pkg.Pkg(), infos); err != nil {
+					return err
+				}
+				outFiles = append(outFiles, out)
+			case "jsonschema":
+				for _, info := range infos {
+					out := new(outFile)
+					out.path = schemaFilePath(file.Path, info)
+					if out.code, err = jsonschema.Generate(info); err != nil {
+						return err
+					}
+					outFiles = append(outFiles, out)
+				}
+			case "openapi":
+				// the package's schemas are generated into
+				// a single file once all files are checked
+				pkgInfos = append(pkgInfos, infos...)
+			case "zod":
+				out := new(outFile)
+				out.path = zodFilePath(file.Path)
+				if out.code, err = zod.Generate(infos); err != nil {
+					return err
+				}
+				outFiles = append(outFiles, out)
+			case "postgres":
+				out := new(outFile)
+				out.path = sqlFilePath(file.Path)
+				if out.code, err = postgres.Generate(infos, cmd.Cfg.DBTag.Value); err != nil {
+					return err
+				}
+				outFiles = append(outFiles, out)
 			}
+		}
 
-			out.code = code
-			outFiles[j] = out
+		if len(pkgInfos) > 0 {
+			out := new(outFile)
+			out.path = openapiFilePath(pkg)
+			if out.code, err = openapi.Generate(pkgInfos); err != nil {
+				return err
+			}
+			outFiles = append(outFiles, out)
 		}
 		result[i] = outFiles
 	}
@@ -148,6 +193,34 @@ func (cmd *Command) outFilePath(inFilePath string) string {
 	return filepath.Join(dir, name)
 }
 
+// schemaFilePath returns the path of the JSON Schema file of the validator
+// of the given info, the file is placed next to the validator's source file.
+func schemaFilePath(inFilePath string, info *rules.Info) string {
+	name := info.Validator.Type.Name + ".schema.json"
+	return filepath.Join(filepath.Dir(inFilePath), name)
+}
+
+// openapiFilePath returns the path of the OpenAPI file of
+// the given package, the file is placed in the package's directory.
+func openapiFilePath(pkg *search.Package) string {
+	name := pkg.Name + ".openapi.yaml"
+	return filepath.Join(filepath.Dir(pkg.Files[0].Path), name)
+}
+
+// zodFilePath returns the path of the TypeScript file of the given
+// input file, the file is placed next to the input file.
+func zodFilePath(inFilePath string) string {
+	name := strings.TrimSuffix(filepath.Base(inFilePath), ".go") + ".zod.ts"
+	return filepath.Join(filepath.Dir(inFilePath), name)
+}
+
+// sqlFilePath returns the path of the SQL file of the given
+// input file, the file is placed next to the input file.
+func sqlFilePath(inFilePath string) string {
+	name := strings.TrimSuffix(filepath.Base(inFilePath), ".go") + ".sql"
+	return filepath.Join(filepath.Dir(inFilePath), name)
+}
+
 type outFile struct {
 	// absolute path of the output file
 	path string
@@ -168,9 +241,11 @@ func (cmd *Command) writeOutFile(out *outFile) (err error) {
 	}()
 
 	// make it look pretty
-	bs, err := format.Source(out.code)
-	if err != nil {
-		return err
+	bs := out.code
+	if strings.HasSuffix(out.path, ".go") {
+		if bs, err = format.Source(out.code); err != nil {
+			return err
+		}
 	}
 
 	buf := bytes.NewBuffer(bs)
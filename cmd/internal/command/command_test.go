@@ -29,11 +29,15 @@ func Test_parseFlags(t *testing.T) {
 			`-rx=^\/path\/to\/my\/\w+_foo.go$`,
 			`-rx`, `^\/path\/to\/my\/\w+_bar.go$`,
 			`-o`, `%_out.go`,
+			`-partial`,
+			`-db.tag`, `pg`,
 			`-fk.tag`, `json`,
 			`-fk.join`,
 			`-fk.sep=.`,
+			`-fk.index`, `pointer`,
 			`-error.constructor`, `example.com/me/mymod/mypkg.NewError`,
 			`-error.aggregator`, `example.com/me/mymod/mypkg.MyErrorAggregator`,
+			`-error.field_errors`,
 		},
 		want: config.Config{
 			File:      config.String{Value: "/path/to/my/config", IsSet: true},
@@ -54,11 +58,14 @@ func Test_parseFlags(t *testing.T) {
 				IsSet: true,
 			},
 			OutNameFormat: config.String{Value: "%_out.go", IsSet: true},
+			Partial:       config.Bool{Value: true, IsSet: true},
+			DBTag:         config.String{Value: "pg", IsSet: true},
 			ErrorHandling: config.ErrorHandlingConfig{
 				FieldKey: config.FieldKeyConfig{
-					Tag:       config.String{Value: "json", IsSet: true},
-					Join:      config.Bool{Value: true, IsSet: true},
-					Separator: config.String{Value: ".", IsSet: true},
+					Tag:        config.String{Value: "json", IsSet: true},
+					Join:       config.Bool{Value: true, IsSet: true},
+					Separator:  config.String{Value: ".", IsSet: true},
+					IndexStyle: config.String{Value: "pointer", IsSet: true},
 				},
 				Constructor: config.ObjectIdent{
 					Pkg:   "example.com/me/mymod/mypkg",
@@ -70,6 +77,7 @@ func Test_parseFlags(t *testing.T) {
 					Name:  "MyErrorAggregator",
 					IsSet: true,
 				},
+				FieldErrors: config.Bool{Value: true, IsSet: true},
 			},
 		},
 	}, {
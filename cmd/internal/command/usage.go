@@ -9,7 +9,8 @@ func printUsage() {
 	fmt.Fprint(os.Stderr, usage)
 }
 
-const usage = `usage: valid [-c] [-wd] [-r] [-f] [-rx] [-o] [-fk.tag] [-fk.join] [-fk.sep]
+const usage = `usage: valid [-c] [-wd] [-r] [-f] [-rx] [-o] [-partial] [-output] [-db.tag] [-fk.tag] [-fk.join]
+             [-fk.sep] [-fk.index] [-error.constructor] [-error.aggregator] [-error.field_errors]
 
 validgen generates validation code for Go structs.
 
@@ -46,6 +47,31 @@ name will be prefixed to the format.
 If left unspecified, the format "%_valid.go" will be used by default.
 
 
+The -partial flag if set to true, instructs the generator to produce, for each validation
+method, an additional "partial" method, e.g. ValidatePartial(present func(key string) bool),
+that validates only those fields whose keys are reported as present by the given function.
+If left unspecified, the value false will be used by default.
+
+
+The -output flag specifies the kind of output that the tool should generate. The value
+"go" produces the Go validation code, one file per input file, named according to the
+-o flag. The value "jsonschema" produces a JSON Schema (draft 2020-12) document for each
+validator type, in a file named "<ValidatorType>.schema.json" that is placed next to the
+input file. The value "openapi" produces, for each package, an OpenAPI 3.1 YAML document
+named "<package>.openapi.yaml" whose "components.schemas" section contains the schemas of
+the package's validator types. The value "zod" produces, for each input file, a TypeScript
+module named "<file>.zod.ts" that declares the equivalent Zod schemas of the file's validator
+types. The value "postgres" produces, for each input file, a PostgreSQL script named
+"<file>.sql" that adds the equivalent CHECK and NOT NULL constraints to the tables of the
+file's validator types. If left unspecified, the value "go" will be used by default.
+
+
+The -db.tag flag specifies the struct tag to be used by the "postgres" output for mapping
+fields to columns, and validator types to tables, the latter by the tag of a blank field,
+e.g. a field named "_" with the tag db:"users". If left unspecified, the value "db" will
+be used by default.
+
+
 The -fk.tag flag if set to a non-empty string, specifies the struct tag to be used
 for constructing the field keys that will be used by the generator for error reporting.
 A valid tag must begin with a letter (A-z) or an underscore (_), subsequent characters
@@ -65,6 +91,14 @@ when producing the field keys. The separator can be at most one byte long.
 If left unspecified, the separator "." will be used by default.
 
 
+The -fk.index flag specifies whether and how the generated code should include the
+indexes of slice and array elements, and the keys of map values, in the field keys.
+The value "dot" joins the index using the separator (e.g. "emails.3"), the value
+"bracket" encloses the index in brackets (e.g. "emails[3]"), and the value "pointer"
+produces field keys in the JSON Pointer format (e.g. "/emails/3"). If left unspecified,
+the value "none" will be used by default and the field keys will not include indexes.
+
+
 The -error.constructor flag specifies the custom error constructor function that
 the generated code should use to handle errors. The value must be a package-path
 qualified identifier, e.g. "github.com/me/mod/pkg.NewError".
@@ -83,4 +117,11 @@ The type MUST implement the following interface:
          Out() error
      }
 
+
+The -error.field_errors flag if set to true, and if neither the -error.constructor
+nor the -error.aggregator flag is specified, instructs the generator to produce code
+that reports every failed validation as a *valid.FieldError value and that returns
+all of them together as a valid.FieldErrors list. If left unspecified, the value false
+will be used by default.
+
 ` //`
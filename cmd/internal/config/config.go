@@ -46,8 +46,50 @@ type Config struct {
 	ValidatorNamePattern String `yaml:"validator_name_pattern"`
 	// Configures the code generation of the handling of validation errors.
 	ErrorHandling ErrorHandlingConfig `yaml:"error_handling"`
+	// If set to true, the tool will generate, for each validation method,
+	// an additional "partial" method, e.g. ValidatePartial, that validates
+	// only those fields whose keys are reported as present by the method's
+	// argument. The method's signature will be the following:
+	//
+	//     func(present func(key string) bool) error
+	//
+	// If not provided, `false` will be used by default.
+	Partial Bool `yaml:"partial"`
+	// Specifies the kind of output that the tool should generate for
+	// the matched validator types. The valid values are:
+	//
+	//   - "go": a Go source file with the validation methods, for each
+	//     source file, named according to the OutNameFormat setting.
+	//   - "jsonschema": a JSON Schema (draft 2020-12) document, for each
+	//     validator type, named "<ValidatorType>.schema.json".
+	//   - "openapi": an OpenAPI 3.1 "components.schemas" YAML document,
+	//     for each package, named "<package>.openapi.yaml".
+	//   - "zod": a TypeScript module with the equivalent Zod schemas,
+	//     for each source file, named "<file>.zod.ts".
+	//   - "postgres": a PostgreSQL script with the equivalent CHECK
+	//     constraints and domains, for each source file, named "<file>.sql".
+	//
+	// If not provided, "go" will be used by default.
+	Output String `yaml:"output"`
+	// The struct tag to be used by the "postgres" output for mapping
+	// validator types to tables and fields to columns. A validator's
+	// table is specified by the tag of a blank field, e.g.
+	//
+	//     _ struct{} `db:"users"`
+	//
+	// The tag MUST be a valid Go identifier. If not provided,
+	// "db" will be used by default.
+	DBTag String `yaml:"db_tag"`
 	// List of custom rules to be made available to the tool.
 	Rules []RuleConfig `yaml:"rules"`
+	// List of the names of validation groups that can be used in struct
+	// tags to restrict rules to a specific group, e.g. `is:"required@create"`.
+	// For each group referenced by a validator struct the tool will generate
+	// a separate method, e.g. ValidateCreate.
+	//
+	// A valid group name must begin with a letter (A-z), subsequent
+	// characters in the name can be letters, underscores, and digits (0-9).
+	Groups []string `yaml:"groups"`
 
 	// The compiled expressions of the FilePatternList slice.
 	fileRegexpList []*regexp.Regexp
@@ -80,6 +122,13 @@ type ErrorHandlingConfig struct {
 	//     }
 	//
 	Aggregator ObjectIdent `yaml:"aggregator"`
+	// If set to true, and neither a constructor nor an aggregator is
+	// provided, the generated code will report every failed validation
+	// as a *valid.FieldError value and aggregate them into a list of type
+	// valid.FieldErrors which will then be returned as the error.
+	//
+	// If not provided, `false` will be used by default.
+	FieldErrors Bool `yaml:"field_errors"`
 }
 
 type FieldKeyConfig struct {
@@ -107,12 +156,36 @@ type FieldKeyConfig struct {
 	//
 	// If not provided, the separator "." will be used by default.
 	Separator String `yaml:"separator"`
+	// Specifies whether and how the generated code should include the
+	// indexes of slice and array elements, and the keys of map values,
+	// in the field keys. The valid values are:
+	//
+	//   - "none": the field keys are static, e.g. "emails"
+	//   - "dot": the index is joined with the separator, e.g. "emails.3"
+	//   - "bracket": the index is enclosed in brackets, e.g. "emails[3]"
+	//   - "pointer": the field keys are JSON Pointers, e.g. "/emails/3"
+	//
+	// If not provided, "none" will be used by default.
+	IndexStyle String `yaml:"index_style"`
 }
 
 type RuleConfig struct {
 	// The function's name qualified with
 	// the path of the function's package.
+	//
+	// Func MUST be omitted if Dep is provided.
 	Func ObjectIdent `yaml:"func"`
+	// The name of a dependency type qualified with the path of the
+	// type's package. If provided, the rule's function is the method
+	// of the dependency type that is identified by the Method field.
+	//
+	// The generated code will invoke the method on a field of the
+	// validator struct whose type is the dependency type, or, if there
+	// is no such field, on a field of that type of the validator's
+	// "Deps" struct field.
+	Dep ObjectIdent `yaml:"dep"`
+	// The name of the dependency type's method. Required if Dep is provided.
+	Method string `yaml:"method"`
 	// The spec for the function's rule. Optional if
 	// the func's doc already has a valid config.
 	Rule *RuleSpec `yaml:"rule"`
@@ -190,6 +263,10 @@ type RuleErrorConfig struct {
 	// If true the generated error message
 	// will include the rule's arguments.
 	WithArgs bool `yaml:"with_args,omitempty"`
+	// If true the generated error message will include the
+	// values of those of the rule's arguments that reference
+	// other fields, regardless of the WithArgs setting.
+	WithFieldArgs bool `yaml:"with_field_args,omitempty"`
 	// The separator used to join the rule's
 	// arguments for the error message.
 	ArgSep string `yaml:"arg_sep,omitempty"`
@@ -221,6 +298,12 @@ func (c *Config) MergeAndCheck() error {
 	if !c.ValidatorNamePattern.IsSet {
 		c.ValidatorNamePattern.Value = dc.ValidatorNamePattern.Value
 	}
+	if !c.Output.IsSet {
+		c.Output.Value = dc.Output.Value
+	}
+	if !c.DBTag.IsSet {
+		c.DBTag.Value = dc.DBTag.Value
+	}
 	if !c.ErrorHandling.FieldKey.Tag.IsSet {
 		c.ErrorHandling.FieldKey.Tag.Value = dc.ErrorHandling.FieldKey.Tag.Value
 	}
@@ -230,6 +313,9 @@ func (c *Config) MergeAndCheck() error {
 	if !c.ErrorHandling.FieldKey.Separator.IsSet {
 		c.ErrorHandling.FieldKey.Separator.Value = dc.ErrorHandling.FieldKey.Separator.Value
 	}
+	if !c.ErrorHandling.FieldKey.IndexStyle.IsSet {
+		c.ErrorHandling.FieldKey.IndexStyle.Value = dc.ErrorHandling.FieldKey.IndexStyle.Value
+	}
 
 	return c.normalizeAndCheck()
 }
@@ -273,6 +359,9 @@ func (c *Config) resolveFile() error {
 
 var rxFKTag = regexp.MustCompile(`^(?:[A-Za-z_]\w*)?$`)
 
+var rxDBTag = regexp.MustCompile(`^[A-Za-z_]\w*$`)
+var rxGroup = regexp.MustCompile(`^[A-Za-z]\w*$`)
+
 func (c *Config) normalizeAndCheck() (err error) {
 	// update wd in case it wasn't abs
 	wd, err := filepath.Abs(c.WorkDir.Value)
@@ -327,6 +416,19 @@ func (c *Config) normalizeAndCheck() (err error) {
 			file: c.File.Value, key: "out_name_format", val: c.OutNameFormat.Value}
 	}
 
+	// check the output kind
+	switch val := c.Output.Value; val {
+	case "go", "jsonschema", "openapi", "zod", "postgres":
+		// ok
+	default:
+		return &Error{C: ERR_OUTPUT, dir: c.WorkDir.Value,
+			file: c.File.Value, key: "output", val: val}
+	}
+	if val := c.DBTag.Value; !rxDBTag.MatchString(val) {
+		return &Error{C: ERR_DB_TAG, dir: c.WorkDir.Value,
+			file: c.File.Value, key: "db_tag", val: val}
+	}
+
 	// compile validator name regexp
 	expr := c.ValidatorNamePattern.Value
 	rx, err := regexp.Compile(expr)
@@ -348,15 +450,35 @@ func (c *Config) normalizeAndCheck() (err error) {
 			file: c.File.Value, key: "field_key.separator",
 			val: val, err: err}
 	}
+	switch val := c.ErrorHandling.FieldKey.IndexStyle.Value; val {
+	case "none", "dot", "bracket", "pointer":
+		// ok
+	default:
+		return &Error{C: ERR_FKEY_INDEX, dir: c.WorkDir.Value,
+			file: c.File.Value, key: "field_key.index_style",
+			val: val, err: err}
+	}
 
 	// check custom rules
 	seen := make(map[string]bool) // to ensure uniqueness
 	for i, rc := range c.Rules {
-		if rc.Func.Name == "" {
+		if rc.Dep.Name != "" {
+			if rc.Func.Name != "" {
+				return &Error{C: ERR_RULE_FUNCDEP, dir: c.WorkDir.Value,
+					file: c.File.Value, val: strconv.Itoa(i)}
+			}
+			if rc.Method == "" {
+				return &Error{C: ERR_RULE_NOMETHOD, dir: c.WorkDir.Value,
+					file: c.File.Value, val: strconv.Itoa(i)}
+			}
+		} else if rc.Func.Name == "" {
 			return &Error{C: ERR_RULE_NOFUNC, dir: c.WorkDir.Value,
 				file: c.File.Value, val: strconv.Itoa(i)}
 		}
 		ruleKey := rc.Func.String()
+		if rc.Dep.Name != "" {
+			ruleKey = rc.Dep.String() + "." + rc.Method
+		}
 
 		if rc.Rule != nil {
 			if rc.Rule.Name == "" {
@@ -374,6 +496,20 @@ func (c *Config) normalizeAndCheck() (err error) {
 		seen[ruleKey] = true
 	}
 
+	// check validation groups
+	seen = make(map[string]bool)
+	for _, g := range c.Groups {
+		if !rxGroup.MatchString(g) {
+			return &Error{C: ERR_GROUP_NAME, dir: c.WorkDir.Value,
+				file: c.File.Value, key: "groups", val: g}
+		}
+		if seen[g] {
+			return &Error{C: ERR_GROUP_DUPNAME, dir: c.WorkDir.Value,
+				file: c.File.Value, key: "groups", val: g}
+		}
+		seen[g] = true
+	}
+
 	return nil
 }
 
@@ -438,11 +574,14 @@ func DefaultConfig() Config {
 		FilePatternList:      StringSlice{},
 		OutNameFormat:        String{Value: "%_valid.go"},
 		ValidatorNamePattern: String{Value: `^(?i:\w*Validator)$`},
+		Output:               String{Value: "go"},
+		DBTag:                String{Value: "db"},
 		ErrorHandling: ErrorHandlingConfig{
 			FieldKey: FieldKeyConfig{
-				Tag:       String{Value: "json"},
-				Join:      Bool{Value: true},
-				Separator: String{Value: "."},
+				Tag:        String{Value: "json"},
+				Join:       Bool{Value: true},
+				Separator:  String{Value: "."},
+				IndexStyle: String{Value: "none"},
 			},
 		},
 	}
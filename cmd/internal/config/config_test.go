@@ -86,6 +86,24 @@ func Test_Config_MergeAndCheck(t *testing.T) {
 			dir:  wd + "/testdata",
 			file: wd + "/testdata/bad_config_test/bad_field_key_separator.yaml",
 			key:  "field_key.separator", val: "..."},
+	}, {
+		c: "testdata/bad_config_test/bad_field_key_index_style.yaml",
+		err: &Error{C: ERR_FKEY_INDEX,
+			dir:  wd + "/testdata",
+			file: wd + "/testdata/bad_config_test/bad_field_key_index_style.yaml",
+			key:  "field_key.index_style", val: "paren"},
+	}, {
+		c: "testdata/bad_config_test/bad_output.yaml",
+		err: &Error{C: ERR_OUTPUT,
+			dir:  wd + "/testdata",
+			file: wd + "/testdata/bad_config_test/bad_output.yaml",
+			key:  "output", val: "xml"},
+	}, {
+		c: "testdata/bad_config_test/bad_db_tag.yaml",
+		err: &Error{C: ERR_DB_TAG,
+			dir:  wd + "/testdata",
+			file: wd + "/testdata/bad_config_test/bad_db_tag.yaml",
+			key:  "db_tag", val: "db-tag"},
 	}, {
 		c: "testdata/bad_config_test/rule_with_no_name.yaml",
 		err: &Error{C: ERR_RULE_NONAME,
@@ -104,12 +122,38 @@ func Test_Config_MergeAndCheck(t *testing.T) {
 			dir:  wd + "/testdata",
 			file: wd + "/testdata/bad_config_test/rule_with_duplicate_name.yaml",
 			val:  "bar"},
+	}, {
+		c: "testdata/bad_config_test/bad_group_name.yaml",
+		err: &Error{C: ERR_GROUP_NAME,
+			dir:  wd + "/testdata",
+			file: wd + "/testdata/bad_config_test/bad_group_name.yaml",
+			key:  "groups", val: "on-create"},
+	}, {
+		c: "testdata/bad_config_test/group_with_duplicate_name.yaml",
+		err: &Error{C: ERR_GROUP_DUPNAME,
+			dir:  wd + "/testdata",
+			file: wd + "/testdata/bad_config_test/group_with_duplicate_name.yaml",
+			key:  "groups", val: "create"},
+	}, {
+		c: "testdata/bad_config_test/rule_with_func_and_dep.yaml",
+		err: &Error{C: ERR_RULE_FUNCDEP,
+			dir:  wd + "/testdata",
+			file: wd + "/testdata/bad_config_test/rule_with_func_and_dep.yaml",
+			val:  "0"},
+	}, {
+		c: "testdata/bad_config_test/rule_with_dep_and_no_method.yaml",
+		err: &Error{C: ERR_RULE_NOMETHOD,
+			dir:  wd + "/testdata",
+			file: wd + "/testdata/bad_config_test/rule_with_dep_and_no_method.yaml",
+			val:  "0"},
 	}, {
 		wd: "testdata/no_config_test/",
 		want: &Config{
 			WorkDir:              String{Value: wd + "/testdata/no_config_test", IsSet: true},
 			OutNameFormat:        dc.OutNameFormat,
 			ValidatorNamePattern: dc.ValidatorNamePattern,
+			Output:               dc.Output,
+			DBTag:                dc.DBTag,
 			ErrorHandling:        dc.ErrorHandling,
 			validatorNameRegexp:  regexp.MustCompile(dc.ValidatorNamePattern.Value),
 		},
@@ -120,6 +164,8 @@ func Test_Config_MergeAndCheck(t *testing.T) {
 			WorkDir:              String{Value: wd + "/testdata/implicit_config_test/foo/bar/baz", IsSet: true},
 			OutNameFormat:        dc.OutNameFormat,
 			ValidatorNamePattern: dc.ValidatorNamePattern,
+			Output:               dc.Output,
+			DBTag:                dc.DBTag,
 			ErrorHandling:        dc.ErrorHandling,
 			validatorNameRegexp:  regexp.MustCompile(dc.ValidatorNamePattern.Value),
 			Rules: []RuleConfig{{
@@ -134,6 +180,8 @@ func Test_Config_MergeAndCheck(t *testing.T) {
 			WorkDir:              String{Value: wd, IsSet: true},
 			OutNameFormat:        dc.OutNameFormat,
 			ValidatorNamePattern: dc.ValidatorNamePattern,
+			Output:               dc.Output,
+			DBTag:                dc.DBTag,
 			ErrorHandling:        dc.ErrorHandling,
 			validatorNameRegexp:  regexp.MustCompile(dc.ValidatorNamePattern.Value),
 		},
@@ -159,11 +207,15 @@ func Test_Config_MergeAndCheck(t *testing.T) {
 			},
 			OutNameFormat:        String{Value: "%_out.go", IsSet: true},
 			ValidatorNamePattern: String{Value: "^\\w+Input$", IsSet: true},
+			Partial:              Bool{Value: true, IsSet: true},
+			Output:               String{Value: "jsonschema", IsSet: true},
+			DBTag:                String{Value: "pg", IsSet: true},
 			ErrorHandling: ErrorHandlingConfig{
 				FieldKey: FieldKeyConfig{
-					Tag:       String{Value: "json", IsSet: true},
-					Join:      Bool{Value: true, IsSet: true},
-					Separator: String{Value: ".", IsSet: true},
+					Tag:        String{Value: "json", IsSet: true},
+					Join:       Bool{Value: true, IsSet: true},
+					Separator:  String{Value: ".", IsSet: true},
+					IndexStyle: String{Value: "bracket", IsSet: true},
 				},
 				Constructor: ObjectIdent{
 					Pkg:   "example.com/me/mymod/mypkg",
@@ -202,6 +254,7 @@ func Test_Config_MergeAndCheck(t *testing.T) {
 					JoinOp: JOIN_OR,
 				},
 			}},
+			Groups: []string{"create", "update"},
 			fileRegexpList: []*regexp.Regexp{
 				regexp.MustCompile("^\\/path\\/to\\/my\\/\\w+_foo.go$"),
 				regexp.MustCompile("^\\/path\\/to\\/my\\/\\w+_bar.go$"),
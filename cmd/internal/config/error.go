@@ -99,11 +99,18 @@ const (
 	ERR_FILE_ITEM      // file_list item unusable
 	ERR_OUTNAME_FORMAT // invalid output name format
 	ERR_PATTERN        // invalid regular expression
+	ERR_OUTPUT         // invalid output kind
+	ERR_DB_TAG         // invalid db tag
 	ERR_FKEY_TAG       // invalid field key tag
 	ERR_FKEY_SEP       // invalid field key separator
+	ERR_FKEY_INDEX     // invalid field key index style
 	ERR_RULE_NONAME    // rule with no name
 	ERR_RULE_NOFUNC    // missing rule func
 	ERR_RULE_DUPNAME   // duplicate rule name
+	ERR_RULE_FUNCDEP   // rule with both func and dep
+	ERR_RULE_NOMETHOD  // rule with dep but no method
+	ERR_GROUP_NAME     // invalid group name
+	ERR_GROUP_DUPNAME  // duplicate group name
 )
 
 func (e ErrorCode) Id() string {
@@ -185,6 +192,18 @@ var error_template = `
 {{- template "config_error_meta" . -}}
 {{ end }}
 
+{{ define "` + ERR_OUTPUT.Id() + `" -}}
+{{ ERRCFG }} The value "{{W .Value}}" is not a valid "{{W .Key}}".
+{{- template "config_error_meta" . -}}
+{{""}}  > HINT: An output value MUST be one of "{{W "go"}}", "{{W "jsonschema"}}", "{{W "openapi"}}", "{{W "zod"}}", or "{{W "postgres"}}".
+{{ end }}
+
+{{ define "` + ERR_DB_TAG.Id() + `" -}}
+{{ ERRCFG }} The value "{{W .Value}}" is not a valid "{{W .Key}}".
+{{- template "config_error_meta" . -}}
+{{""}}  > HINT: A db tag MUST match the "{{W ` + "`^[A-Za-z_]\\w*$`" + `}}" regular expression.
+{{ end }}
+
 {{ define "` + ERR_FKEY_TAG.Id() + `" -}}
 {{ ERRCFG }} The value "{{W .Value}}" is not a valid "{{W .Key}}".
 {{- template "config_error_meta" . -}}
@@ -197,6 +216,12 @@ var error_template = `
 {{""}}  > HINT: A field key separator MUST be a single byte.
 {{ end }}
 
+{{ define "` + ERR_FKEY_INDEX.Id() + `" -}}
+{{ ERRCFG }} The value "{{W .Value}}" is not a valid "{{W .Key}}".
+{{- template "config_error_meta" . -}}
+{{""}}  > HINT: A field key index style MUST be one of "{{W "none"}}", "{{W "dot"}}", "{{W "bracket"}}", or "{{W "pointer"}}".
+{{ end }}
+
 {{ define "` + ERR_RULE_NONAME.Id() + `" -}}
 {{ ERRCFG }} The rule (index {{W .Value}}) is missing a name.
 {{- template "config_error_meta" . -}}
@@ -211,4 +236,27 @@ var error_template = `
 {{ ERRCFG }} The rule name {{W .Value}} is already taken by another rule.
 {{- template "config_error_meta" . -}}
 {{ end }}
+
+{{ define "` + ERR_RULE_FUNCDEP.Id() + `" -}}
+{{ ERRCFG }} The rule (index {{W .Value}}) has both a func and a dep value.
+{{- template "config_error_meta" . -}}
+{{""}}  > HINT: A rule whose function is a method of a dependency type MUST omit the func value` +
+	`{{NT}}  and instead specify the method's name with the method value.
+{{ end }}
+
+{{ define "` + ERR_RULE_NOMETHOD.Id() + `" -}}
+{{ ERRCFG }} The rule (index {{W .Value}}) has a dep value but no associated method value.
+{{- template "config_error_meta" . -}}
+{{ end }}
+
+{{ define "` + ERR_GROUP_NAME.Id() + `" -}}
+{{ ERRCFG }} The value "{{W .Value}}" is not a valid "{{W .Key}}" item.
+{{- template "config_error_meta" . -}}
+{{""}}  > HINT: A group name MUST match the "{{W ` + "`^[A-Za-z]\\w*$`" + `}}" regular expression.
+{{ end }}
+
+{{ define "` + ERR_GROUP_DUPNAME.Id() + `" -}}
+{{ ERRCFG }} The group name {{W .Value}} is declared more than once.
+{{- template "config_error_meta" . -}}
+{{ end }}
 `
@@ -0,0 +1,65 @@
+package generator
+
+import (
+	"github.com/frk/valid/cmd/internal/rules"
+
+	GO "github.com/frk/ast/golang"
+)
+
+// builds an expression that checks the value against each of the alternation
+// group's rules. Since the generated code checks for invalid values, the value
+// is invalid only if it fails all of the group's rules, i.e. !a && !b && ...
+func (b *bb) alternationCondExpr(n *rules.Node, r *rules.Rule) (x GO.ExprNode) {
+	for _, a := range r.Alt {
+		y := b.condExpr(n, a)
+		if isLogicalExpr(y) {
+			y = GO.ParenExpr{y}
+		}
+
+		if x == nil {
+			x = y
+		} else {
+			x = binAnd(x, y)
+		}
+	}
+	return x
+}
+
+// the comparison operators and their inverse
+var inverseOps = map[GO.BinaryOp]GO.BinaryOp{
+	GO.BinaryEql: GO.BinaryNeq,
+	GO.BinaryNeq: GO.BinaryEql,
+	GO.BinaryLss: GO.BinaryGeq,
+	GO.BinaryGeq: GO.BinaryLss,
+	GO.BinaryGtr: GO.BinaryLeq,
+	GO.BinaryLeq: GO.BinaryGtr,
+}
+
+// negateExpr returns the logical negation of the given expression.
+// A negated expression is simplified if possible, i.e. !x becomes x
+// and a comparison is replaced by its inverse, e.g. x == y becomes x != y.
+func negateExpr(x GO.ExprNode) GO.ExprNode {
+	switch x := x.(type) {
+	case GO.UnaryExpr:
+		if x.Op == GO.UnaryNot {
+			return x.X
+		}
+	case GO.BinaryExpr:
+		if op, ok := inverseOps[x.Op]; ok {
+			x.Op = op
+			return x
+		}
+	case GO.CallExpr, GO.Ident, GO.SelectorExpr:
+		return GO.UnaryExpr{Op: GO.UnaryNot, X: x}
+	}
+	return GO.UnaryExpr{Op: GO.UnaryNot, X: GO.ParenExpr{x}}
+}
+
+// isLogicalExpr reports whether or not x is a binary
+// expression with a logical "&&" or "||" operator.
+func isLogicalExpr(x GO.ExprNode) bool {
+	if bx, ok := x.(GO.BinaryExpr); ok {
+		return bx.Op == GO.BinaryLAnd || bx.Op == GO.BinaryLOr
+	}
+	return false
+}
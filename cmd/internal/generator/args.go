@@ -23,6 +23,10 @@ func (b *bb) ruleArg(n *rules.Node, r *rules.Rule, i int, a *rules.Arg) GO.ExprN
 	switch r.Spec.Kind {
 	case rules.LENGTH:
 		tt = &gotype.Type{Kind: gotype.K_INT}
+	case rules.TEMPORAL:
+		if r.Name == "within" {
+			tt = durationType
+		}
 	case rules.FUNCTION, rules.PREPROC:
 		if in := r.Spec.FType.In; len(in)-1 <= i {
 			tt = in[len(in)-1].Type
@@ -46,7 +50,7 @@ func (b *bb) fieldArg(a *rules.Arg, t *gotype.Type) GO.ExprNode {
 		return GO.UnaryExpr{Op: GO.UnaryAmp, X: x}
 	}
 	if leaf.Type.NeedsConversion(t) {
-		T := GO.Ident{t.TypeString(nil)}
+		T := b.typeExpr(t)
 		return GO.CallExpr{Fun: T, Args: GO.ArgsList{List: x}}
 	}
 	return x
@@ -96,6 +100,11 @@ func (b *bb) constArg(n *rules.Node, r *rules.Rule, a *rules.Arg, t *gotype.Type
 	if t.IsEmptyInterface() && a.Type != rules.ARG_STRING && a.Value != "" {
 		return GO.ValueLit(a.Value)
 	}
+	if a.Type == rules.ARG_STRING && (t.IsTime() || t.IsDuration()) {
+		if x := b.timeArg(a, t); x != nil {
+			return x
+		}
+	}
 	if a.Type == rules.ARG_STRING && r.Spec.UseRawString {
 		return GO.RawStringLit(a.Value)
 	}
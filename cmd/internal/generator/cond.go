@@ -8,6 +8,18 @@ import (
 )
 
 func (b *bb) condExpr(n *rules.Node, r *rules.Rule) GO.ExprNode {
+	if r.Spec.Kind == rules.ALTERNATION {
+		return b.alternationCondExpr(n, r)
+	}
+	if r.Negated {
+		return negateExpr(b.ruleCondExpr(n, r))
+	}
+	return b.ruleCondExpr(n, r)
+}
+
+// ruleCondExpr builds an expression that checks the value
+// against the rule, ignoring the rule's negation, if any.
+func (b *bb) ruleCondExpr(n *rules.Node, r *rules.Rule) GO.ExprNode {
 	if len(r.Args) > 0 {
 		b.prepArgs(n, r)
 	}
@@ -30,6 +42,8 @@ func (b *bb) condExpr(n *rules.Node, r *rules.Rule) GO.ExprNode {
 		return b.rangeCondExpr(n, r)
 	case rules.ENUM:
 		return b.enumCondExpr(n, r)
+	case rules.TEMPORAL:
+		return b.temporalCondExpr(n, r)
 	case rules.FUNCTION:
 		return b.functionCondExpr(n, r)
 	case rules.METHOD:
@@ -86,39 +100,118 @@ func (b *bb) requiredCondExpr(n *rules.Node, r *rules.Rule) GO.ExprNode {
 			return GO.BinaryExpr{Op: GO.BinaryEql, X: b.val, Y: NIL}
 		}
 	case "required":
-		switch n.Type.Kind {
-		case gotype.K_STRING:
-			return GO.BinaryExpr{Op: GO.BinaryEql, X: b.val, Y: GO.ValueLit(`""`)}
-		case gotype.K_MAP, gotype.K_SLICE:
-			return GO.BinaryExpr{Op: GO.BinaryEql, X: GO.CallLenExpr{b.val}, Y: GO.IntLit(0)}
-		case gotype.K_INT, gotype.K_INT8, gotype.K_INT16, gotype.K_INT32, gotype.K_INT64:
-			return GO.BinaryExpr{Op: GO.BinaryEql, X: b.val, Y: GO.IntLit(0)}
-		case gotype.K_UINT, gotype.K_UINT8, gotype.K_UINT16, gotype.K_UINT32, gotype.K_UINT64:
-			return GO.BinaryExpr{Op: GO.BinaryEql, X: b.val, Y: GO.IntLit(0)}
-		case gotype.K_FLOAT32, gotype.K_FLOAT64:
-			return GO.BinaryExpr{Op: GO.BinaryEql, X: b.val, Y: GO.ValueLit("0.0")}
-		case gotype.K_BOOL:
-			return GO.BinaryExpr{Op: GO.BinaryEql, X: b.val, Y: GO.ValueLit("false")}
-		case gotype.K_INTERFACE:
-			return GO.BinaryExpr{Op: GO.BinaryEql, X: b.val, Y: NIL}
-		case gotype.K_PTR:
-			return GO.BinaryExpr{Op: GO.BinaryEql, X: b.val, Y: NIL}
-		case gotype.K_STRUCT:
-			lit := GO.StructLit{Compact: true}
-			if n.Type.Pkg != b.g.pkg {
-				pkg := b.g.addImport(n.Type.Pkg)
-				lit.Type = pkgQualIdent(pkg, n.Type.Name)
-			} else {
-				lit.Type = GO.Ident{n.Type.Name}
-			}
-			return GO.BinaryExpr{Op: GO.BinaryEql, X: b.val, Y: GO.ParenExpr{lit}}
+		return b.zeroCmpExpr(n.Type, b.val, GO.BinaryEql)
+	}
+
+	panic("shouldn't reach")
+	return nil
+}
+
+// builds an expression that compares x, a value of type t, against
+// the "zero" value of t using op, which should be either == or !=.
+func (b *bb) zeroCmpExpr(t *gotype.Type, x GO.ExprNode, op GO.BinaryOp) GO.ExprNode {
+	switch t.Kind {
+	case gotype.K_STRING:
+		return GO.BinaryExpr{Op: op, X: x, Y: GO.ValueLit(`""`)}
+	case gotype.K_MAP, gotype.K_SLICE:
+		return GO.BinaryExpr{Op: op, X: GO.CallLenExpr{x}, Y: GO.IntLit(0)}
+	case gotype.K_INT, gotype.K_INT8, gotype.K_INT16, gotype.K_INT32, gotype.K_INT64:
+		return GO.BinaryExpr{Op: op, X: x, Y: GO.IntLit(0)}
+	case gotype.K_UINT, gotype.K_UINT8, gotype.K_UINT16, gotype.K_UINT32, gotype.K_UINT64:
+		return GO.BinaryExpr{Op: op, X: x, Y: GO.IntLit(0)}
+	case gotype.K_FLOAT32, gotype.K_FLOAT64:
+		return GO.BinaryExpr{Op: op, X: x, Y: GO.ValueLit("0.0")}
+	case gotype.K_BOOL:
+		return GO.BinaryExpr{Op: op, X: x, Y: GO.ValueLit("false")}
+	case gotype.K_INTERFACE:
+		return GO.BinaryExpr{Op: op, X: x, Y: NIL}
+	case gotype.K_PTR:
+		return GO.BinaryExpr{Op: op, X: x, Y: NIL}
+	case gotype.K_STRUCT:
+		lit := GO.StructLit{Compact: true}
+		if t.Pkg != b.g.pkg {
+			pkg := b.g.addImport(t.Pkg)
+			lit.Type = pkgQualIdent(pkg, t.Name)
+		} else {
+			lit.Type = GO.Ident{t.Name}
 		}
+		return GO.BinaryExpr{Op: op, X: x, Y: GO.ParenExpr{lit}}
 	}
 
 	panic("shouldn't reach")
 	return nil
 }
 
+// builds an expression that checks whether the condition of the
+// conditional required rule is met and whether the value is "zero".
+func (b *bb) condRequiredCondExpr(n *rules.Node, r *rules.Rule) GO.ExprNode {
+	var cond GO.ExprNode
+	switch r.Name {
+	case "required_if", "required_unless":
+		binOp, logOp := GO.BinaryEql, GO.BinaryLOr
+		if r.Name == "required_unless" {
+			binOp, logOp = GO.BinaryNeq, GO.BinaryLAnd
+		}
+
+		x, leaf := b.fieldArgSelector(r.Args[0])
+		args := make([]GO.ExprNode, len(r.Args)-1)
+		for i, a := range r.Args[1:] {
+			if a.Type == rules.ARG_FIELD_ABS || a.Type == rules.ARG_FIELD_REL {
+				args[i] = b.fieldArg(a, leaf.Type)
+			} else {
+				args[i] = b.constArg(n, r, a, leaf.Type)
+			}
+		}
+
+		cond = cmpListExpr(x, args, binOp, logOp)
+		if len(args) > 1 && logOp == GO.BinaryLOr {
+			cond = GO.ParenExpr{cond}
+		}
+	case "required_with", "required_without":
+		op := GO.BinaryNeq
+		if r.Name == "required_without" {
+			op = GO.BinaryEql
+		}
+
+		for _, a := range r.Args {
+			x, leaf := b.fieldArgSelector(a)
+			if y := b.zeroCmpExpr(leaf.Type, x, op); cond != nil {
+				cond = binOr(cond, y)
+			} else {
+				cond = y
+			}
+		}
+		if len(r.Args) > 1 {
+			cond = GO.ParenExpr{cond}
+		}
+	}
+
+	return binAnd(cond, b.requiredZeroExpr(n.Type, b.val))
+}
+
+// builds an expression that checks whether x is "zero" in the same way
+// as the "required" rule does, i.e. if x is a pointer then each level of
+// the pointer is checked for nil and the base is compared to its zero value.
+func (b *bb) requiredZeroExpr(t *gotype.Type, x GO.ExprNode) GO.ExprNode {
+	if t.Kind != gotype.K_PTR {
+		return b.zeroCmpExpr(t, x, GO.BinaryEql)
+	}
+
+	var cond GO.ExprNode
+	for ; t.Kind == gotype.K_PTR; t = t.Elem {
+		if y := b.zeroCmpExpr(t, x, GO.BinaryEql); cond != nil {
+			cond = binOr(cond, y)
+		} else {
+			cond = y
+		}
+		x = GO.PointerIndirectionExpr{X: x}
+	}
+	if t.Kind.IsBasic() || t.IsNilable() || (len(t.Name) > 0 && t.IsComparable()) {
+		cond = binOr(cond, b.zeroCmpExpr(t, x, GO.BinaryEql))
+	}
+	return GO.ParenExpr{cond}
+}
+
 // builds an expression that compares the value against the rule's arguments.
 func (b *bb) comparableCondExpr(n *rules.Node, r *rules.Rule) GO.ExprNode {
 	var binOp, logOp GO.BinaryOp
@@ -131,10 +224,15 @@ func (b *bb) comparableCondExpr(n *rules.Node, r *rules.Rule) GO.ExprNode {
 		logOp = GO.BinaryLOr
 	}
 
-	args := b.g.argmap[r]
-	cond := GO.BinaryExpr{Op: binOp, X: b.val, Y: args[0]}
+	return cmpListExpr(b.val, b.g.argmap[r], binOp, logOp)
+}
+
+// builds an expression that compares x against each of the given
+// args using binOp and joins the individual comparisons with logOp.
+func cmpListExpr(x GO.ExprNode, args []GO.ExprNode, binOp, logOp GO.BinaryOp) GO.ExprNode {
+	cond := GO.BinaryExpr{Op: binOp, X: x, Y: args[0]}
 	for _, a := range args[1:] {
-		y := GO.BinaryExpr{Op: binOp, X: b.val, Y: a}
+		y := GO.BinaryExpr{Op: binOp, X: x, Y: a}
 		cond = GO.BinaryExpr{Op: logOp, X: cond, Y: y}
 	}
 	return cond
@@ -225,7 +323,7 @@ func (b *bb) functionCondExpr(n *rules.Node, r *rules.Rule) (x GO.ExprNode) {
 	// NOTE(mkopriva): if the logic here changes, make sure to
 	// mirror those changes in functionCallExpr if necessary.
 
-	pkg := b.g.addImport(r.Spec.FType.Pkg)
+	fn := b.funcExpr(r)
 	args := b.g.argmap[r]
 
 	// If this is the included regexp rule, then add
@@ -246,15 +344,15 @@ func (b *bb) functionCondExpr(n *rules.Node, r *rules.Rule) (x GO.ExprNode) {
 	}
 
 	if r.Spec.JoinOp == 0 {
-		cx := GO.CallExpr{Fun: pkgQualIdent(pkg, r.Spec.FName)}
-		cx.Args.List = append(GO.ExprList{v}, args...)
+		cx := GO.CallExpr{Fun: fn}
+		cx.Args.List = funcArgs(r, v, args...)
 		return GO.UnaryExpr{Op: GO.UnaryNot, X: cx}
 	}
 
 	if r.Spec.JoinOp > 0 {
 		for _, a := range args {
-			cx := GO.CallExpr{Fun: pkgQualIdent(pkg, r.Spec.FName)}
-			cx.Args.List = GO.ExprList{v, a}
+			cx := GO.CallExpr{Fun: fn}
+			cx.Args.List = funcArgs(r, v, a)
 
 			switch {
 			// x || x...
@@ -310,7 +408,7 @@ func (b *bb) functionCallExpr(n *rules.Node, r *rules.Rule) (x GO.ExprNode) {
 	// NOTE(mkopriva): if the logic here changes, make sure to
 	// mirror those changes in functionCondExpr if necessary.
 
-	pkg := b.g.addImport(r.Spec.FType.Pkg)
+	fn := b.funcExpr(r)
 	args := b.g.argmap[r]
 
 	// If this is the included regexp rule, then add
@@ -330,7 +428,34 @@ func (b *bb) functionCallExpr(n *rules.Node, r *rules.Rule) (x GO.ExprNode) {
 		v = GO.CallExpr{Fun: T, Args: GO.ArgsList{List: v}}
 	}
 
-	cx := GO.CallExpr{Fun: pkgQualIdent(pkg, r.Spec.FName)}
-	cx.Args.List = append(GO.ExprList{v}, args...)
+	cx := GO.CallExpr{Fun: fn}
+	cx.Args.List = funcArgs(r, v, args...)
 	return cx
 }
+
+// funcExpr returns the expression that identifies the rule spec's function.
+// If the function is a method of a dependency type then the expression will
+// be a selector of the method on the validator's field holding the dependency.
+func (b *bb) funcExpr(r *rules.Rule) GO.ExprNode {
+	if fs, ok := b.g.info.DepMap[r.Spec]; ok {
+		x := b.g.recv
+		for _, f := range fs {
+			x = GO.SelectorExpr{X: x, Sel: GO.Ident{f.Name}}
+		}
+		return GO.SelectorExpr{X: x, Sel: GO.Ident{r.Spec.FName}}
+	}
+
+	pkg := b.g.addImport(r.Spec.FType.Pkg)
+	return pkgQualIdent(pkg, r.Spec.FName)
+}
+
+// funcArgs returns the argument list for a call to the rule spec's function.
+// If the function's first parameter is a context.Context then the list will
+// be prefixed with the ctx argument of the generated ValidateContext method.
+func funcArgs(r *rules.Rule, v GO.ExprNode, args ...GO.ExprNode) GO.ExprList {
+	list := append(GO.ExprList{v}, args...)
+	if r.Spec.FType.HasContext {
+		list = append(GO.ExprList{CTX}, list...)
+	}
+	return list
+}
@@ -0,0 +1,63 @@
+package generator
+
+import (
+	"strconv"
+
+	"github.com/frk/valid/cmd/internal/gotype"
+	"github.com/frk/valid/cmd/internal/rules"
+
+	GO "github.com/frk/ast/golang"
+)
+
+// defaultStmt builds the statements that assign a zero field its default value.
+func (b *bb) defaultStmt(n *rules.Node) {
+	x, t := b.val, n.Type
+	for t.Kind == gotype.K_PTR {
+		// if v.F == nil {
+		//	v.F = new(T)
+		// }
+		ifs := &GO.IfStmt{}
+		ifs.Cond = GO.BinaryExpr{Op: GO.BinaryEql, X: x, Y: NIL}
+		ifs.Body.Add(GO.AssignStmt{Token: GO.Assign, Lhs: x, Rhs: GO.CallExpr{
+			Fun:  GO.Ident{Name: "new"},
+			Args: GO.ArgsList{List: b.typeExpr(t.Elem)},
+		}})
+		b.add(ifs)
+
+		x = GO.PointerIndirectionExpr{X: x}
+		t = t.Elem
+	}
+
+	var val GO.ExprNode
+	switch a := n.Default; a.Type {
+	case rules.ARG_FIELD_ABS, rules.ARG_FIELD_REL:
+		val = b.fieldArg(a, t)
+	case rules.ARG_STRING:
+		val = GO.ValueLit(strconv.Quote(a.Value))
+	default:
+		val = GO.ValueLit(a.Value)
+	}
+
+	// if v.F == <zero> {
+	//	v.F = <default>
+	// }
+	ifs := &GO.IfStmt{}
+	ifs.Cond = b.zeroCmpExpr(t, x, GO.BinaryEql)
+	ifs.Body.Add(GO.AssignStmt{Token: GO.Assign, Lhs: x, Rhs: val})
+	b.add(ifs)
+
+	n.Default = nil
+}
+
+// typeExpr returns the type expression for t. The type t is expected
+// to be either a named type, a basic type, or a pointer to such types.
+func (b *bb) typeExpr(t *gotype.Type) GO.ExprNode {
+	if len(t.Name) == 0 && t.Kind == gotype.K_PTR {
+		return GO.PointerType{Elem: b.typeExpr(t.Elem).(GO.TypeNode)}
+	}
+	if len(t.Name) > 0 && t.Pkg != b.g.pkg {
+		pkg := b.g.addImport(t.Pkg)
+		return pkgQualIdent(pkg, t.Name)
+	}
+	return GO.Ident{Name: t.TypeString(&b.g.pkg)}
+}
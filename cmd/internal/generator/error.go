@@ -24,35 +24,48 @@ func (b *bb) err(n *rules.Node, r *rules.Rule, body *GO.BlockStmt) {
 		b.errGlobalHandler(n, r, body, true)
 	case global.ErrorConstructor != nil:
 		b.errGlobalHandler(n, r, body, false)
+	case global.FieldErrors:
+		b.errFieldErrors(n, r, body)
 	default:
 		b.errDefault(n, r, body)
 	}
 }
 
-func (b *bb) errGlobalHandler(n *rules.Node, r *rules.Rule, body *GO.BlockStmt, isAgg bool) {
-	args := make(GO.ExprList, 3)
-	args[0] = GO.StringLit(b.key)
-	args[1] = b.rootv()
-	args[2] = GO.StringLit(r.Name)
-
+// errRuleArgs returns the rule's arguments as a list of expressions
+// that can be passed to the custom error handling code.
+func (b *bb) errRuleArgs(r *rules.Rule) (args GO.ExprList) {
+	if r.Spec.Kind == rules.ALTERNATION {
+		for _, a := range r.Alt {
+			args = append(args, b.errRuleArgs(a)...)
+		}
+		return args
+	}
 	if r.Spec.Kind == rules.ENUM {
-		enums := b.g.enumap[r]
-		args = append(args, enums...)
-	} else {
-		for _, a := range r.Args {
-			switch a.Type {
-			case rules.ARG_FIELD_ABS, rules.ARG_FIELD_REL:
-				x, _ := b.fieldArgSelector(a)
-				args = append(args, x)
-			case rules.ARG_STRING:
-				args = append(args, GO.StringLit(a.Value))
-			case rules.ARG_UNKNOWN:
-				args = append(args, GO.StringLit(""))
-			default:
-				args = append(args, GO.ValueLit(a.Value))
-			}
+		return b.g.enumap[r]
+	}
+
+	for _, a := range r.Args {
+		switch a.Type {
+		case rules.ARG_FIELD_ABS, rules.ARG_FIELD_REL:
+			x, _ := b.fieldArgSelector(a)
+			args = append(args, x)
+		case rules.ARG_STRING:
+			args = append(args, GO.StringLit(a.Value))
+		case rules.ARG_UNKNOWN:
+			args = append(args, GO.StringLit(""))
+		default:
+			args = append(args, GO.ValueLit(a.Value))
 		}
 	}
+	return args
+}
+
+func (b *bb) errGlobalHandler(n *rules.Node, r *rules.Rule, body *GO.BlockStmt, isAgg bool) {
+	args := append(GO.ExprList{
+		b.keyExpr(),
+		b.rootv(),
+		GO.StringLit(r.TagName()),
+	}, b.errRuleArgs(r)...)
 
 	if isAgg {
 		x := GO.ExprNode(nil)
@@ -71,29 +84,11 @@ func (b *bb) errGlobalHandler(n *rules.Node, r *rules.Rule, body *GO.BlockStmt,
 }
 
 func (b *bb) errHandler(n *rules.Node, r *rules.Rule, body *GO.BlockStmt) {
-	args := make(GO.ExprList, 3)
-	args[0] = GO.StringLit(b.key)
-	args[1] = b.rootv()
-	args[2] = GO.StringLit(r.Name)
-
-	if r.Spec.Kind == rules.ENUM {
-		enums := b.g.enumap[r]
-		args = append(args, enums...)
-	} else {
-		for _, a := range r.Args {
-			switch a.Type {
-			case rules.ARG_FIELD_ABS, rules.ARG_FIELD_REL:
-				x, _ := b.fieldArgSelector(a)
-				args = append(args, x)
-			case rules.ARG_STRING:
-				args = append(args, GO.StringLit(a.Value))
-			case rules.ARG_UNKNOWN:
-				args = append(args, GO.StringLit(""))
-			default:
-				args = append(args, GO.ValueLit(a.Value))
-			}
-		}
-	}
+	args := append(GO.ExprList{
+		b.keyExpr(),
+		b.rootv(),
+		GO.StringLit(r.TagName()),
+	}, b.errRuleArgs(r)...)
 
 	x := GO.ExprNode(nil)
 	h := b.g.info.Validator.ErrorHandlerField
@@ -108,34 +103,134 @@ func (b *bb) errHandler(n *rules.Node, r *rules.Rule, body *GO.BlockStmt) {
 	}
 }
 
+// errFieldErrors produces a statement that adds a valid.FieldError
+// for the failed rule to the list of the aggregated field errors.
+func (b *bb) errFieldErrors(n *rules.Node, r *rules.Rule, body *GO.BlockStmt) {
+	args := GO.ExprList{
+		b.keyExpr(),
+		b.rootv(),
+		GO.StringLit(r.TagName()),
+		b.errMessage(n, r),
+	}
+	args = append(args, b.errRuleArgs(r)...)
+
+	x := GO.ExprNode(nil)
+	x = GO.SelectorExpr{X: ERRS, Sel: GO.Ident{"Add"}}
+	x = GO.CallExpr{Fun: x, Args: GO.ArgsList{List: args}}
+	body.Add(GO.ExprStmt{x})
+}
+
 func (b *bb) errDefault(n *rules.Node, r *rules.Rule, body *GO.BlockStmt) {
-	cfg := r.Spec.Err
-	if len(r.Spec.ErrOpts) > 0 && len(r.Args) > 0 {
-		var key string
-		for _, a := range r.Args {
-			key += ":"
-			if len(a.Value) > 0 {
-				key += "x"
-			}
+	text, refs := b.errText(n, r)
+	textExpr := GO.ValueLit(strconv.Quote(text))
+
+	if len(refs) > 0 {
+		pkg := b.g.addImport(gotype.Pkg{Path: "fmt"})
+		body.Add(GO.ReturnStmt{GO.CallExpr{Fun: pkgQualIdent(pkg, "Errorf"),
+			Args: GO.ArgsList{List: append(GO.ExprList{textExpr}, refs...)}}})
+	} else {
+		pkg := b.g.addImport(gotype.Pkg{Path: "errors"})
+		body.Add(GO.ReturnStmt{GO.CallExpr{Fun: pkgQualIdent(pkg, "New"),
+			Args: GO.ArgsList{List: GO.ExprList{textExpr}}}})
+	}
+}
+
+// errMessage returns an expression that produces the
+// default error message text for the failed rule.
+func (b *bb) errMessage(n *rules.Node, r *rules.Rule) GO.ExprNode {
+	text, refs := b.errText(n, r)
+	textExpr := GO.ValueLit(strconv.Quote(text))
+
+	if len(refs) > 0 {
+		pkg := b.g.addImport(gotype.Pkg{Path: "fmt"})
+		return GO.CallExpr{Fun: pkgQualIdent(pkg, "Sprintf"),
+			Args: GO.ArgsList{List: append(GO.ExprList{textExpr}, refs...)}}
+	}
+	return textExpr
+}
+
+// errText returns the default error message text for the failed rule. If
+// the text contains "%v" verbs, then refs will hold the expressions of the
+// referenced fields' values that should be used to format the text.
+func (b *bb) errText(n *rules.Node, r *rules.Rule) (text string, refs GO.ExprList) {
+	text, refs = b.ruleErrText(n, r)
+
+	// If the key is constructed at runtime, pass
+	// it as the first argument to the formatter.
+	if parts := b.keyParts(); len(parts) == 1 && parts[0].x == nil {
+		text = parts[0].lit + " " + text
+	} else {
+		if len(refs) == 0 {
+			text = strings.ReplaceAll(text, "%", "%%")
 		}
+		text = "%s " + text
+		refs = append(GO.ExprList{b.keyExpr()}, refs...)
+	}
+	return text, refs
+}
 
-		key = key[1:]
-		if c, ok := r.Spec.ErrOpts[key]; ok {
-			cfg = c
+// ruleErrText returns the error message text for the failed rule without
+// the field's key. The texts of an alternation group's rules are joined
+// with "or", and the text of a negated rule is negated.
+func (b *bb) ruleErrText(n *rules.Node, r *rules.Rule) (text string, refs GO.ExprList) {
+	if r.Spec.Kind == rules.ALTERNATION {
+		texts := make([]string, len(r.Alt))
+		hasRefs := make([]bool, len(r.Alt))
+		for i, a := range r.Alt {
+			t, x := b.ruleErrText(n, a)
+			texts[i], hasRefs[i] = t, len(x) > 0
+			refs = append(refs, x...)
+		}
+		if len(refs) > 0 {
+			// the texts will be used as a format string
+			for i := range texts {
+				if !hasRefs[i] {
+					texts[i] = strings.ReplaceAll(texts[i], "%", "%%")
+				}
+			}
 		}
+		return strings.Join(texts, " or "), refs
 	}
 
-	text := cfg.Text
+	cfg := r.ErrSpec()
+	text = cfg.Text
 	if len(text) == 0 {
 		text = "is not valid"
 	}
-	text = b.key + " " + text
 	//////////////////////////////
 
-	var refs GO.ExprList
-	if cfg.WithArgs {
+	if r.Spec.Kind == rules.CONDREQUIRED {
+		// The arguments of a conditional required rule reference
+		// the controlling fields, name them rather than their values.
+		switch r.Name {
+		case "required_if", "required_unless":
+			var args []string
+			for _, arg := range r.Args[1:] {
+				switch arg.Type {
+				case rules.ARG_FIELD_ABS, rules.ARG_FIELD_REL:
+					args = append(args, arg.Value)
+				case rules.ARG_STRING, rules.ARG_UNKNOWN:
+					args = append(args, strconv.Quote(arg.Value))
+				default:
+					args = append(args, arg.Value)
+				}
+			}
+			text += " " + r.Args[0].Value + " is " + strings.Join(args, cfg.ArgSep)
+		case "required_with", "required_without":
+			var args []string
+			for _, arg := range r.Args {
+				args = append(args, arg.Value)
+			}
+			text += " " + strings.Join(args, cfg.ArgSep) + " " + cfg.ArgSuffix
+		}
+	} else if cfg.WithArgs || cfg.WithFieldArgs {
 		var args []string
 		for _, arg := range r.Args {
+			isField := arg.Type == rules.ARG_FIELD_ABS || arg.Type == rules.ARG_FIELD_REL
+			if !cfg.WithArgs && !isField {
+				continue
+			}
+
 			// A rule argument of unknown kind for
 			// a numeric type can be treated as 0.
 			if arg.Type == rules.ARG_UNKNOWN && n.Type.Kind.IsNumeric() {
@@ -153,7 +248,11 @@ func (b *bb) errDefault(n *rules.Node, r *rules.Rule, body *GO.BlockStmt) {
 				args = append(args, "%v")
 				refs = append(refs, x)
 			case rules.ARG_STRING:
-				args = append(args, strconv.Quote(arg.Value))
+				if isTimeArg(n, r, arg) {
+					args = append(args, arg.Value)
+				} else {
+					args = append(args, strconv.Quote(arg.Value))
+				}
 			default:
 				args = append(args, arg.Value)
 			}
@@ -165,15 +264,17 @@ func (b *bb) errDefault(n *rules.Node, r *rules.Rule, body *GO.BlockStmt) {
 			}
 		}
 	}
-	textExpr := GO.ValueLit(strconv.Quote(text))
 
-	if len(refs) > 0 {
-		pkg := b.g.addImport(gotype.Pkg{Path: "fmt"})
-		body.Add(GO.ReturnStmt{GO.CallExpr{Fun: pkgQualIdent(pkg, "Errorf"),
-			Args: GO.ArgsList{List: append(GO.ExprList{textExpr}, refs...)}}})
-	} else {
-		pkg := b.g.addImport(gotype.Pkg{Path: "errors"})
-		body.Add(GO.ReturnStmt{GO.CallExpr{Fun: pkgQualIdent(pkg, "New"),
-			Args: GO.ArgsList{List: GO.ExprList{textExpr}}}})
+	if r.Spec.Kind == rules.UNIQUE {
+		t, x := uniqueErrText(n)
+		if len(refs) == 0 {
+			text = strings.ReplaceAll(text, "%", "%%")
+		}
+		text += t
+		refs = append(refs, x)
+	}
+	if r.Negated {
+		text = rules.NegateErrText(text)
 	}
+	return text, refs
 }
@@ -19,7 +19,7 @@ func fileAST(pkg gotype.Pkg, infos []*rules.Info) GO.File {
 		PkgName:  pkg.Name,
 	}
 	for _, info := range infos {
-		f.Decls = append(f.Decls, methodAST(g, info))
+		f.Decls = append(f.Decls, methodsAST(g, info)...)
 	}
 	if len(g.init) > 0 {
 		f.Decls = append([]GO.TopLevelDeclNode{initAST(g)}, f.Decls...)
@@ -20,6 +20,9 @@ var (
 	FILE_PREAMBLE = GO.LineComment{` DO NOT EDIT. This file was generated by "github.com/frk/valid".`}
 	OK            = GO.Ident{"ok"}
 	ERR           = GO.Ident{"err"}
+	CTX           = GO.Ident{"ctx"}
+	PRESENT       = GO.Ident{"present"}
+	ERRS          = GO.Ident{"errs"}
 	NIL           = GO.Ident{"nil"}
 	ERROR         = GO.Ident{"error"}
 	ROOT_RECV     = GO.Ident{"v"}
@@ -44,6 +47,9 @@ type gg struct {
 	recv    GO.ExprNode
 	argmap  map[*rules.Rule][]GO.ExprNode
 	enumap  map[*rules.Rule][]GO.ExprNode
+	// If set, the code being generated is that of a partial
+	// validation method, i.e. one that skips absent fields.
+	partial bool
 }
 
 func (g *gg) block(ast *GO.BlockStmt) bb {
@@ -117,6 +123,7 @@ type bb struct {
 	vals   []GO.ExprNode
 	idx    GO.ExprNode
 	key    string
+	keyidx []keyidx
 	tmp    bool
 	nloop  int
 
@@ -130,53 +137,57 @@ type elem struct {
 
 func (b *bb) new() (out bb) {
 	return bb{
-		g:     b.g,
-		ast:   b.cur,
-		cur:   b.cur,
-		val:   b.val,
-		idx:   b.idx,
-		key:   b.key,
-		nloop: b.nloop,
-		elems: b.elems,
+		g:      b.g,
+		ast:    b.cur,
+		cur:    b.cur,
+		val:    b.val,
+		idx:    b.idx,
+		key:    b.key,
+		keyidx: b.keyidx,
+		nloop:  b.nloop,
+		elems:  b.elems,
 	}
 }
 
 func (b *bb) with(val GO.ExprNode) (out bb) {
 	return bb{
-		g:     b.g,
-		ast:   b.ast,
-		cur:   b.cur,
-		idx:   b.idx,
-		val:   val,
-		key:   b.key,
-		nloop: b.nloop,
-		elems: b.elems,
+		g:      b.g,
+		ast:    b.ast,
+		cur:    b.cur,
+		idx:    b.idx,
+		val:    val,
+		key:    b.key,
+		keyidx: b.keyidx,
+		nloop:  b.nloop,
+		elems:  b.elems,
 	}
 }
 
 func (b *bb) field(f *rules.FieldNode) (out bb) {
 	if f.Field.IsEmbedded {
 		return bb{
-			g:     b.g,
-			ast:   b.ast,
-			cur:   b.cur,
-			idx:   b.idx,
-			val:   b.val,
-			key:   b.key,
-			nloop: b.nloop,
-			elems: b.elems,
+			g:      b.g,
+			ast:    b.ast,
+			cur:    b.cur,
+			idx:    b.idx,
+			val:    b.val,
+			key:    b.key,
+			keyidx: b.keyidx,
+			nloop:  b.nloop,
+			elems:  b.elems,
 		}
 	}
 
 	return bb{
-		g:     b.g,
-		ast:   b.ast,
-		cur:   b.cur,
-		idx:   b.idx,
-		val:   GO.SelectorExpr{X: b.val, Sel: GO.Ident{f.Field.Name}},
-		key:   f.Key,
-		nloop: b.nloop,
-		elems: b.elems,
+		g:      b.g,
+		ast:    b.ast,
+		cur:    b.cur,
+		idx:    b.idx,
+		val:    GO.SelectorExpr{X: b.val, Sel: GO.Ident{f.Field.Name}},
+		key:    f.Key,
+		keyidx: b.keyidx,
+		nloop:  b.nloop,
+		elems:  b.elems,
 	}
 }
 
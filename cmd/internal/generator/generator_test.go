@@ -43,7 +43,7 @@ func TestGenerator(t *testing.T) {
 		"validation/23_base64",
 		"validation/24_cidr",
 		"validation/25_cvv",
-		//"validation/26_ein", (currently not implemented by github.com/frk/valid)
+		"validation/26_ein",
 		"validation/27_fqdn",
 		"validation/28_hex",
 		"validation/29_hexcolor",
@@ -55,7 +55,7 @@ func TestGenerator(t *testing.T) {
 		"validation/35_ssn",
 		"validation/36_strongpass",
 		"validation/37_upper",
-		//"validation/38_url", (currently not implemented by github.com/frk/valid)
+		"validation/38_url",
 		"validation/39_uuid",
 		"validation/40_zip",
 		"validation/41_error_constructor",
@@ -79,6 +79,9 @@ func TestGenerator(t *testing.T) {
 		"validation/60_embed",
 		"validation/61_omitkey",
 		"validation/62_omitnil",
+		"validation/63_default",
+		"validation/64_context",
+		"validation/65_dependencies",
 
 		// TODO test IsValid with `is:"-"`
 		// TODO test IsValid combined with other rules
@@ -97,9 +100,21 @@ func TestGenerator(t *testing.T) {
 		"global/02_global_error_aggregator",
 		"global/03_global_error_agg_has_priority_over_ctor",
 		"global/04_local_has_priority_over_global",
+		"global/05_global_field_errors",
+		"global/06_global_validation_groups",
+		"global/07_global_partial",
+
+		// field keys
+		"fieldkey/01_index_dot",
+		"fieldkey/02_index_bracket",
+		"fieldkey/03_index_pointer",
 
 		// builtin/stdlib validation
 		"is/required/v",
+		"is/required_if/v",
+		"is/required_unless/v",
+		"is/required_with/v",
+		"is/required_without/v",
 		"is/optional/v",
 		"is/omitnil/v",
 		"is/noguard/v",
@@ -120,6 +135,15 @@ func TestGenerator(t *testing.T) {
 		"is/contains/v",
 		"is/prefix/v",
 		"is/suffix/v",
+		"is/before/v",
+		"is/after/v",
+		"is/future/v",
+		"is/past/v",
+		"is/within/v",
+		"is/duration/v",
+		"is/unique/v",
+		"is/negation/v",
+		"is/alternation/v",
 
 		// builtin/stdlib preprocessors
 		"pre/lower/v",
@@ -143,6 +167,7 @@ func TestGenerator(t *testing.T) {
 		"pre/round/v",
 		"pre/ceil/v",
 		"pre/floor/v",
+		"pre/e164/v",
 
 		// included validation
 		"included/re/v",
@@ -160,10 +185,11 @@ func TestGenerator(t *testing.T) {
 		"included/cvv/v",
 		"included/ccy/v",
 		"included/datauri/v",
+		"included/date/v",
 		"included/decimal/v",
 		"included/digits/v",
 		"included/ean/v",
-		// TODO "included/ein/v",
+		"included/ein/v",
 		"included/eth/v",
 		"included/email/v",
 		"included/fqdn/v",
@@ -173,7 +199,7 @@ func TestGenerator(t *testing.T) {
 		"included/hex/v",
 		"included/hexcolor/v",
 		"included/iban/v",
-		// TODO "included/ic/v",
+		"included/ic/v",
 		"included/imei/v",
 		"included/ip/v",
 		"included/iprange/v",
@@ -181,7 +207,9 @@ func TestGenerator(t *testing.T) {
 		"included/isin/v",
 		"included/iso639/v",
 		"included/iso31661a/v",
+		"included/iso31662/v",
 		"included/iso4217/v",
+		"included/iso8601/v",
 		"included/isrc/v",
 		"included/issn/v",
 		"included/in/v",
@@ -199,14 +227,17 @@ func TestGenerator(t *testing.T) {
 		"included/numeric/v",
 		"included/octal/v",
 		"included/pan/v",
+		"included/passport/v",
 		"included/phone/v",
 		"included/port/v",
+		"included/rfc3339/v",
 		"included/rgb/v",
 		"included/ssn/v",
 		"included/semver/v",
 		"included/slug/v",
 		"included/strongpass/v",
-		// TODO "included/url/v",
+		"included/timezone/v",
+		"included/url/v",
 		"included/uuid/v",
 		"included/uint/v",
 		"included/upper/v",
@@ -219,6 +250,7 @@ func TestGenerator(t *testing.T) {
 		"nested/fieldabs/v",
 		"nested/fieldrel/01",
 		"nested/fieldrel/02",
+		"nested/fieldcc/v",
 
 		// imports
 		"imports/01",
@@ -274,6 +306,28 @@ func TestGenerator(t *testing.T) {
 				t.Fatal(err)
 			}
 		},
+		"global/05_global_field_errors": func(t *testing.T) {
+			var cfg config.Config
+			cfg.ErrorHandling.FieldErrors = config.Bool{Value: true, IsSet: true}
+			if err := global.Init(cfg, &AST); err != nil {
+				t.Fatal(err)
+			}
+		},
+		"global/06_global_validation_groups": func(t *testing.T) {
+			var cfg config.Config
+			cfg.Groups = []string{"create", "update", "delete"}
+			if err := global.Init(cfg, &AST); err != nil {
+				t.Fatal(err)
+			}
+		},
+		"global/07_global_partial": func(t *testing.T) {
+			var cfg config.Config
+			cfg.Groups = []string{"update"}
+			cfg.Partial = config.Bool{Value: true, IsSet: true}
+			if err := global.Init(cfg, &AST); err != nil {
+				t.Fatal(err)
+			}
+		},
 	}
 
 	cfg := loadConfig("testdata/config.yaml")
@@ -285,6 +339,26 @@ func TestGenerator(t *testing.T) {
 		Join:      config.Bool{Value: true, IsSet: true},
 		Separator: config.String{Value: ".", IsSet: true},
 	}
+	fkCfgs := map[string]*config.FieldKeyConfig{
+		"fieldkey/01_index_dot": &config.FieldKeyConfig{
+			Tag:        config.String{Value: "json", IsSet: true},
+			Join:       config.Bool{Value: true, IsSet: true},
+			Separator:  config.String{Value: ".", IsSet: true},
+			IndexStyle: config.String{Value: "dot", IsSet: true},
+		},
+		"fieldkey/02_index_bracket": &config.FieldKeyConfig{
+			Tag:        config.String{Value: "json", IsSet: true},
+			Join:       config.Bool{Value: true, IsSet: true},
+			Separator:  config.String{Value: ".", IsSet: true},
+			IndexStyle: config.String{Value: "bracket", IsSet: true},
+		},
+		"fieldkey/03_index_pointer": &config.FieldKeyConfig{
+			Tag:        config.String{Value: "json", IsSet: true},
+			Join:       config.Bool{Value: true, IsSet: true},
+			Separator:  config.String{Value: ".", IsSet: true},
+			IndexStyle: config.String{Value: "pointer", IsSet: true},
+		},
+	}
 
 	for _, filename := range tests {
 		t.Run(filename, func(t *testing.T) {
@@ -299,10 +373,15 @@ func TestGenerator(t *testing.T) {
 				defer global.Unset()
 			}
 
+			fkc := fkCfg
+			if c, ok := fkCfgs[filename]; ok {
+				fkc = c
+			}
+
 			infos := make([]*rules.Info, len(f.Matches))
 			for k, match := range f.Matches {
 				info := new(rules.Info)
-				checker := rules.NewChecker(&AST, pkg.Pkg(), fkCfg, info)
+				checker := rules.NewChecker(&AST, pkg.Pkg(), fkc, info)
 				if err := checker.Check(match); err != nil {
 					t.Fatal(err)
 				}
@@ -9,6 +9,9 @@ import (
 func hookAST(h *gotype.MethodInfo, b bb) {
 	call := GO.CallExpr{}
 	call.Fun = GO.SelectorExpr{X: b.g.recv, Sel: GO.Ident{h.Name}}
+	if h.HasContext {
+		call.Args.List = CTX
+	}
 
 	ifs := new(GO.IfStmt)
 	ifs.Init = GO.AssignStmt{Token: GO.AssignDefine, Lhs: ERR, Rhs: call}
@@ -37,7 +37,16 @@ func (b *bb) isruleStmt(n *rules.Node) {
 			cur = elif
 		}
 
-		if r.IsBasic() {
+		if r.Spec.Kind == rules.UNIQUE {
+			basicOnly = false
+
+			// if idx, ok := valid.Unique(<value>); !ok {
+			//	return <custom_error>
+			// }
+			cur.Init = b.uniqueInitStmt(n, r)
+			cur.Cond = GO.UnaryExpr{Op: GO.UnaryNot, X: OK}
+			b.err(n, r, &cur.Body)
+		} else if r.IsBasic() {
 			cur.Cond = b.condExpr(n, r)
 			b.err(n, r, &cur.Body)
 		} else {
@@ -88,6 +97,18 @@ func (b *bb) isruleStmt(n *rules.Node) {
 	}
 }
 
+// condruleStmt builds a separate if-statement for
+// each of the node's conditional required rules.
+func (b *bb) condruleStmt(n *rules.Node) {
+	for _, r := range n.CondRules {
+		ifs := &GO.IfStmt{}
+		ifs.Cond = b.condRequiredCondExpr(n, r)
+		b.err(n, r, &ifs.Body)
+		b.add(ifs)
+	}
+	n.CondRules = nil
+}
+
 func (b *bb) ptrOptionalStmt(n *rules.Node) (base *rules.Node) {
 	ifs := &GO.IfStmt{}
 	for base = n; base.Type.Kind == gotype.K_PTR; {
@@ -179,6 +200,12 @@ func binOr(x, y GO.ExprNode) GO.BinaryExpr {
 }
 
 func wantsParens(r *rules.Rule) bool {
+	if r.Spec.Kind == rules.ALTERNATION {
+		return len(r.Alt) > 1
+	}
+	if r.Negated {
+		return false
+	}
 	return r.Spec.Kind == rules.ENUM || len(r.Args) > 1 &&
 		(r.Spec.Kind != rules.FUNCTION || r.Spec.JoinOp > 0)
 }
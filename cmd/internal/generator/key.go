@@ -0,0 +1,164 @@
+package generator
+
+import (
+	"strings"
+
+	"github.com/frk/valid/cmd/internal/gotype"
+
+	GO "github.com/frk/ast/golang"
+)
+
+// keyidx holds the static key of a collection field and the expression
+// that produces the index, or the map key, of the collection's current
+// element. It is used to construct the elements' field keys at runtime.
+type keyidx struct {
+	key string
+	x   GO.ExprNode
+}
+
+// keypart represents a part of a field key, it is either
+// a string literal or an expression that produces a string.
+type keypart struct {
+	lit string
+	x   GO.ExprNode
+}
+
+// hasKeyIndex reports whether or not the generated code should include
+// the indexes of collection elements in the field keys.
+func (g *gg) hasKeyIndex() bool {
+	switch g.info.KeyIndex {
+	case "dot", "bracket", "pointer":
+		return true
+	}
+	return false
+}
+
+// withIndex is like with but, if enabled, it also records the index expression
+// of the current collection element for the construction of the field keys.
+// The t argument is the type of the index expression, or nil if it's an int.
+func (b *bb) withIndex(val, idx GO.ExprNode, t *gotype.Type) (out bb) {
+	out = b.with(val)
+	if idx == nil || !b.g.hasKeyIndex() {
+		return out
+	}
+
+	n := len(b.keyidx)
+	out.keyidx = append(b.keyidx[:n:n], keyidx{
+		key: b.key,
+		x:   b.keyIndexExpr(idx, t),
+	})
+	return out
+}
+
+// keyIndexExpr returns an expression that converts the index
+// expression x, whose type is represented by t, to a string.
+func (b *bb) keyIndexExpr(x GO.ExprNode, t *gotype.Type) GO.ExprNode {
+	if t == nil || (t.Kind == gotype.K_INT && t.Name == "") {
+		pkg := b.g.addImport(gotype.Pkg{Path: "strconv"})
+		return GO.CallExpr{Fun: pkgQualIdent(pkg, "Itoa"),
+			Args: GO.ArgsList{List: x}}
+	}
+	if t.Kind != gotype.K_STRING {
+		pkg := b.g.addImport(gotype.Pkg{Path: "fmt"})
+		return GO.CallExpr{Fun: pkgQualIdent(pkg, "Sprint"),
+			Args: GO.ArgsList{List: x}}
+	}
+
+	if t.Name != "" {
+		x = GO.CallExpr{Fun: GO.Ident{"string"}, Args: GO.ArgsList{List: x}}
+	}
+	if b.g.info.KeyIndex == "pointer" {
+		// escape the map key as a JSON Pointer reference token
+		pkg := b.g.addImport(gotype.Pkg{Path: "strings"})
+		for _, s := range [][2]string{{"~", "~0"}, {"/", "~1"}} {
+			x = GO.CallExpr{Fun: pkgQualIdent(pkg, "ReplaceAll"),
+				Args: GO.ArgsList{List: GO.ExprList{x,
+					GO.StringLit(s[0]), GO.StringLit(s[1])}}}
+		}
+	}
+	return x
+}
+
+// keyExpr returns an expression that produces the current field key.
+func (b *bb) keyExpr() GO.ExprNode {
+	var x GO.ExprNode
+	for _, p := range b.keyParts() {
+		y := p.x
+		if y == nil {
+			y = GO.StringLit(p.lit)
+		}
+		if x == nil {
+			x = y
+		} else {
+			x = GO.BinaryExpr{X: x, Op: GO.BinaryAdd, Y: y}
+		}
+	}
+	return x
+}
+
+// keyParts splits the current field key into parts, inserting
+// the index expressions of the enclosing collections' elements.
+func (b *bb) keyParts() (parts []keypart) {
+	sep := b.g.info.KeySep
+	key, pos := b.key, 0
+	lit := func(s string) {
+		if n := len(parts); n > 0 && parts[n-1].x == nil {
+			parts[n-1].lit += s
+		} else {
+			parts = append(parts, keypart{lit: s})
+		}
+	}
+
+	for _, ki := range b.keyidx {
+		// If the field keys are not joined then the key of a
+		// field nested inside an element will not begin with
+		// the collection's key, in which case the index is omitted.
+		end := len(ki.key)
+		if end < pos || !strings.HasPrefix(key, ki.key) ||
+			(end < len(key) && !strings.HasPrefix(key[end:], sep)) {
+			continue
+		}
+
+		lit(b.keyLit(key[pos:end], pos == 0))
+		switch b.g.info.KeyIndex {
+		case "dot":
+			lit(sep)
+		case "bracket":
+			lit("[")
+		case "pointer":
+			lit("/")
+		}
+		parts = append(parts, keypart{x: ki.x})
+		if b.g.info.KeyIndex == "bracket" {
+			lit("]")
+		}
+		pos = end
+	}
+	if pos < len(key) || len(parts) == 0 {
+		lit(b.keyLit(key[pos:], pos == 0))
+	}
+	return parts
+}
+
+// keyLit returns the given segment of a static field key formatted
+// according to the configured index style. The first argument
+// reports whether or not s is the beginning of the key.
+func (b *bb) keyLit(s string, first bool) string {
+	if b.g.info.KeyIndex != "pointer" {
+		return s
+	}
+
+	sep := b.g.info.KeySep
+	oldnew := []string{"~", "~0"}
+	if sep != "/" {
+		oldnew = append(oldnew, "/", "~1")
+	}
+	if len(sep) > 0 {
+		oldnew = append(oldnew, sep, "/")
+	}
+	s = strings.NewReplacer(oldnew...).Replace(s)
+	if first {
+		s = "/" + s
+	}
+	return s
+}
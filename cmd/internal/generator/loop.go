@@ -35,7 +35,11 @@ func (b *bb) arrayRangeClause(E *rules.Node) GO.ForRangeClause {
 	if E.HasRules() {
 		v = GO.Ident{"e" + strconv.Itoa(b.nloop)}
 	}
-	if E.Type.Kind != gotype.K_PTR && len(E.PreRules) > 0 {
+	if b.g.hasKeyIndex() && v.Name != "" {
+		// the index is needed for the elements' field keys,
+		// use a unique name so that nested loops can access it
+		k = GO.Ident{"i" + strconv.Itoa(b.nloop)}
+	} else if E.Type.Kind != gotype.K_PTR && len(E.PreRules) > 0 {
 		k = GO.Ident{"i"}
 	} else if v.Name != "" {
 		k = GO.Ident{"_"}
@@ -74,7 +78,8 @@ func (b *bb) mapRangeClause(K, E *rules.Node) GO.ForRangeClause {
 	if E.HasRules() {
 		v = GO.Ident{"e" + strconv.Itoa(b.nloop)}
 	}
-	if K.HasRules() || (E.Type.Kind != gotype.K_PTR && len(E.PreRules) > 0) {
+	if K.HasRules() || (E.Type.Kind != gotype.K_PTR && len(E.PreRules) > 0) ||
+		(b.g.hasKeyIndex() && v.Name != "") {
 		k = GO.Ident{"k" + strconv.Itoa(b.nloop)}
 	} else if v.Name != "" {
 		k = GO.Ident{"_"}
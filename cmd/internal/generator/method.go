@@ -1,74 +1,170 @@
 package generator
 
 import (
+	"strings"
+
 	"github.com/frk/valid/cmd/internal/global"
+	"github.com/frk/valid/cmd/internal/gotype"
 	"github.com/frk/valid/cmd/internal/rules"
 
 	GO "github.com/frk/ast/golang"
 )
 
-func methodAST(g *gg, info *rules.Info) (dec GO.MethodDecl) {
+// methodsAST produces the validation methods of the given validator. The
+// Validate method checks the rules that are not restricted to any validation
+// group, and for each of the groups referenced by the validator a separate
+// method, e.g. ValidateCreate, is produced that checks the group's rules.
+func methodsAST(g *gg, info *rules.Info) (decls []GO.TopLevelDeclNode) {
+	decls = append(decls, methodDecls(g, info, "Validate", info.RootNode)...)
+	for _, grp := range info.Groups {
+		name := "Validate" + strings.ToUpper(grp.Name[:1]) + grp.Name[1:]
+		decls = append(decls, methodDecls(g, info, name, grp.RootNode)...)
+	}
+	return decls
+}
+
+// methodDecls produces the named method and, if any of its hooks or rules
+// need a context, the method that delegates to its context variant. If the
+// partial methods are enabled, the same is done for the partial variant of
+// the named method, e.g. ValidatePartial.
+func methodDecls(g *gg, info *rules.Info, name string, root *rules.Node) (decls []GO.TopLevelDeclNode) {
+	defer func() { g.partial = false }()
+
+	// The generator modifies the nodes it visits, therefore
+	// the partial variant needs its own copy of the tree.
+	roots := []*rules.Node{root}
+	if global.Partial {
+		roots = append(roots, copyNode(root, nil))
+	}
+
+	for i, root := range roots {
+		g.partial = i > 0
+		mname := name
+		if g.partial {
+			mname += "Partial"
+		}
+		if needsContext(info, root) {
+			decls = append(decls, contextMethodAST(g, info, mname))
+		}
+		decls = append(decls, methodAST(g, info, mname, root))
+	}
+	return decls
+}
+
+func methodAST(g *gg, info *rules.Info, name string, root *rules.Node) (dec GO.MethodDecl) {
 	g.info = info
 	g.recv = GO.Ident{"v"}
 
 	dec.Recv.Name = g.recv.(GO.Ident)
 	dec.Recv.Type = GO.Ident{g.info.Validator.Type.Name}
-	dec.Name.Name = "Validate"
+	dec.Name.Name = name
 	dec.Type.Results = GO.ParamList{{Type: ERROR}}
+	if needsContext(info, root) {
+		pkg := g.addImport(gotype.Pkg{Path: "context", Name: "context"})
+		dec.Name.Name = name + "Context"
+		dec.Type.Params = GO.ParamList{{Names: CTX, Type: pkgQualIdent(pkg, "Context")}}
+	}
+	if g.partial {
+		dec.Type.Params = append(dec.Type.Params, presentParam())
+	}
 
 	if global.ErrorAggregator != nil && info.Validator.ErrorHandlerField == nil {
 		newErrorAggregatorAST(info, g.block(&dec.Body))
+	} else if usesFieldErrors(info) {
+		newFieldErrorsAST(info, g.block(&dec.Body))
 	}
 	if before := info.Validator.BeforeValidateMethod; before != nil {
 		hookAST(before, g.block(&dec.Body))
 	}
 
-	nodesAST(info.RootNode, g.block(&dec.Body))
+	nodesAST(root, g.block(&dec.Body))
 	exitAST(info, g.block(&dec.Body))
 
 	return dec
 }
 
-func exitAST(info *rules.Info, b bb) {
-	stmt := GO.ReturnStmt{Result: NIL}
-	errh := info.Validator.ErrorHandlerField
-	after := info.Validator.AfterValidateMethod
+// contextMethodAST produces the named method, e.g. Validate, that delegates
+// to its context variant, e.g. ValidateContext, using the background context.
+func contextMethodAST(g *gg, info *rules.Info, name string) (dec GO.MethodDecl) {
+	g.info = info
+	g.recv = GO.Ident{"v"}
 
-	hasAgg := false
-	if (errh != nil && errh.IsAggregator) || (errh == nil && global.ErrorAggregator != nil) {
-		hasAgg = true
+	dec.Recv.Name = g.recv.(GO.Ident)
+	dec.Recv.Type = GO.Ident{g.info.Validator.Type.Name}
+	dec.Name.Name = name
+	dec.Type.Results = GO.ParamList{{Type: ERROR}}
+
+	pkg := g.addImport(gotype.Pkg{Path: "context", Name: "context"})
+	call := GO.CallExpr{Fun: GO.SelectorExpr{X: g.recv, Sel: GO.Ident{name + "Context"}}}
+	bg := GO.CallExpr{Fun: pkgQualIdent(pkg, "Background")}
+	call.Args.List = bg
+	if g.partial {
+		dec.Type.Params = GO.ParamList{presentParam()}
+		call.Args.List = GO.ExprList{bg, PRESENT}
 	}
+	dec.Body.Add(GO.ReturnStmt{Result: call})
+	return dec
+}
 
-	switch {
-	case hasAgg == true && after != nil:
-		call := GO.CallExpr{}
-		if h := info.Validator.ErrorHandlerField; h != nil && h.IsAggregator {
-			x := GO.SelectorExpr{X: b.g.recv, Sel: GO.Ident{h.Name}}
-			call.Fun = GO.SelectorExpr{X: x, Sel: GO.Ident{"Out"}}
-		} else if h == nil && global.ErrorAggregator != nil {
-			x := GO.SelectorExpr{X: GO.Ident{"ea"}, Sel: GO.Ident{"Out"}}
-			call.Fun = x
+// needsContext reports whether the validator's code should be generated
+// as a ValidateContext method, that is, whether any of the validator's
+// hooks or the rule functions of the given root node accept a context.Context.
+func needsContext(info *rules.Info, root *rules.Node) bool {
+	if h := info.Validator.BeforeValidateMethod; h != nil && h.HasContext {
+		return true
+	}
+	if h := info.Validator.AfterValidateMethod; h != nil && h.HasContext {
+		return true
+	}
+	return nodeNeedsContext(root)
+}
+
+func nodeNeedsContext(n *rules.Node) bool {
+	if n == nil {
+		return false
+	}
+	for _, list := range []rules.RuleList{n.PreRules, n.IsRules, n.CondRules} {
+		for _, r := range list {
+			if r.Spec.FType != nil && r.Spec.FType.HasContext {
+				return true
+			}
+			for _, a := range r.Alt {
+				if a.Spec.FType != nil && a.Spec.FType.HasContext {
+					return true
+				}
+			}
+		}
+	}
+	if nodeNeedsContext(n.Key) || nodeNeedsContext(n.Elem) {
+		return true
+	}
+	for _, f := range n.Fields {
+		if nodeNeedsContext(f.Type) {
+			return true
 		}
+	}
+	return false
+}
+
+func exitAST(info *rules.Info, b bb) {
+	stmt := GO.ReturnStmt{Result: NIL}
+	after := info.Validator.AfterValidateMethod
+	out := aggregatorOutAST(info, b)
 
+	switch {
+	case out != nil && after != nil:
 		ifs := new(GO.IfStmt)
-		ifs.Init = GO.AssignStmt{Token: GO.AssignDefine, Lhs: ERR, Rhs: call}
+		ifs.Init = GO.AssignStmt{Token: GO.AssignDefine, Lhs: ERR, Rhs: out}
 		ifs.Cond = GO.BinaryExpr{Op: GO.BinaryNeq, X: ERR, Y: NIL}
 		ifs.Body = GO.BlockStmt{[]GO.StmtNode{GO.ReturnStmt{ERR}}}
 
 		b.add(ifs)
 		hookAST(after, b)
 
-	case hasAgg == true && after == nil:
-		if h := info.Validator.ErrorHandlerField; h != nil && h.IsAggregator {
-			x := GO.SelectorExpr{X: b.g.recv, Sel: GO.Ident{h.Name}}
-			x = GO.SelectorExpr{X: x, Sel: GO.Ident{"Out"}}
-			stmt = GO.ReturnStmt{Result: GO.CallExpr{Fun: x}}
-		} else if h == nil && global.ErrorAggregator != nil {
-			x := GO.SelectorExpr{X: GO.Ident{"ea"}, Sel: GO.Ident{"Out"}}
-			stmt = GO.ReturnStmt{Result: GO.CallExpr{Fun: x}}
-		}
+	case out != nil && after == nil:
+		stmt = GO.ReturnStmt{Result: out}
 
-	case hasAgg == false && after != nil:
+	case out == nil && after != nil:
 		hookAST(after, b)
 
 	}
@@ -76,6 +172,29 @@ func exitAST(info *rules.Info, b bb) {
 	b.add(stmt)
 }
 
+// aggregatorOutAST produces the call expression that returns the aggregated
+// errors. If the validator's errors are not aggregated, nil is returned.
+func aggregatorOutAST(info *rules.Info, b bb) GO.ExprNode {
+	h := info.Validator.ErrorHandlerField
+	switch {
+	case h != nil && h.IsAggregator:
+		x := GO.SelectorExpr{X: b.g.recv, Sel: GO.Ident{h.Name}}
+		return GO.CallExpr{Fun: GO.SelectorExpr{X: x, Sel: GO.Ident{"Out"}}}
+	case h == nil && global.ErrorAggregator != nil:
+		return GO.CallExpr{Fun: GO.SelectorExpr{X: GO.Ident{"ea"}, Sel: GO.Ident{"Out"}}}
+	case usesFieldErrors(info):
+		return GO.CallExpr{Fun: GO.SelectorExpr{X: ERRS, Sel: GO.Ident{"Err"}}}
+	}
+	return nil
+}
+
+// usesFieldErrors reports whether the validator's
+// errors should be aggregated as valid.FieldErrors.
+func usesFieldErrors(info *rules.Info) bool {
+	return global.FieldErrors && info.Validator.ErrorHandlerField == nil &&
+		global.ErrorAggregator == nil && global.ErrorConstructor == nil
+}
+
 func newErrorAggregatorAST(info *rules.Info, b bb) {
 	agg := global.ErrorAggregator
 	pkg := b.g.addImport(agg.Pkg)
@@ -87,3 +206,11 @@ func newErrorAggregatorAST(info *rules.Info, b bb) {
 	b.add(GO.AssignStmt{Token: GO.AssignDefine, Lhs: GO.Ident{"ea"}, Rhs: call})
 	b.add(GO.NL{})
 }
+
+func newFieldErrorsAST(info *rules.Info, b bb) {
+	pkg := b.g.addImport(gotype.Pkg{Path: "github.com/frk/valid", Name: "valid"})
+
+	spec := GO.ValueSpec{Names: ERRS, Type: pkgQualIdent(pkg, "FieldErrors")}
+	b.add(GO.DeclStmt{GO.VarDecl{Spec: spec}})
+	b.add(GO.NL{})
+}
@@ -13,6 +13,18 @@ func nodesAST(n *rules.Node, b bb) {
 	if !n.HasRules() {
 		return
 	}
+	if n.Default != nil {
+		b.defaultStmt(n)
+		if !n.HasRules() {
+			return
+		}
+	}
+	if !n.CondRules.Empty() {
+		b.condruleStmt(n)
+		if !n.HasRules() {
+			return
+		}
+	}
 
 	switch {
 	case n.IsPtr() && n.IsOptional() && len(n.Base().PreRules) > 0:
@@ -92,16 +104,20 @@ func nodesAST(n *rules.Node, b bb) {
 	case n.Type.Is(gotype.K_ARRAY, gotype.K_SLICE):
 		b.nloop += 1
 		rc := b.arrayForStmt(n)
-		nodesAST(n.Elem, b.with(rc.Value))
+		nodesAST(n.Elem, b.withIndex(rc.Value, rc.Key, nil))
 
 	case n.Type.Is(gotype.K_MAP):
 		b.nloop += 1
 		rc := b.mapForStmt(n)
 		nodesAST(n.Key, b.with(rc.Key))
-		nodesAST(n.Elem, b.with(rc.Value))
+		nodesAST(n.Elem, b.withIndex(rc.Value, rc.Key, n.Key.Type))
 
 	case n.Type.Is(gotype.K_STRUCT):
 		for _, f := range n.Fields {
+			if b.g.partial && !f.Field.IsEmbedded && b.nloop == 0 {
+				b.presentStmt(f)
+				continue
+			}
 			nodesAST(f.Type, b.field(f))
 		}
 	default:
@@ -0,0 +1,62 @@
+package generator
+
+import (
+	"github.com/frk/valid/cmd/internal/rules"
+
+	GO "github.com/frk/ast/golang"
+)
+
+// presentStmt produces the code of the given field wrapped in an if-statement
+// that checks, using the partial method's "present" function, whether or not
+// the field is present. The field's rules, including "required", are therefore
+// not applied to absent fields.
+func (b *bb) presentStmt(f *rules.FieldNode) {
+	if !f.Type.HasRules() {
+		return
+	}
+
+	fb := b.field(f)
+	ifs := &GO.IfStmt{}
+	ifs.Cond = GO.CallExpr{Fun: PRESENT, Args: GO.ArgsList{List: fb.keyExpr()}}
+
+	fb.add(ifs)
+	fb.use(&ifs.Body)
+	fb.ast = &ifs.Body
+	nodesAST(f.Type, fb)
+}
+
+// presentParam returns the parameter of the partial validation
+// method that reports whether or not the keyed field is present.
+func presentParam() GO.Param {
+	return GO.Param{Names: PRESENT, Type: GO.FuncType{
+		Params:  GO.ParamList{{Names: GO.Ident{"key"}, Type: GO.Ident{"string"}}},
+		Results: GO.ParamList{{Type: GO.Ident{"bool"}}},
+	}}
+}
+
+// copyNode returns a copy of the given Node tree whose nodes can be
+// modified without affecting the original tree. The ptr argument is
+// the copy of the Node that points to n, or nil.
+func copyNode(n *rules.Node, ptr *rules.Node) *rules.Node {
+	if n == nil {
+		return nil
+	}
+
+	c := *n
+	c.Ptr = ptr
+	c.Key = copyNode(n.Key, nil)
+	if n.Elem != nil && n.Elem.Ptr == n {
+		c.Elem = copyNode(n.Elem, &c)
+	} else {
+		c.Elem = copyNode(n.Elem, nil)
+	}
+	if n.Fields != nil {
+		c.Fields = make([]*rules.FieldNode, len(n.Fields))
+		for i, f := range n.Fields {
+			fc := *f
+			fc.Type = copyNode(f.Type, nil)
+			c.Fields[i] = &fc
+		}
+	}
+	return &c
+}
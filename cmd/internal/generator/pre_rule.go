@@ -36,16 +36,16 @@ func (b *bb) preprocCall(n *rules.Node) GO.CallExpr {
 	}
 
 	r := n.PreRules[0]
-	pkg := b.g.addImport(r.Spec.FType.Pkg)
-	args := append(GO.ExprList{b.val}, b.g.argmap[r]...)
+	fn := b.funcExpr(r)
+	args := funcArgs(r, b.val, b.g.argmap[r]...)
 
-	call := GO.CallExpr{Fun: pkgQualIdent(pkg, r.Spec.FName)}
+	call := GO.CallExpr{Fun: fn}
 	call.Args.List = args
 	for _, r := range n.PreRules[1:] {
-		pkg := b.g.addImport(r.Spec.FType.Pkg)
-		args := append(GO.ExprList{call}, b.g.argmap[r]...)
+		fn := b.funcExpr(r)
+		args := funcArgs(r, call, b.g.argmap[r]...)
 
-		call = GO.CallExpr{Fun: pkgQualIdent(pkg, r.Spec.FName)}
+		call = GO.CallExpr{Fun: fn}
 		call.Args.List = args
 	}
 	return call
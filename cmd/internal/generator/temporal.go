@@ -0,0 +1,125 @@
+package generator
+
+import (
+	"time"
+
+	"github.com/frk/valid/cmd/internal/gotype"
+	"github.com/frk/valid/cmd/internal/rules"
+
+	GO "github.com/frk/ast/golang"
+)
+
+// used for adding pkgimport
+var timepkg = gotype.Pkg{Path: "time", Name: "time"}
+
+// the time.Duration type, used as the target type of the "within" rule's argument
+var durationType = &gotype.Type{Kind: gotype.K_INT64, Name: "Duration", Pkg: timepkg}
+
+// builds an expression that compares the time value against the rule's
+// argument, or against the current time if the rule takes no arguments.
+func (b *bb) temporalCondExpr(n *rules.Node, r *rules.Rule) GO.ExprNode {
+	pkg := b.g.addImport(timepkg)
+	now := GO.CallExpr{Fun: pkgQualIdent(pkg, "Now")}
+
+	x := b.val
+	if n.PtrDepth() > 0 && r.Name != "within" {
+		x = GO.ParenExpr{x}
+	}
+
+	var meth string
+	var arg GO.ExprNode
+	switch r.Name {
+	case "before":
+		meth, arg = "Before", b.g.argmap[r][0]
+	case "after":
+		meth, arg = "After", b.g.argmap[r][0]
+	case "future":
+		meth, arg = "After", now
+	case "past":
+		meth, arg = "Before", now
+	case "within":
+		since := GO.CallExpr{Fun: pkgQualIdent(pkg, "Since"), Args: GO.ArgsList{List: x}}
+		abs := GO.CallExpr{Fun: GO.SelectorExpr{X: since, Sel: GO.Ident{"Abs"}}}
+		return GO.BinaryExpr{Op: GO.BinaryGtr, X: abs, Y: b.g.argmap[r][0]}
+	default:
+		panic("shouldn't reach")
+	}
+
+	call := GO.CallExpr{Fun: GO.SelectorExpr{X: x, Sel: GO.Ident{meth}}}
+	call.Args.List = arg
+	return GO.UnaryExpr{Op: GO.UnaryNot, X: call}
+}
+
+// timeArg returns an expression that produces the time.Time
+// or time.Duration value of the given literal argument. If the
+// argument cannot be converted to a value of type t, nil is returned.
+func (b *bb) timeArg(a *rules.Arg, t *gotype.Type) GO.ExprNode {
+	if t.IsDuration() {
+		if d, ok := rules.ParseDurationArg(a); ok {
+			return b.durationExpr(d)
+		}
+	}
+	if t.IsTime() {
+		if tt, ok := rules.ParseTimeArg(a); ok {
+			return b.timeExpr(tt)
+		}
+	}
+	return nil
+}
+
+// durationExpr returns an expression that produces the given duration
+// as a multiple of the largest time unit that it is divisible by.
+func (b *bb) durationExpr(d time.Duration) GO.ExprNode {
+	if d == 0 {
+		return GO.IntLit(0)
+	}
+
+	pkg := b.g.addImport(timepkg)
+	units := []struct {
+		d    time.Duration
+		name string
+	}{
+		{time.Hour, "Hour"},
+		{time.Minute, "Minute"},
+		{time.Second, "Second"},
+		{time.Millisecond, "Millisecond"},
+		{time.Microsecond, "Microsecond"},
+	}
+	for _, u := range units {
+		if d%u.d != 0 {
+			continue
+		}
+		if d == u.d {
+			return pkgQualIdent(pkg, u.name)
+		}
+		return GO.BinaryExpr{Op: GO.BinaryMul, X: GO.IntLit(d / u.d), Y: pkgQualIdent(pkg, u.name)}
+	}
+	return GO.CallExpr{Fun: pkgQualIdent(pkg, "Duration"), Args: GO.ArgsList{List: GO.IntLit(d)}}
+}
+
+// timeExpr returns a time.Date call expression that produces the given time in UTC.
+func (b *bb) timeExpr(t time.Time) GO.ExprNode {
+	pkg := b.g.addImport(timepkg)
+	t = t.UTC()
+
+	args := GO.ExprList{
+		GO.IntLit(t.Year()),
+		pkgQualIdent(pkg, t.Month().String()),
+		GO.IntLit(t.Day()),
+		GO.IntLit(t.Hour()),
+		GO.IntLit(t.Minute()),
+		GO.IntLit(t.Second()),
+		GO.IntLit(t.Nanosecond()),
+		pkgQualIdent(pkg, "UTC"),
+	}
+	return GO.CallExpr{Fun: pkgQualIdent(pkg, "Date"), Args: GO.ArgsList{List: args}}
+}
+
+// isTimeArg reports whether or not the given argument
+// of the rule is a time or a duration literal.
+func isTimeArg(n *rules.Node, r *rules.Rule, a *rules.Arg) bool {
+	if a.Type != rules.ARG_STRING {
+		return false
+	}
+	return r.Spec.Kind == rules.TEMPORAL || (r.Spec.Kind == rules.ORDERED && n.Type.IsDuration())
+}
@@ -0,0 +1,43 @@
+// DO NOT EDIT. This file was generated by "github.com/frk/valid".
+
+package testdata
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/frk/valid"
+)
+
+func (v T01Validator) Validate() error {
+	for i1, e1 := range v.Emails {
+		if !valid.Email(e1) {
+			return fmt.Errorf("%s must be a valid email address", "emails."+strconv.Itoa(i1))
+		}
+	}
+	for k1, e1 := range v.Phones {
+		if !valid.Phone(e1, "us") {
+			return fmt.Errorf("%s must be a valid phone number", "phones."+k1)
+		}
+	}
+	for i1, e1 := range v.Addresses {
+		if e1.City == "" {
+			return fmt.Errorf("%s is required", "addresses."+strconv.Itoa(i1)+".city")
+		}
+	}
+	for i1, e1 := range v.Matrix {
+		for i2, e2 := range e1 {
+			if e2 < 1 {
+				return fmt.Errorf("%s must be greater than or equal to: 1", "matrix."+strconv.Itoa(i1)+"."+strconv.Itoa(i2))
+			}
+		}
+	}
+	for k1, e1 := range v.Scores {
+		for i2, e2 := range e1 {
+			if e2 < 0 || e2 > v.Max {
+				return fmt.Errorf("%s must be between: 0 and %v", "scores."+fmt.Sprint(k1)+"."+strconv.Itoa(i2), v.Max)
+			}
+		}
+	}
+	return nil
+}
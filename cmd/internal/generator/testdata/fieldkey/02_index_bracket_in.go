@@ -0,0 +1,14 @@
+package testdata
+
+type T02Validator struct {
+	Emails    []string          `json:"emails" is:"[]email"`
+	Phones    map[string]string `json:"phones" is:"[]phone"`
+	Addresses []struct {
+		City string `json:"city" is:"required"`
+	} `json:"addresses"`
+	Matrix [][]int         `json:"matrix" is:"[][]min:1"`
+	Scores map[Code2][]int `json:"scores" is:"[][]rng:0:&max"`
+	Max    int             `json:"max"`
+}
+
+type Code2 string
@@ -0,0 +1,15 @@
+package testdata
+
+type T03Validator struct {
+	Name      string            `json:"name" is:"required"`
+	Emails    []string          `json:"emails" is:"[]email"`
+	Phones    map[string]string `json:"phones" is:"[]phone"`
+	Addresses []struct {
+		City string `json:"city" is:"required"`
+	} `json:"addresses"`
+	Matrix [][]int         `json:"matrix" is:"[][]min:1"`
+	Scores map[Code3][]int `json:"scores" is:"[][]rng:0:&max"`
+	Max    int             `json:"max"`
+}
+
+type Code3 string
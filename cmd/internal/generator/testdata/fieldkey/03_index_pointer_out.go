@@ -0,0 +1,48 @@
+// DO NOT EDIT. This file was generated by "github.com/frk/valid".
+
+package testdata
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/frk/valid"
+)
+
+func (v T03Validator) Validate() error {
+	if v.Name == "" {
+		return errors.New("/name is required")
+	}
+	for i1, e1 := range v.Emails {
+		if !valid.Email(e1) {
+			return fmt.Errorf("%s must be a valid email address", "/emails/"+strconv.Itoa(i1))
+		}
+	}
+	for k1, e1 := range v.Phones {
+		if !valid.Phone(e1, "us") {
+			return fmt.Errorf("%s must be a valid phone number", "/phones/"+strings.ReplaceAll(strings.ReplaceAll(k1, "~", "~0"), "/", "~1"))
+		}
+	}
+	for i1, e1 := range v.Addresses {
+		if e1.City == "" {
+			return fmt.Errorf("%s is required", "/addresses/"+strconv.Itoa(i1)+"/city")
+		}
+	}
+	for i1, e1 := range v.Matrix {
+		for i2, e2 := range e1 {
+			if e2 < 1 {
+				return fmt.Errorf("%s must be greater than or equal to: 1", "/matrix/"+strconv.Itoa(i1)+"/"+strconv.Itoa(i2))
+			}
+		}
+	}
+	for k1, e1 := range v.Scores {
+		for i2, e2 := range e1 {
+			if e2 < 0 || e2 > v.Max {
+				return fmt.Errorf("%s must be between: 0 and %v", "/scores/"+strings.ReplaceAll(strings.ReplaceAll(string(k1), "~", "~0"), "/", "~1")+"/"+strconv.Itoa(i2), v.Max)
+			}
+		}
+	}
+	return nil
+}
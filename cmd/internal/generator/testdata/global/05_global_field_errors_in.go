@@ -0,0 +1,13 @@
+package testdata
+
+type T05Validator struct {
+	F1 string   `is:"required"`
+	F2 []string `is:"required,len::9"`
+	F3 *int     `is:"rng:1:&F4"`
+	F4 int
+	F5 string `is:"email"`
+}
+
+func (v T05Validator) AfterValidate() error {
+	return nil
+}
@@ -0,0 +1,35 @@
+// DO NOT EDIT. This file was generated by "github.com/frk/valid".
+
+package testdata
+
+import (
+	"fmt"
+
+	"github.com/frk/valid"
+)
+
+func (v T05Validator) Validate() error {
+	var errs valid.FieldErrors
+
+	if v.F1 == "" {
+		errs.Add("F1", v.F1, "required", "F1 is required")
+	}
+	if len(v.F2) == 0 {
+		errs.Add("F2", v.F2, "required", "F2 is required")
+	} else if len(v.F2) > 9 {
+		errs.Add("F2", v.F2, "len", "F2 must be of length at most: 9", "", 9)
+	}
+	if v.F3 != nil && (*v.F3 < 1 || *v.F3 > v.F4) {
+		errs.Add("F3", v.F3, "rng", fmt.Sprintf("F3 must be between: 1 and %v", v.F4), 1, v.F4)
+	}
+	if !valid.Email(v.F5) {
+		errs.Add("F5", v.F5, "email", "F5 must be a valid email address")
+	}
+	if err := errs.Err(); err != nil {
+		return err
+	}
+	if err := v.AfterValidate(); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+package testdata
+
+type T06aValidator struct {
+	Email string `is:"required@create,email"`
+	Name  string `is:"len:1:64" is.update:"required"`
+	Age   int    `is:"min:18" is.create:"max:130"`
+}
+
+func (v T06aValidator) AfterValidate() error {
+	return nil
+}
+
+type T06bValidator struct {
+	ID   string `is:"required@update" is.delete:"required"`
+	Code string `is:"required,len:6" is.update:"rctx"`
+}
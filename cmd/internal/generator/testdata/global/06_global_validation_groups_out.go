@@ -0,0 +1,104 @@
+// DO NOT EDIT. This file was generated by "github.com/frk/valid".
+
+package testdata
+
+import (
+	"context"
+	"errors"
+
+	"github.com/frk/valid"
+	"github.com/frk/valid/cmd/internal/generator/testdata/mypkg"
+)
+
+func (v T06aValidator) Validate() error {
+	if !valid.Email(v.Email) {
+		return errors.New("Email must be a valid email address")
+	}
+	if len(v.Name) < 1 || len(v.Name) > 64 {
+		return errors.New("Name must be of length between: 1 and 64 (inclusive)")
+	}
+	if v.Age < 18 {
+		return errors.New("Age must be greater than or equal to: 18")
+	}
+	if err := v.AfterValidate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (v T06aValidator) ValidateCreate() error {
+	if v.Email == "" {
+		return errors.New("Email is required")
+	} else if !valid.Email(v.Email) {
+		return errors.New("Email must be a valid email address")
+	}
+	if len(v.Name) < 1 || len(v.Name) > 64 {
+		return errors.New("Name must be of length between: 1 and 64 (inclusive)")
+	}
+	if v.Age < 18 {
+		return errors.New("Age must be greater than or equal to: 18")
+	} else if v.Age > 130 {
+		return errors.New("Age must be less than or equal to: 130")
+	}
+	if err := v.AfterValidate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (v T06aValidator) ValidateUpdate() error {
+	if !valid.Email(v.Email) {
+		return errors.New("Email must be a valid email address")
+	}
+	if v.Name == "" {
+		return errors.New("Name is required")
+	} else if len(v.Name) < 1 || len(v.Name) > 64 {
+		return errors.New("Name must be of length between: 1 and 64 (inclusive)")
+	}
+	if v.Age < 18 {
+		return errors.New("Age must be greater than or equal to: 18")
+	}
+	if err := v.AfterValidate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (v T06bValidator) Validate() error {
+	if v.Code == "" {
+		return errors.New("Code is required")
+	} else if len(v.Code) != 6 {
+		return errors.New("Code must be of length: 6")
+	}
+	return nil
+}
+
+func (v T06bValidator) ValidateUpdate() error {
+	return v.ValidateUpdateContext(context.Background())
+}
+
+func (v T06bValidator) ValidateUpdateContext(ctx context.Context) error {
+	if v.ID == "" {
+		return errors.New("ID is required")
+	}
+	if v.Code == "" {
+		return errors.New("Code is required")
+	} else if len(v.Code) != 6 {
+		return errors.New("Code must be of length: 6")
+	} else if !mypkg.RuleWithCtx(ctx, v.Code) {
+		return errors.New("Code is not valid")
+	}
+	return nil
+}
+
+func (v T06bValidator) ValidateDelete() error {
+	if v.ID == "" {
+		return errors.New("ID is required")
+	}
+	if v.Code == "" {
+		return errors.New("Code is required")
+	} else if len(v.Code) != 6 {
+		return errors.New("Code must be of length: 6")
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+package testdata
+
+type T07aValidator struct {
+	Name    string   `is:"required,len:1:64"`
+	Email   string   `is:"required,email"`
+	Tags    []string `is:"[]required"`
+	Address struct {
+		City string `is:"required"`
+		Zip  string `is:"len:5"`
+	}
+	Note string
+}
+
+type T07bValidator struct {
+	Code string `is:"required" is.update:"rctx"`
+}
@@ -0,0 +1,117 @@
+// DO NOT EDIT. This file was generated by "github.com/frk/valid".
+
+package testdata
+
+import (
+	"context"
+	"errors"
+
+	"github.com/frk/valid"
+	"github.com/frk/valid/cmd/internal/generator/testdata/mypkg"
+)
+
+func (v T07aValidator) Validate() error {
+	if v.Name == "" {
+		return errors.New("Name is required")
+	} else if len(v.Name) < 1 || len(v.Name) > 64 {
+		return errors.New("Name must be of length between: 1 and 64 (inclusive)")
+	}
+	if v.Email == "" {
+		return errors.New("Email is required")
+	} else if !valid.Email(v.Email) {
+		return errors.New("Email must be a valid email address")
+	}
+	for _, e1 := range v.Tags {
+		if e1 == "" {
+			return errors.New("Tags is required")
+		}
+	}
+	if v.Address.City == "" {
+		return errors.New("Address.City is required")
+	}
+	if len(v.Address.Zip) != 5 {
+		return errors.New("Address.Zip must be of length: 5")
+	}
+	return nil
+}
+
+func (v T07aValidator) ValidatePartial(present func(key string) bool) error {
+	if present("Name") {
+		if v.Name == "" {
+			return errors.New("Name is required")
+		} else if len(v.Name) < 1 || len(v.Name) > 64 {
+			return errors.New("Name must be of length between: 1 and 64 (inclusive)")
+		}
+	}
+	if present("Email") {
+		if v.Email == "" {
+			return errors.New("Email is required")
+		} else if !valid.Email(v.Email) {
+			return errors.New("Email must be a valid email address")
+		}
+	}
+	if present("Tags") {
+		for _, e1 := range v.Tags {
+			if e1 == "" {
+				return errors.New("Tags is required")
+			}
+		}
+	}
+	if present("Address") {
+		if present("Address.City") {
+			if v.Address.City == "" {
+				return errors.New("Address.City is required")
+			}
+		}
+		if present("Address.Zip") {
+			if len(v.Address.Zip) != 5 {
+				return errors.New("Address.Zip must be of length: 5")
+			}
+		}
+	}
+	return nil
+}
+
+func (v T07bValidator) Validate() error {
+	if v.Code == "" {
+		return errors.New("Code is required")
+	}
+	return nil
+}
+
+func (v T07bValidator) ValidatePartial(present func(key string) bool) error {
+	if present("Code") {
+		if v.Code == "" {
+			return errors.New("Code is required")
+		}
+	}
+	return nil
+}
+
+func (v T07bValidator) ValidateUpdate() error {
+	return v.ValidateUpdateContext(context.Background())
+}
+
+func (v T07bValidator) ValidateUpdateContext(ctx context.Context) error {
+	if v.Code == "" {
+		return errors.New("Code is required")
+	} else if !mypkg.RuleWithCtx(ctx, v.Code) {
+		return errors.New("Code is not valid")
+	}
+	return nil
+}
+
+func (v T07bValidator) ValidateUpdatePartial(present func(key string) bool) error {
+	return v.ValidateUpdatePartialContext(context.Background(), present)
+}
+
+func (v T07bValidator) ValidateUpdatePartialContext(ctx context.Context, present func(key string) bool) error {
+	if present("Code") {
+		if v.Code == "" {
+			return errors.New("Code is required")
+		} else if !mypkg.RuleWithCtx(ctx, v.Code) {
+			return errors.New("Code is not valid")
+		}
+	}
+	return nil
+}
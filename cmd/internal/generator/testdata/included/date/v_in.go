@@ -0,0 +1,8 @@
+package testdata
+
+type Validator struct {
+	F1 string  `is:"date"`
+	F2 *string `is:"date:rfc1123"`
+	F3 string  `is:"date:\"02/01/2006 15:04\""`
+	F4 *string `is:"date:2006-01"`
+}
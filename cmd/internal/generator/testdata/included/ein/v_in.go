@@ -1 +1,6 @@
 package testdata
+
+type Validator struct {
+	F1 string  `is:"ein"`
+	F2 *string `is:"ein"`
+}
@@ -0,0 +1,7 @@
+package testdata
+
+type Validator struct {
+	F1 string  `is:"ic:es"`
+	F2 *string `is:"ic:it"`
+	F3 string  `is:"ic:pol"`
+}
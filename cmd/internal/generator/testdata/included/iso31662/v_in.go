@@ -0,0 +1,11 @@
+package testdata
+
+type Validator struct {
+	F1 string  `is:"iso31662"`
+	F2 *string `is:"iso31662"`
+	F3 string  `is:"iso31662:us"`
+	F4 *string `is:"iso31662:can"`
+
+	Country string
+	State   string `is:"iso31662:&Country"`
+}
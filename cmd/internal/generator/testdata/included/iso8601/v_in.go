@@ -0,0 +1,7 @@
+package testdata
+
+type Validator struct {
+	F1 string  `is:"iso8601"`
+	F2 *string `is:"iso8601:strict"`
+	F3 string  `is:"iso8601:true"`
+}
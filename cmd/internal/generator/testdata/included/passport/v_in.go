@@ -0,0 +1,8 @@
+package testdata
+
+type Validator struct {
+	F1 string  `is:"passport"`
+	F2 *string `is:"passport"`
+	F3 string  `is:"passport:de"`
+	F4 *string `is:"passport:jpn"`
+}
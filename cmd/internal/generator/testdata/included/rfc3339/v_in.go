@@ -0,0 +1,6 @@
+package testdata
+
+type Validator struct {
+	F1 string  `is:"rfc3339"`
+	F2 *string `is:"rfc3339"`
+}
@@ -0,0 +1,6 @@
+package testdata
+
+type Validator struct {
+	F1 string  `is:"timezone"`
+	F2 *string `is:"timezone"`
+}
@@ -0,0 +1,13 @@
+package testdata
+
+import (
+	"github.com/frk/valid"
+)
+
+type Validator struct {
+	F1 string  `is:"url"`
+	F2 *string `is:"url"`
+	F3 string  `is:"url:&urlOpts"`
+
+	urlOpts *valid.URLOpts
+}
@@ -0,0 +1,12 @@
+package testdata
+
+import (
+	"time"
+)
+
+type Validator struct {
+	StartsAt time.Time
+	F1       time.Time   `is:"after:&StartsAt"`
+	F2       *time.Time  `is:"after:2006-01-02,required"`
+	F3       []time.Time `is:"[]after:\"2006-01-02T15:04:05.5\""`
+}
@@ -0,0 +1,9 @@
+package testdata
+
+type Validator struct {
+	F1 string   `is:"(ip|fqdn)"`
+	F2 *string  `is:"(len:2:8|!contains:x)"`
+	F3 int      `is:"required,(eq:&F4|gte:10)"`
+	F4 int      `is:"(lt:0|gt:100)"`
+	F5 []string `is:"[](email|eq:\"root\"|numeric)"`
+}
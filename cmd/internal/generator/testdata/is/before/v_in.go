@@ -0,0 +1,12 @@
+package testdata
+
+import (
+	"time"
+)
+
+type Validator struct {
+	F1 time.Time  `is:"before:2030-01-01"`
+	F2 *time.Time `is:"before:\"2030-01-01T12:30:00+02:00\""`
+	F3 time.Time  `is:"before:&F4"`
+	F4 time.Time
+}
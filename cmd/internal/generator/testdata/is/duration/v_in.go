@@ -0,0 +1,12 @@
+package testdata
+
+import (
+	"time"
+)
+
+type Validator struct {
+	F1 time.Duration  `is:"max:30m"`
+	F2 *time.Duration `is:"min:1500ms,max:2h"`
+	F3 time.Duration  `is:"gt:0s,lt:1h30m"`
+	F4 time.Duration  `is:"lte:1001ns"`
+}
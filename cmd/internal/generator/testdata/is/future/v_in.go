@@ -0,0 +1,11 @@
+package testdata
+
+import (
+	"time"
+)
+
+type Validator struct {
+	F1 time.Time  `is:"future"`
+	F2 *time.Time `is:"future"`
+	F3 time.Time  `is:"required,future"`
+}
@@ -0,0 +1,10 @@
+package testdata
+
+type Validator struct {
+	F1 string   `is:"!contains:admin"`
+	F2 int      `is:"!eq:0,!gt:100"`
+	F3 *string  `is:"!email"`
+	F4 string   `is:"!len:2:8"`
+	F5 []string `is:"[]!prefix:tmp_"`
+	F6 float64  `is:"!rng:-1:1"`
+}
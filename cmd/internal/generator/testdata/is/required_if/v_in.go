@@ -0,0 +1,15 @@
+package testdata
+
+type Validator struct {
+	Method string
+	Amount int
+	Card   struct {
+		Type   string
+		Number string `is:"required_if:.Type:visa:mastercard"`
+	}
+
+	F1 string  `is:"required_if:&Method:card"`
+	F2 *string `is:"required_if:&Method:card:paypal"`
+	F3 []byte  `is:"required_if:&Amount:0,len:4"`
+	F4 *string `is:"required_if:&Method:card,email"`
+}
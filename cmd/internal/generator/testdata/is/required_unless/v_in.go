@@ -0,0 +1,11 @@
+package testdata
+
+type Validator struct {
+	Method string
+	Manual bool
+
+	F1 string      `is:"required_unless:&Method:cash"`
+	F2 *float64    `is:"required_unless:&Method:cash:voucher"`
+	F3 []string    `is:"required_unless:&Manual:true"`
+	F4 interface{} `is:"required_unless:&Manual:true,required_unless:&Method:cash"`
+}
@@ -0,0 +1,15 @@
+package testdata
+
+type Validator struct {
+	Street  string
+	City    *string
+	Zip     []byte
+	Address struct {
+		Line1 string
+		Line2 string `is:"required_with:.Line1"`
+	}
+
+	F1 string  `is:"required_with:&Street"`
+	F2 *string `is:"required_with:&Street:&City"`
+	F3 int     `is:"required_with:&Zip,gt:0"`
+}
@@ -0,0 +1,10 @@
+package testdata
+
+type Validator struct {
+	Email string
+	Phone *string
+
+	F1 string            `is:"required_without:&Phone"`
+	F2 *string           `is:"required_without:&Email:&Phone"`
+	F3 map[string]string `is:"required_without:&Email"`
+}
@@ -0,0 +1,17 @@
+package testdata
+
+type Item struct {
+	ID   int
+	Tags []string
+}
+
+type Validator struct {
+	F1 []string          `is:"unique"`
+	F2 [4]int            `is:"unique"`
+	F3 map[string]string `is:"unique"`
+	F4 *[]float64        `is:"unique"`
+	F5 []Item            `is:"unique:ID"`
+	F6 map[int]Item      `is:"unique:ID"`
+	F7 [][]string        `is:"[]unique"`
+	F8 []string          `is:"unique,[]len:1:8"`
+}
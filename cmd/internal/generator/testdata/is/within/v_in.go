@@ -0,0 +1,10 @@
+package testdata
+
+import (
+	"time"
+)
+
+type Validator struct {
+	F1 time.Time  `is:"within:24h"`
+	F2 *time.Time `is:"within:1h30m"`
+}
@@ -1,5 +1,9 @@
 package mypkg
 
+import (
+	"context"
+)
+
 func HasUniqueInts(v []int, vv ...[]int) bool {
 	// ...
 	return false
@@ -39,3 +43,40 @@ func PreWithOpt2(v string, opts string) string {
 	// ...
 	return v
 }
+
+func RuleWithCtx(ctx context.Context, v string) bool {
+	// ...
+	return false
+}
+
+func RuleWithCtxErr(ctx context.Context, v string, x int) (ok bool, err error) {
+	// ...
+	return false, nil
+}
+
+func PreWithCtx(ctx context.Context, v string) string {
+	// ...
+	return v
+}
+
+type UserRepo struct {
+	// ...
+}
+
+func (r *UserRepo) IsUniqueEmail(v string) (ok bool, err error) {
+	// ...
+	return false, nil
+}
+
+type Entitlements interface {
+	Allows(ctx context.Context, v string, level int) bool
+}
+
+type Normalizer struct {
+	// ...
+}
+
+func (Normalizer) Normalize(v string) string {
+	// ...
+	return v
+}
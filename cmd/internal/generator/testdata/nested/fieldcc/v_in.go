@@ -0,0 +1,22 @@
+package testdata
+
+type CountryCode string
+
+type Validator struct {
+	Country string
+	Zip     string  `is:"zip:&Country"`
+	Phone   *string `is:"phone:&Country"`
+	VAT     string  `is:"vat:&Address.Country"`
+	Address struct {
+		Country  CountryCode
+		Zip      string `is:"zip:.Country"`
+		State    string `is:"iso31662:.Country"`
+		IC       string `is:"ic:&Address.Country"`
+		Passport string `is:"passport:&Country"`
+	}
+	Items []struct {
+		Country    string
+		PostalCode string `is:"zip:.Country"`
+		Phone      string `is:"phone:.Country"`
+	}
+}
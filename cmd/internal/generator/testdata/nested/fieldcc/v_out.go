@@ -0,0 +1,42 @@
+// DO NOT EDIT. This file was generated by "github.com/frk/valid".
+
+package testdata
+
+import (
+	"fmt"
+
+	"github.com/frk/valid"
+)
+
+func (v Validator) Validate() error {
+	if !valid.Zip(v.Zip, v.Country) {
+		return fmt.Errorf("Zip must be a valid zip code: %v", v.Country)
+	}
+	if v.Phone != nil && !valid.Phone(*v.Phone, v.Country) {
+		return fmt.Errorf("Phone must be a valid phone number: %v", v.Country)
+	}
+	if !valid.VAT(v.VAT, string(v.Address.Country)) {
+		return fmt.Errorf("VAT must be a valid VAT number: %v", v.Address.Country)
+	}
+	if !valid.Zip(v.Address.Zip, string(v.Address.Country)) {
+		return fmt.Errorf("Address.Zip must be a valid zip code: %v", v.Address.Country)
+	}
+	if !valid.ISO31662(v.Address.State, string(v.Address.Country)) {
+		return fmt.Errorf("Address.State must be a valid ISO 3166-2 subdivision code: %v", v.Address.Country)
+	}
+	if !valid.IdentityCard(v.Address.IC, string(v.Address.Country)) {
+		return fmt.Errorf("Address.IC must be a valid identity card number: %v", v.Address.Country)
+	}
+	if !valid.PassportNumber(v.Address.Passport, v.Country) {
+		return fmt.Errorf("Address.Passport must be a valid passport number: %v", v.Country)
+	}
+	for _, e1 := range v.Items {
+		if !valid.Zip(e1.PostalCode, e1.Country) {
+			return fmt.Errorf("Items.PostalCode must be a valid zip code: %v", e1.Country)
+		}
+		if !valid.Phone(e1.Phone, e1.Country) {
+			return fmt.Errorf("Items.Phone must be a valid phone number: %v", e1.Country)
+		}
+	}
+	return nil
+}
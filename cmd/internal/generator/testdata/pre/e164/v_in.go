@@ -0,0 +1,8 @@
+package testdata
+
+type Validator struct {
+	F1 string  `pre:"e164"`
+	F2 *string `pre:"e164"`
+	F3 string  `pre:"e164:gb"`
+	F4 *string `pre:"trim,e164:jp" is:"phone:jp"`
+}
@@ -3,7 +3,6 @@
 package testdata
 
 import (
-	"errors"
 	"fmt"
 	"strings"
 
@@ -18,7 +17,7 @@ func (v T46aValidator) Validate() error {
 		return fmt.Errorf("F2 must be between: %v and %v", v.Min, v.Max)
 	}
 	if !valid.Phone(v.F3, v.SomeValue) {
-		return errors.New("F3 must be a valid phone number")
+		return fmt.Errorf("F3 must be a valid phone number: %v", v.SomeValue)
 	}
 	if !strings.Contains(v.F4, v.SomeValue) && !strings.Contains(v.F4, "bar") && !strings.Contains(v.F4, "baz") {
 		return fmt.Errorf("F4 must contain substring: %v or \"bar\" or \"baz\"", v.SomeValue)
@@ -0,0 +1,14 @@
+package testdata
+
+type Status string
+
+type DefaultValidator struct {
+	F1 string   `default:"foo"`
+	F2 int      `is:"gt:0" default:"10"`
+	F3 *float64 `default:"0.5"`
+	F4 **bool   `default:"true"`
+	F5 *string  `is:"len:3" default:"bar"`
+	F6 string   `is:"len:1:10" pre:"trim" default:"active"`
+	F7 string   `default:"&F1"`
+	F8 *Status  `default:".F6"`
+}
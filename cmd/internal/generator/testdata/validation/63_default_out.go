@@ -0,0 +1,61 @@
+// DO NOT EDIT. This file was generated by "github.com/frk/valid".
+
+package testdata
+
+import (
+	"errors"
+	"strings"
+)
+
+func (v DefaultValidator) Validate() error {
+	if v.F1 == "" {
+		v.F1 = "foo"
+	}
+	if v.F2 == 0 {
+		v.F2 = 10
+	}
+	if v.F2 <= 0 {
+		return errors.New("F2 must be greater than: 0")
+	}
+	if v.F3 == nil {
+		v.F3 = new(float64)
+	}
+	if *v.F3 == 0.0 {
+		*v.F3 = 0.5
+	}
+	if v.F4 == nil {
+		v.F4 = new(*bool)
+	}
+	if *v.F4 == nil {
+		*v.F4 = new(bool)
+	}
+	if **v.F4 == false {
+		**v.F4 = true
+	}
+	if v.F5 == nil {
+		v.F5 = new(string)
+	}
+	if *v.F5 == "" {
+		*v.F5 = "bar"
+	}
+	if v.F5 != nil && len(*v.F5) != 3 {
+		return errors.New("F5 must be of length: 3")
+	}
+	if v.F6 == "" {
+		v.F6 = "active"
+	}
+	v.F6 = strings.TrimSpace(v.F6)
+	if len(v.F6) < 1 || len(v.F6) > 10 {
+		return errors.New("F6 must be of length between: 1 and 10 (inclusive)")
+	}
+	if v.F7 == "" {
+		v.F7 = v.F1
+	}
+	if v.F8 == nil {
+		v.F8 = new(Status)
+	}
+	if *v.F8 == "" {
+		*v.F8 = Status(v.F6)
+	}
+	return nil
+}
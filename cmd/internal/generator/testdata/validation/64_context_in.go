@@ -0,0 +1,37 @@
+package testdata
+
+import (
+	"context"
+)
+
+type T64aValidator struct {
+	F1 string   `is:"rctx"`
+	F2 *string  `is:"rctxerr,required"`
+	F3 string   `is:"rctxerr:10,email" pre:"trim,pre_with_ctx"`
+	F4 []string `is:"[]rctx"`
+}
+
+type T64bValidator struct {
+	F1 string `is:"required"`
+}
+
+func (v *T64bValidator) BeforeValidate(ctx context.Context) error {
+	return nil
+}
+
+func (v T64bValidator) AfterValidate(ctx context.Context) error {
+	return nil
+}
+
+type T64cValidator struct {
+	F1 T64Code  `is:"required"`
+	F2 *T64Code `is:"len:3"`
+}
+
+// T64Code's IsValid method takes a context and therefore
+// does not match the implicit "IsValid() bool" rule.
+type T64Code string
+
+func (c T64Code) IsValid(ctx context.Context) bool {
+	return true
+}
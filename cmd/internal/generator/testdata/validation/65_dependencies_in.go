@@ -0,0 +1,26 @@
+package testdata
+
+import (
+	"github.com/frk/valid/cmd/internal/generator/testdata/mypkg"
+)
+
+type T65aValidator struct {
+	F1   string   `is:"unique_email,email" pre:"trim,normalize"`
+	F2   *string  `is:"unique_email"`
+	F3   []string `is:"[]email" pre:"[]normalize"`
+	Repo *mypkg.UserRepo
+	Deps struct {
+		Norm mypkg.Normalizer
+	}
+}
+
+type T65bValidator struct {
+	F1   string `is:"entitled:3"`
+	Deps struct {
+		Ent  mypkg.Entitlements
+		Repo *mypkg.UserRepo
+	}
+	// direct fields take precedence over "Deps" fields
+	Repo *mypkg.UserRepo
+	F2   string `is:"unique_email"`
+}
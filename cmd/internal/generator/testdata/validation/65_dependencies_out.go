@@ -0,0 +1,53 @@
+// DO NOT EDIT. This file was generated by "github.com/frk/valid".
+
+package testdata
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/frk/valid"
+)
+
+func (v T65aValidator) Validate() error {
+	v.F1 = v.Deps.Norm.Normalize(strings.TrimSpace(v.F1))
+	if ok, err := v.Repo.IsUniqueEmail(v.F1); err != nil {
+		return err
+	} else if !ok {
+		return errors.New("F1 is not valid")
+	} else if !valid.Email(v.F1) {
+		return errors.New("F1 must be a valid email address")
+	}
+	if v.F2 != nil {
+		if ok, err := v.Repo.IsUniqueEmail(*v.F2); err != nil {
+			return err
+		} else if !ok {
+			return errors.New("F2 is not valid")
+		}
+	}
+	for i, e1 := range v.F3 {
+		v.F3[i] = v.Deps.Norm.Normalize(e1)
+		e1 = v.F3[i]
+		if !valid.Email(e1) {
+			return errors.New("F3 must be a valid email address")
+		}
+	}
+	return nil
+}
+
+func (v T65bValidator) Validate() error {
+	return v.ValidateContext(context.Background())
+}
+
+func (v T65bValidator) ValidateContext(ctx context.Context) error {
+	if !v.Deps.Ent.Allows(ctx, v.F1, 3) {
+		return errors.New("F1 is not valid")
+	}
+	if ok, err := v.Repo.IsUniqueEmail(v.F2); err != nil {
+		return err
+	} else if !ok {
+		return errors.New("F2 is not valid")
+	}
+	return nil
+}
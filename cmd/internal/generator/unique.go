@@ -0,0 +1,91 @@
+package generator
+
+import (
+	"github.com/frk/valid/cmd/internal/gotype"
+	"github.com/frk/valid/cmd/internal/rules"
+
+	GO "github.com/frk/ast/golang"
+)
+
+// used for adding pkgimport
+var validpkg = gotype.Pkg{Path: "github.com/frk/valid", Name: "valid"}
+
+// the identifiers declared by the "unique" rule's if-statement that
+// hold the index, or the map key, of the first duplicate element
+var (
+	UNIQUE_IDX = GO.Ident{"idx"}
+	UNIQUE_KEY = GO.Ident{"key"}
+)
+
+// uniqueInitStmt builds the init statement of the "unique" rule's
+// if-statement. The statement declares the index, or the key, of the
+// first duplicate element and the "ok" variable that reports whether
+// or not the elements are unique.
+//
+//	idx, ok := valid.Unique(<value>)
+//	idx, ok := valid.UniqueFunc(len(<value>), func(j int) T { return <value>[j].<field> })
+//	key, ok := valid.UniqueMap(<value>)
+//	key, ok := valid.UniqueMapFunc(<value>, func(k K) T { return <value>[k].<field> })
+func (b *bb) uniqueInitStmt(n *rules.Node, r *rules.Rule) GO.StmtNode {
+	pkg := b.g.addImport(validpkg)
+	isMap := n.Type.Kind == gotype.K_MAP
+
+	x := b.val
+	if n.PtrDepth() > 0 {
+		x = GO.ParenExpr{x}
+	}
+
+	var fn string
+	var args GO.ExprList
+	if len(r.Args) == 0 {
+		switch n.Type.Kind {
+		case gotype.K_MAP:
+			fn, args = "UniqueMap", GO.ExprList{x}
+		case gotype.K_ARRAY:
+			fn, args = "Unique", GO.ExprList{GO.SliceExpr{X: x}}
+		default:
+			fn, args = "Unique", GO.ExprList{b.val}
+		}
+	} else {
+		f := rules.UniqueField(n.Type.Elem, r.Args[0].Value)
+
+		var param GO.Param
+		if isMap {
+			fn = "UniqueMapFunc"
+			param = GO.Param{Names: GO.Ident{"k"}, Type: b.typeExpr(n.Type.Key).(GO.TypeNode)}
+			args = GO.ExprList{b.val}
+		} else {
+			fn = "UniqueFunc"
+			param = GO.Param{Names: GO.Ident{"j"}, Type: GO.Ident{"int"}}
+			args = GO.ExprList{GO.CallLenExpr{b.val}}
+		}
+
+		elem := GO.IndexExpr{X: x, Index: param.Names.(GO.Ident)}
+		lit := GO.FuncLit{Type: GO.FuncType{
+			Params:  GO.ParamList{param},
+			Results: GO.ParamList{{Type: b.typeExpr(f.Type).(GO.TypeNode)}},
+		}}
+		lit.Body.Add(GO.ReturnStmt{GO.SelectorExpr{X: elem, Sel: GO.Ident{f.Name}}})
+		args = append(args, lit)
+	}
+
+	lhs := UNIQUE_IDX
+	if isMap {
+		lhs = UNIQUE_KEY
+	}
+	return GO.AssignStmt{
+		Token: GO.AssignDefine,
+		Lhs:   GO.IdentList{lhs, OK},
+		Rhs:   GO.CallExpr{Fun: pkgQualIdent(pkg, fn), Args: GO.ArgsList{List: args}},
+	}
+}
+
+// uniqueErrText returns the text, and the reference to the identifier,
+// that is appended to the "unique" rule's error message to report the
+// index, or the key, of the first duplicate element.
+func uniqueErrText(n *rules.Node) (text string, ref GO.ExprNode) {
+	if n.Type.Kind == gotype.K_MAP {
+		return ", duplicate at key: %v", UNIQUE_KEY
+	}
+	return ", duplicate at index: %d", UNIQUE_IDX
+}
@@ -11,12 +11,23 @@ import (
 var (
 	ErrorConstructor *gotype.Func
 	ErrorAggregator  *gotype.Type
+	// If true, and no custom error handling is configured, the generated
+	// code should report errors as valid.FieldErrors.
+	FieldErrors bool
+	// The names of the validation groups declared in the config.
+	Groups []string
+	// If true, the generator should also produce the
+	// partial variants of the validation methods.
+	Partial bool
 )
 
 // used by tests
 func Unset() {
 	ErrorConstructor = nil
 	ErrorAggregator = nil
+	FieldErrors = false
+	Groups = nil
+	Partial = false
 }
 
 //
@@ -59,6 +70,9 @@ func Init(cfg config.Config, a *search.AST) error {
 
 		ErrorAggregator = t
 	}
+	FieldErrors = cfg.ErrorHandling.FieldErrors.Value
+	Groups = cfg.Groups
+	Partial = cfg.Partial.Value
 
 	return nil
 }
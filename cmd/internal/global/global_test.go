@@ -92,6 +92,20 @@ func TestInit(t *testing.T) {
 			oid: objId("ErrorAggregatorWithBadImpl"),
 			obj: findObj("ErrorAggregatorWithBadImpl"),
 		},
+	}, {
+		ctor: obj{id: objId("ErrorConstructorWithContext"), want: false},
+		err: &Error{
+			C:   ERR_ERROR_CONSTRUCTOR_TYPE,
+			oid: objId("ErrorConstructorWithContext"),
+			obj: findObj("ErrorConstructorWithContext"),
+		},
+	}, {
+		agg: obj{id: objId("ErrorAggregatorWithContext"), want: false},
+		err: &Error{
+			C:   ERR_ERROR_AGGREGATOR_TYPE,
+			oid: objId("ErrorAggregatorWithContext"),
+			obj: findObj("ErrorAggregatorWithContext"),
+		},
 	}}
 
 	compare := compare.Config{ObserveFieldTag: "cmp"}
@@ -1,5 +1,9 @@
 package testdata
 
+import (
+	"context"
+)
+
 ////////////////////////////////////////////////////////////////////////////////
 // ok
 ////////////////////////////////////////////////////////////////////////////////
@@ -42,6 +46,21 @@ func (ErrorAggregatorWithBadImpl) Out() error {
 	return nil
 }
 
+func ErrorConstructorWithContext(ctx context.Context, k string, v any, r string, o ...any) error {
+	return nil
+}
+
+type ErrorAggregatorWithContext struct{}
+
+func (ErrorAggregatorWithContext) Error(ctx context.Context, k string, v any, r string, o ...any) {
+	//...
+}
+
+func (ErrorAggregatorWithContext) Out() error {
+	//...
+	return nil
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 
 type CustomString string
@@ -155,6 +155,12 @@ func (a *Analyzer) Analyze(t types.Type) (u *Type) {
 		u.In, u.Out = a.analyzeSignature(T)
 		u.TypeParams = a.analyzeTypeParams(T)
 		u.IsVariadic = T.Variadic()
+		if len(u.In) > 0 && u.In[0].Type.IsContext() {
+			u.In, u.HasContext = u.In[1:], true
+			if len(u.In) == 0 {
+				u.In = nil
+			}
+		}
 	case *types.Chan:
 		u.Kind = K_CHAN
 		// NOTE Channels aren't used for anything by the package
@@ -1,6 +1,8 @@
 package gotype
 
 import (
+	"go/constant"
+	"strconv"
 	"sync"
 
 	"github.com/frk/valid/cmd/internal/search"
@@ -12,6 +14,8 @@ type Const struct {
 	Pkg Pkg
 	// Name of the constant.
 	Name string
+	// The constant's value in Go syntax, e.g. `1`, `"foo"`, or `true`.
+	Value string
 }
 
 // Consts is a helper method that finds and
@@ -43,7 +47,8 @@ func (a *Analyzer) Consts(t *Type, ast *search.AST) (consts []Const) {
 		}
 
 		consts = append(consts, Const{
-			Name: name,
+			Name:  name,
+			Value: constValue(c.Val()),
 			Pkg: Pkg{
 				Path: pkg.Path(),
 				Name: pkg.Name(),
@@ -58,6 +63,17 @@ func (a *Analyzer) Consts(t *Type, ast *search.AST) (consts []Const) {
 	return consts
 }
 
+// constValue returns the Go syntax representation of the given value.
+// Floats are formatted as decimals since ExactString may return them
+// as fractions, e.g. "1/3".
+func constValue(v constant.Value) string {
+	if v.Kind() == constant.Float {
+		f, _ := constant.Float64Val(v)
+		return strconv.FormatFloat(f, 'g', -1, 64)
+	}
+	return v.ExactString()
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // cache
 
@@ -22,32 +22,32 @@ func TestConsts(t *testing.T) {
 	}{{
 		typ: &Type{Pkg: pkg0, Name: "ConstType1"},
 		want: []Const{
-			{Pkg: pkg0, Name: "CT1"},
-			{Pkg: pkg0, Name: "CT2"},
-			{Pkg: pkg0, Name: "CT4"},
-			{Pkg: pkg0, Name: "ct5"},
+			{Pkg: pkg0, Name: "CT1", Value: "0"},
+			{Pkg: pkg0, Name: "CT2", Value: "1"},
+			{Pkg: pkg0, Name: "CT4", Value: "3"},
+			{Pkg: pkg0, Name: "ct5", Value: "4"},
 		},
 	}, {
 		typ: &Type{Pkg: pkg0, Name: "ConstType2"},
 		want: []Const{
-			{Pkg: pkg0, Name: "ConstFoo"},
-			{Pkg: pkg0, Name: "ConstBar"},
-			{Pkg: pkg0, Name: "const_baz"},
+			{Pkg: pkg0, Name: "ConstFoo", Value: `"foo"`},
+			{Pkg: pkg0, Name: "ConstBar", Value: `"bar"`},
+			{Pkg: pkg0, Name: "const_baz", Value: `"baz"`},
 		},
 	}, {
 		typ: &Type{Pkg: pkg0, Name: "constType3"},
 		want: []Const{
-			{Pkg: pkg0, Name: "kYES"},
-			{Pkg: pkg0, Name: "kNO"},
+			{Pkg: pkg0, Name: "kYES", Value: "true"},
+			{Pkg: pkg0, Name: "kNO", Value: "false"},
 		},
 	}, {
 		// make sure the unexported constant ct5 is
 		// omitted if the const's type is imported.
 		typ: &Type{Pkg: pkg1, Name: "ConstType1"},
 		want: []Const{
-			{Pkg: pkg1, Name: "CT1"},
-			{Pkg: pkg1, Name: "CT2"},
-			{Pkg: pkg1, Name: "CT4"},
+			{Pkg: pkg1, Name: "CT1", Value: "0"},
+			{Pkg: pkg1, Name: "CT2", Value: "1"},
+			{Pkg: pkg1, Name: "CT4", Value: "3"},
 		},
 	}}
 
@@ -14,7 +14,7 @@ func IsErrorAggregator(t *Type) bool {
 		// Error(key string, val any, rule string, args ...any)
 		case "Error":
 			sig := m.Type // signature
-			if !sig.IsVariadic {
+			if !sig.IsVariadic || sig.HasContext {
 				return false
 			}
 			in, out := sig.In, sig.Out
@@ -31,7 +31,7 @@ func IsErrorAggregator(t *Type) bool {
 		case "Out":
 			sig := m.Type // signature
 			in, out := sig.In, sig.Out
-			if len(in) != 0 || len(out) != 1 {
+			if len(in) != 0 || len(out) != 1 || sig.HasContext {
 				return false
 			}
 			if !out[0].Type.IsGoError() {
@@ -69,7 +69,7 @@ func IsErrorConstructorFunc(t *Type) bool {
 	if t.Kind != K_FUNC {
 		return false
 	}
-	if !t.IsVariadic {
+	if !t.IsVariadic || t.HasContext {
 		return false
 	}
 
@@ -85,25 +85,25 @@ func IsErrorConstructorFunc(t *Type) bool {
 	return true
 }
 
-// nameOfValidateMethod scans the method set of the given type for
-// a method with the signature "func() error" and with its name equal
-// to the given prefix+"validate" (case insensitive). If it finds a
-// match it will return that method's full name (with case preserved),
-// and if there's no match it will it will return an empty string.
-func nameOfValidateMethod(t *Type, prefix string) string {
+// findValidateMethod scans the method set of the given type for a method
+// with the signature "func() error", or "func(context.Context) error", and
+// with its name equal to the given prefix+"validate" (case insensitive).
+// If it finds a match it will return that method's info (with the name's
+// case preserved), and if there's no match it will it will return nil.
+func findValidateMethod(t *Type, prefix string) *MethodInfo {
 	name := strings.ToLower(prefix) + "validate"
 	for _, m := range t.Methods {
 		if strings.ToLower(m.Name) == name {
 			sig := m.Type // signature
 			in, out := sig.In, sig.Out
 			if len(in) != 0 || len(out) != 1 {
-				return ""
+				return nil
 			}
 			if !out[0].Type.IsGoError() {
-				return ""
+				return nil
 			}
-			return m.Name
+			return &MethodInfo{Name: m.Name, HasContext: sig.HasContext}
 		}
 	}
-	return ""
+	return nil
 }
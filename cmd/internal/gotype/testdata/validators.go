@@ -1,5 +1,9 @@
 package testdata
 
+import (
+	"context"
+)
+
 type Test1Validator struct {
 	// ...
 }
@@ -19,6 +23,13 @@ type Test5Validator struct {
 func (Test5Validator) beforevalidate() error { return nil }
 func (*Test5Validator) AfterValidate() error { return nil }
 
+type Test6Validator struct {
+	// ...
+}
+
+func (Test6Validator) BeforeValidate(ctx context.Context) error { return nil }
+func (Test6Validator) AfterValidate(ctx context.Context) error  { return nil }
+
 type errorConstructor struct{}
 
 func (errorConstructor) Error(key string, val interface{}, rule string, args ...interface{}) error {
@@ -28,6 +28,10 @@ type Type struct {
 	ArrayLen int64
 	// If kind is func, indicates whether or not the function is variadic.
 	IsVariadic bool
+	// If kind is func, indicates whether or not the function's first
+	// parameter is of type context.Context. Note that if HasContext is
+	// true then the context.Context parameter will be omitted from In.
+	HasContext bool
 	// Indicates whether or not the type is the "byte" alias type.
 	IsByte bool
 	// Indicates whether or not the type is the "rune" alias type.
@@ -136,6 +140,21 @@ func (t *Type) IsGoAny() bool {
 		(t.Name == "any" || len(t.Methods) == 0)
 }
 
+// IsContext reports whether or not t is the context.Context type.
+func (t *Type) IsContext() bool {
+	return t.Pkg.Path == "context" && t.Name == "Context" && t.Kind == K_INTERFACE
+}
+
+// IsTime reports whether or not t is the time.Time type.
+func (t *Type) IsTime() bool {
+	return t.Pkg.Path == "time" && t.Name == "Time" && t.Kind == K_STRUCT
+}
+
+// IsDuration reports whether or not t is the time.Duration type.
+func (t *Type) IsDuration() bool {
+	return t.Pkg.Path == "time" && t.Name == "Duration" && t.Kind == K_INT64
+}
+
 // IsGoAnySlice reports whether or not t is the Go builtin []any/[]interface{} type.
 func (t *Type) IsGoAnySlice() bool {
 	if t.Kind == K_SLICE {
@@ -189,6 +208,7 @@ func (t *Type) HasLength() bool {
 func (t *Type) HasIsValid() bool {
 	for _, m := range t.Methods {
 		if m.Name == "IsValid" &&
+			!m.Type.HasContext &&
 			len(m.Type.In) == 0 &&
 			len(m.Type.Out) == 1 &&
 			m.Type.Out[0].Type.Kind == K_BOOL {
@@ -251,7 +271,7 @@ func (t *Type) IsIdentical(u *Type) bool {
 		return t.IsEmptyInterface() && u.IsEmptyInterface()
 	case K_FUNC:
 		// incompatible number of in/out parameters, reject
-		if len(t.In) != len(u.In) || len(t.Out) != len(u.Out) {
+		if len(t.In) != len(u.In) || len(t.Out) != len(u.Out) || t.HasContext != u.HasContext {
 			return false
 		}
 		// non-identical input parameter types, reject
@@ -335,9 +355,12 @@ func (t Type) TypeString(pkg *Pkg) string {
 	case K_CHAN:
 		return "<chan>"
 	case K_FUNC:
-		in := make([]string, len(t.In))
+		in := make([]string, 0, len(t.In)+1)
+		if t.HasContext {
+			in = append(in, "context.Context")
+		}
 		for i := range t.In {
-			in[i] = t.In[i].Type.TypeString(pkg)
+			in = append(in, t.In[i].Type.TypeString(pkg))
 		}
 		out := make([]string, len(t.Out))
 		for i := range t.Out {
@@ -33,6 +33,9 @@ type ErrorHandlerField struct {
 type MethodInfo struct {
 	// The name of the method (case preserved).
 	Name string
+	// Indicates whether or not the method
+	// takes a context.Context argument.
+	HasContext bool
 }
 
 // Validator analyzes the named type and returns its
@@ -49,12 +52,8 @@ func (an *Analyzer) Validator(named *types.Named) *Validator {
 
 	v := new(Validator)
 	v.Type = an.Analyze(named)
-	if name := nameOfValidateMethod(v.Type, "before"); len(name) > 0 {
-		v.BeforeValidateMethod = &MethodInfo{Name: name}
-	}
-	if name := nameOfValidateMethod(v.Type, "after"); len(name) > 0 {
-		v.AfterValidateMethod = &MethodInfo{Name: name}
-	}
+	v.BeforeValidateMethod = findValidateMethod(v.Type, "before")
+	v.AfterValidateMethod = findValidateMethod(v.Type, "after")
 	for _, f := range v.Type.Fields {
 		if IsErrorConstructor(f.Type) {
 			v.ErrorHandlerField = new(ErrorHandlerField)
@@ -122,6 +122,26 @@ func TestAnalyzer_Validator(t *testing.T) {
 			},
 			BeforeValidateMethod: &MethodInfo{Name: "beforevalidate"},
 			AfterValidateMethod:  &MethodInfo{Name: "AfterValidate"}},
+	}, {
+		named: test_type("Test6Validator").(*types.Named),
+		want: &Validator{
+			Type: &Type{
+				Pkg:        pkg0,
+				Name:       "Test6Validator",
+				Kind:       K_STRUCT,
+				IsExported: true,
+				Methods: []*Method{{
+					Pkg:  pkg0,
+					Name: "BeforeValidate", Type: &Type{Kind: K_FUNC, HasContext: true, Out: []*Var{{Type: errorType}}},
+					IsExported: true,
+				}, {
+					Pkg:  pkg0,
+					Name: "AfterValidate", Type: &Type{Kind: K_FUNC, HasContext: true, Out: []*Var{{Type: errorType}}},
+					IsExported: true,
+				}},
+			},
+			BeforeValidateMethod: &MethodInfo{Name: "BeforeValidate", HasContext: true},
+			AfterValidateMethod:  &MethodInfo{Name: "AfterValidate", HasContext: true}},
 	}}
 
 	compare := compare.Config{ObserveFieldTag: "cmp"}
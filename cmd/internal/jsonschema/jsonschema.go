@@ -0,0 +1,558 @@
+// Package jsonschema implements the conversion of rule-checked
+// validator types into JSON Schema (draft 2020-12) documents.
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/frk/tagutil"
+	"github.com/frk/valid/cmd/internal/gotype"
+	"github.com/frk/valid/cmd/internal/rules"
+)
+
+// Generate returns the indented JSON encoding of the JSON Schema
+// document that describes the validator type of the given info.
+func Generate(info *rules.Info) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(Build(info)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Build returns the JSON Schema document that describes the validator
+// type of the given info. The validator's fields are described by the
+// document's properties, which are named after the fields' keys, and
+// the fields' rules are mapped to the equivalent JSON Schema keywords.
+//
+// Rules that have no equivalent in JSON Schema, e.g. rules that reference
+// other fields, or custom function rules, are not included in the document.
+func Build(info *rules.Info) *Schema {
+	b := new(Builder)
+	b.info = info
+	s := b.node(info.RootNode)
+	s.Schema = Draft
+	s.Title = info.Validator.Type.Name
+	return s
+}
+
+// Builder builds the schemas of validator types whose nested struct
+// types are described by separate, named, schemas.
+type Builder struct {
+	// The prefix of the "$ref" URIs of the named schemas, e.g.
+	// "#/components/schemas/". The URI of a named schema is the
+	// prefix followed by the schema's name.
+	RefPrefix string
+	// If set, the numeric types and byte slices are described using
+	// the formats defined by the OpenAPI Specification, e.g. "int64",
+	// and the rules that have no equivalent keyword are listed by
+	// the "x-valid-rule" extension keyword.
+	OpenAPI bool
+	// Defs holds the named schemas, in the order in which
+	// they were added. Named schemas are named after the
+	// struct types that they describe.
+	Defs Properties
+
+	// the info of the validator being built
+	info *rules.Info
+	// maps the package-path qualified names of
+	// the struct types to their schemas' names
+	names map[string]string
+	// the set of the names that are already taken
+	taken map[string]bool
+}
+
+// Add adds the named schema of the validator type of the given info, and
+// the named schemas of the struct types that it references, to b.Defs.
+func (b *Builder) Add(info *rules.Info) {
+	b.info = info
+	b.ref(info.RootNode)
+}
+
+// node returns the schema of the given node.
+func (b *Builder) node(n *rules.Node) *Schema {
+	if n.Type.Kind == gotype.K_PTR {
+		s := b.node(n.Elem)
+		if !isRequired(n) {
+			if len(s.Type) > 0 {
+				s.Type = append(s.Type, "null")
+			} else if len(s.Ref) > 0 {
+				s = &Schema{AnyOf: []*Schema{s, {Type: Types{"null"}}}}
+			}
+		}
+		b.extend(s, n.CondRules)
+		return s
+	}
+
+	s := &Schema{Type: typeOf(n.Type)}
+	switch t := n.Type; {
+	case t.IsTime():
+		s.Format = "date-time"
+	case isBytes(t):
+		s.ContentEncoding = "base64"
+		if b.OpenAPI {
+			s.Format, s.ContentEncoding = "byte", ""
+		}
+	case t.Kind == gotype.K_ARRAY:
+		s.Items = b.node(n.Elem)
+		s.MinItems = intptr(int(t.ArrayLen))
+		s.MaxItems = intptr(int(t.ArrayLen))
+	case t.Kind == gotype.K_SLICE:
+		s.Items = b.node(n.Elem)
+	case t.Kind == gotype.K_MAP:
+		s.AdditionalProperties = b.node(n.Elem)
+		if n.Key.Type.Kind == gotype.K_STRING && len(n.Key.IsRules) > 0 {
+			s.PropertyNames = b.node(n.Key)
+		}
+	case t.Kind == gotype.K_STRUCT:
+		if len(b.RefPrefix) > 0 && len(t.Name) > 0 {
+			s = b.ref(n)
+		} else {
+			b.fields(n, s)
+		}
+	case b.OpenAPI:
+		s.Format = openAPIFormats[t.Kind]
+	}
+
+	var unmapped rules.RuleList
+	for _, r := range n.IsRules {
+		switch r.Spec.Kind {
+		case rules.REQUIRED, rules.OPTIONAL, rules.NOGUARD, rules.REMOVE:
+			continue
+		}
+
+		t := new(Schema)
+		if b.rule(n, r, t) {
+			s.merge(t)
+		} else {
+			unmapped = append(unmapped, r)
+		}
+	}
+	b.extend(s, append(unmapped, n.CondRules...))
+
+	if n.Default != nil {
+		if v, ok := b.value(n, n.Default); ok {
+			s.Default = v
+		}
+	}
+	return s
+}
+
+// ref returns a schema that references the named schema of the struct
+// type of the node n. If the named schema does not yet exist, it is
+// added to b.Defs.
+func (b *Builder) ref(n *rules.Node) *Schema {
+	name, ok := b.name(n.Type)
+	if !ok {
+		p := &Property{Name: name}
+		b.Defs = append(b.Defs, p)
+
+		p.Schema = &Schema{Type: Types{"object"}}
+		b.fields(n, p.Schema)
+	}
+	return &Schema{Ref: b.RefPrefix + name}
+}
+
+// name returns the name of the named schema of the struct type t. The
+// result will be false if the name was not previously assigned to t.
+// Struct types with the same name, but from different packages, are
+// disambiguated by prefixing the name with the package's name.
+func (b *Builder) name(t *gotype.Type) (name string, ok bool) {
+	if b.names == nil {
+		b.names = make(map[string]string)
+		b.taken = make(map[string]bool)
+	}
+
+	key := t.Pkg.Path + "." + t.Name
+	if name, ok = b.names[key]; ok {
+		return name, true
+	}
+
+	name = t.Name
+	if b.taken[name] {
+		name = strings.ToUpper(t.Pkg.Name[:1]) + t.Pkg.Name[1:] + t.Name
+	}
+	b.names[key] = name
+	b.taken[name] = true
+	return name, false
+}
+
+// extend lists, if enabled, the given rules in the "x-valid-rule"
+// extension keyword of the schema s. The rules are listed in the
+// syntax of the struct tags and separated by commas.
+func (b *Builder) extend(s *Schema, rs rules.RuleList) {
+	if !b.OpenAPI || len(rs) == 0 {
+		return
+	}
+
+	list := make([]string, len(rs))
+	for i, r := range rs {
+		// drop the empty default arguments, e.g. "strongpass:"
+		list[i] = strings.TrimRight(r.String(), ":")
+	}
+	if len(s.XValidRule) > 0 {
+		list = append([]string{s.XValidRule}, list...)
+	}
+	s.XValidRule = strings.Join(list, ",")
+}
+
+// fields adds the properties of the struct node n to the schema s.
+// Embedded structs without a key of their own have their properties
+// added directly to s, the same way encoding/json handles them.
+func (b *Builder) fields(n *rules.Node, s *Schema) {
+	for _, f := range n.Fields {
+		if h := b.info.Validator.ErrorHandlerField; h != nil && n == b.info.RootNode && h.Name == f.Field.Name {
+			continue
+		}
+
+		name, tagged := f.Field.Name, false
+		if len(b.info.KeyTag) > 0 {
+			if v := tagutil.New(f.Field.Tag).First(b.info.KeyTag); v == "-" {
+				continue
+			} else if len(v) > 0 {
+				name, tagged = v, true
+			}
+		}
+		if f.Field.IsEmbedded && !tagged && f.Type.Base().IsStruct() {
+			b.fields(f.Type.Base(), s)
+			continue
+		}
+		if !f.Field.IsExported {
+			continue
+		}
+
+		s.Properties = append(s.Properties, &Property{Name: name, Schema: b.node(f.Type)})
+		if isRequired(f.Type) {
+			s.Required = append(s.Required, name)
+		}
+	}
+}
+
+// rule adds the keywords that are equivalent to the rule r, applied to
+// the node n, to the schema t. The result will be false if the rule has
+// no equivalent in JSON Schema, in which case t should not be used.
+func (b *Builder) rule(n *rules.Node, r *rules.Rule, t *Schema) bool {
+	if r.Negated {
+		rr := *r
+		rr.Negated = false
+
+		t.Not = new(Schema)
+		return b.rule(n, &rr, t.Not)
+	}
+
+	switch r.Spec.Kind {
+	case rules.ALTERNATION:
+		for _, alt := range r.Alt {
+			a := new(Schema)
+			if !b.rule(n, alt, a) {
+				// one of the alternatives cannot be
+				// described, so neither can the group
+				return false
+			}
+			t.AnyOf = append(t.AnyOf, a)
+		}
+		return true
+	case rules.COMPARABLE:
+		vals, ok := b.values(n, r.Args)
+		if !ok {
+			return false
+		}
+		if r.Name == "ne" {
+			t.Not = new(Schema)
+			t = t.Not
+		}
+		if len(vals) == 1 {
+			t.Const = vals[0]
+		} else {
+			t.Enum = vals
+		}
+		return true
+	case rules.ORDERED:
+		if !isNumeric(n.Type) {
+			return false
+		}
+		num, ok := b.number(n, r.Args[0])
+		if !ok {
+			return false
+		}
+		switch r.Name {
+		case "gt":
+			t.ExclusiveMinimum = num
+		case "lt":
+			t.ExclusiveMaximum = num
+		case "gte", "min":
+			t.Minimum = num
+		case "lte", "max":
+			t.Maximum = num
+		}
+		return true
+	case rules.RANGE:
+		if !isNumeric(n.Type) {
+			return false
+		}
+		lo, ok1 := b.number(n, r.Args[0])
+		hi, ok2 := b.number(n, r.Args[1])
+		if !ok1 || !ok2 {
+			return false
+		}
+		t.Minimum, t.Maximum = lo, hi
+		return true
+	case rules.LENGTH:
+		return lengthRule(n, r, t)
+	case rules.ENUM:
+		consts := b.info.EnumMap[n.Type]
+		if len(consts) == 0 {
+			return false
+		}
+		for _, c := range consts {
+			v, ok := constValue(c)
+			if !ok {
+				return false
+			}
+			t.Enum = append(t.Enum, v)
+		}
+		return true
+	case rules.UNIQUE:
+		if len(r.Args) > 0 || !n.Type.Is(gotype.K_SLICE, gotype.K_ARRAY) {
+			return false
+		}
+		t.UniqueItems = true
+		return true
+	case rules.FUNCTION:
+		return funcRule(r, t)
+	}
+	return false
+}
+
+// lengthRule adds the length bounds of the "len" or "runecount" rule
+// to the schema t. The bounds of strings are described with the
+// minLength and maxLength keywords, those of arrays and slices with
+// minItems and maxItems, and those of maps with minProperties and
+// maxProperties.
+func lengthRule(n *rules.Node, r *rules.Rule, t *Schema) bool {
+	var min, max *int
+	for i, a := range r.Args {
+		if a.IsEmpty() {
+			continue
+		}
+		v, err := strconv.Atoi(a.Value)
+		if err != nil {
+			return false
+		}
+		if len(r.Args) == 1 {
+			min, max = intptr(v), intptr(v)
+		} else if i == 0 {
+			min = intptr(v)
+		} else {
+			max = intptr(v)
+		}
+	}
+
+	switch {
+	case n.Type.Kind == gotype.K_STRING:
+		t.MinLength, t.MaxLength = min, max
+	case isBytes(n.Type):
+		// the length of the base64
+		// encoding would be different
+		return false
+	case n.Type.Is(gotype.K_ARRAY, gotype.K_SLICE):
+		t.MinItems, t.MaxItems = min, max
+	case n.Type.Kind == gotype.K_MAP:
+		t.MinProperties, t.MaxProperties = min, max
+	default:
+		return false
+	}
+	return true
+}
+
+// funcRule adds the format, or the pattern, that is equivalent to the
+// function rule r to the schema t. Only some of the functions of the
+// github.com/frk/valid and the strings package have an equivalent.
+func funcRule(r *rules.Rule, t *Schema) bool {
+	for _, a := range r.Args {
+		if a.Type == rules.ARG_FIELD_ABS || a.Type == rules.ARG_FIELD_REL {
+			return false
+		}
+	}
+
+	if r.Spec.FType.IsIncluded() {
+		switch r.Spec.Name {
+		case "email":
+			t.Format = "email"
+		case "uuid":
+			t.Format = "uuid"
+		case "url":
+			t.Format = "uri"
+		case "fqdn":
+			t.Format = "hostname"
+		case "rfc3339":
+			t.Format = "date-time"
+		case "date":
+			if r.Args[0].Value != "2006-01-02" {
+				return false
+			}
+			t.Format = "date"
+		case "ip":
+			switch r.Args[0].Value {
+			case "4":
+				t.Format = "ipv4"
+			case "6":
+				t.Format = "ipv6"
+			default:
+				t.AnyOf = []*Schema{{Format: "ipv4"}, {Format: "ipv6"}}
+			}
+		case "re":
+			t.Pattern = r.Args[0].Value
+		default:
+			return false
+		}
+		return true
+	}
+
+	if r.Spec.FType.Pkg.Path == "strings" {
+		var format string
+		switch r.Spec.FName {
+		case "HasPrefix":
+			format = "^(?:%s)"
+		case "HasSuffix":
+			format = "(?:%s)$"
+		case "Contains":
+			format = "(?:%s)"
+		default:
+			return false
+		}
+
+		alts := make([]string, len(r.Args))
+		for i, a := range r.Args {
+			alts[i] = regexp.QuoteMeta(a.Value)
+		}
+		t.Pattern = strings.Replace(format, "%s", strings.Join(alts, "|"), 1)
+		return true
+	}
+	return false
+}
+
+// values returns the JSON values of the given arguments. The result
+// will be false if any of the arguments does not have a JSON value.
+func (b *Builder) values(n *rules.Node, args []*rules.Arg) ([]any, bool) {
+	vals := make([]any, len(args))
+	for i, a := range args {
+		v, ok := b.value(n, a)
+		if !ok {
+			return nil, false
+		}
+		vals[i] = v
+	}
+	return vals, true
+}
+
+// value returns the JSON value of the argument a that is applied
+// to the node n. Arguments that reference other fields, and time
+// arguments, have no JSON value.
+func (b *Builder) value(n *rules.Node, a *rules.Arg) (any, bool) {
+	switch a.Type {
+	case rules.ARG_BOOL:
+		return a.Value == "true", true
+	case rules.ARG_INT, rules.ARG_FLOAT:
+		return Number(a.Value), true
+	case rules.ARG_STRING:
+		if n.Base().Type.IsDuration() {
+			return b.number(n, a)
+		}
+		if n.Base().Type.IsTime() {
+			return nil, false
+		}
+		return a.Value, true
+	}
+	return nil, false
+}
+
+// number returns the JSON number of the argument a that is applied to
+// the node n. Duration literals are converted to their nanosecond count.
+func (b *Builder) number(n *rules.Node, a *rules.Arg) (Number, bool) {
+	if a.IsNumeric() {
+		return Number(a.Value), true
+	}
+	if n.Base().Type.IsDuration() {
+		if d, ok := rules.ParseDurationArg(a); ok {
+			return Number(strconv.FormatInt(int64(d), 10)), true
+		}
+	}
+	return "", false
+}
+
+// constValue returns the JSON value of the given constant.
+func constValue(c gotype.Const) (any, bool) {
+	switch v := c.Value; {
+	case v == "true" || v == "false":
+		return v == "true", true
+	case strings.HasPrefix(v, `"`) || strings.HasPrefix(v, "`"):
+		s, err := strconv.Unquote(v)
+		return s, err == nil
+	case len(v) > 0:
+		return Number(v), true
+	}
+	return nil, false
+}
+
+// typeOf returns the JSON types of the values of the given Go type.
+func typeOf(t *gotype.Type) Types {
+	switch {
+	case t.IsTime(), isBytes(t):
+		return Types{"string"}
+	case t.Kind == gotype.K_BOOL:
+		return Types{"boolean"}
+	case t.Kind.IsInteger():
+		return Types{"integer"}
+	case t.Kind.IsFloat():
+		return Types{"number"}
+	case t.Kind == gotype.K_STRING:
+		return Types{"string"}
+	case t.Kind == gotype.K_ARRAY, t.Kind == gotype.K_SLICE:
+		return Types{"array"}
+	case t.Kind == gotype.K_MAP, t.Kind == gotype.K_STRUCT:
+		return Types{"object"}
+	}
+	return nil
+}
+
+// isRequired reports whether or not the node's value must be present.
+func isRequired(n *rules.Node) bool {
+	for _, r := range n.IsRules {
+		if r.Spec.Kind == rules.REQUIRED {
+			return true
+		}
+	}
+	return false
+}
+
+// isNumeric reports whether or not t is a numeric type.
+func isNumeric(t *gotype.Type) bool {
+	return t.Kind.IsInteger() || t.Kind.IsFloat()
+}
+
+// isBytes reports whether or not t is a byte slice, which
+// encoding/json encodes as a base64 encoded string.
+func isBytes(t *gotype.Type) bool {
+	return t.Kind == gotype.K_SLICE && t.Elem.Kind == gotype.K_UINT8
+}
+
+// The formats of the numeric types as defined by the OpenAPI Specification.
+var openAPIFormats = map[gotype.Kind]string{
+	gotype.K_INT:     "int64",
+	gotype.K_INT32:   "int32",
+	gotype.K_INT64:   "int64",
+	gotype.K_FLOAT32: "float",
+	gotype.K_FLOAT64: "double",
+}
+
+func intptr(i int) *int {
+	return &i
+}
@@ -0,0 +1,139 @@
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+)
+
+// The URI of the JSON Schema dialect of the generated documents.
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema represents a JSON Schema document, or a subschema, as specified
+// by the JSON Schema Core and Validation vocabularies (draft 2020-12).
+//
+// NOTE the order of the fields determines the order of the keywords in
+// the encoded schema, and the zero value of a field omits its keyword.
+type Schema struct {
+	Schema string `json:"$schema,omitempty"`
+	Ref    string `json:"$ref,omitempty"`
+	Title  string `json:"title,omitempty"`
+	Type   Types  `json:"type,omitempty"`
+	Format string `json:"format,omitempty"`
+	// The encoding of a string's content, used for []byte values.
+	ContentEncoding string `json:"contentEncoding,omitempty"`
+	Pattern         string `json:"pattern,omitempty"`
+	MinLength       *int   `json:"minLength,omitempty"`
+	MaxLength       *int   `json:"maxLength,omitempty"`
+
+	Minimum          Number `json:"minimum,omitempty"`
+	ExclusiveMinimum Number `json:"exclusiveMinimum,omitempty"`
+	Maximum          Number `json:"maximum,omitempty"`
+	ExclusiveMaximum Number `json:"exclusiveMaximum,omitempty"`
+
+	Const   any   `json:"const,omitempty"`
+	Enum    []any `json:"enum,omitempty"`
+	Default any   `json:"default,omitempty"`
+
+	Items       *Schema `json:"items,omitempty"`
+	MinItems    *int    `json:"minItems,omitempty"`
+	MaxItems    *int    `json:"maxItems,omitempty"`
+	UniqueItems bool    `json:"uniqueItems,omitempty"`
+
+	Properties           Properties `json:"properties,omitempty"`
+	Required             []string   `json:"required,omitempty"`
+	PropertyNames        *Schema    `json:"propertyNames,omitempty"`
+	AdditionalProperties *Schema    `json:"additionalProperties,omitempty"`
+	MinProperties        *int       `json:"minProperties,omitempty"`
+	MaxProperties        *int       `json:"maxProperties,omitempty"`
+
+	Not   *Schema   `json:"not,omitempty"`
+	AnyOf []*Schema `json:"anyOf,omitempty"`
+	AllOf []*Schema `json:"allOf,omitempty"`
+
+	// The OpenAPI extension that lists the rules
+	// which have no equivalent JSON Schema keyword.
+	XValidRule string `json:"x-valid-rule,omitempty"`
+}
+
+// merge adds the keywords of t to s. If any of the keywords of t is
+// already present in s then, to avoid overwriting it, t is instead
+// added to the allOf list of s.
+func (s *Schema) merge(t *Schema) {
+	sv, tv := reflect.ValueOf(s).Elem(), reflect.ValueOf(t).Elem()
+	for i := 0; i < tv.NumField(); i++ {
+		if !tv.Field(i).IsZero() && !sv.Field(i).IsZero() {
+			s.AllOf = append(s.AllOf, t)
+			return
+		}
+	}
+	for i := 0; i < tv.NumField(); i++ {
+		if !tv.Field(i).IsZero() {
+			sv.Field(i).Set(tv.Field(i))
+		}
+	}
+}
+
+// Types is the value of the "type" keyword. A single type is
+// encoded as a string, multiple types are encoded as an array.
+type Types []string
+
+func (ts Types) MarshalJSON() ([]byte, error) {
+	if len(ts) == 1 {
+		return json.Marshal(ts[0])
+	}
+	return json.Marshal([]string(ts))
+}
+
+// Number is a JSON number in its literal form, e.g. "10" or "0.5".
+type Number string
+
+func (n Number) MarshalJSON() ([]byte, error) {
+	return []byte(n), nil
+}
+
+// Property is a single entry of the "properties" keyword.
+type Property struct {
+	Name   string
+	Schema *Schema
+}
+
+// Properties is the value of the "properties" keyword. The
+// properties are encoded in the order in which they are listed,
+// which is the order in which the struct fields were declared.
+type Properties []*Property
+
+func (ps Properties) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, p := range ps {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, err := marshal(p.Name)
+		if err != nil {
+			return nil, err
+		}
+		schema, err := marshal(p.Schema)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(schema)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// marshal returns the JSON encoding of v. Unlike json.Marshal, marshal
+// does not escape the HTML characters, which are common in patterns.
+func marshal(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte{'\n'}), nil
+}
@@ -0,0 +1,34 @@
+package testdata
+
+import (
+	"time"
+)
+
+type T01Validator struct {
+	Name      string            `json:"name"`
+	Age       int               `json:"age"`
+	Score     float64           `json:"score"`
+	Active    bool              `json:"active"`
+	Nickname  *string           `json:"nickname,omitempty"`
+	Tags      []string          `json:"tags"`
+	Point     [2]float32        `json:"point"`
+	Labels    map[string]string `json:"labels"`
+	Data      []byte            `json:"data"`
+	CreatedAt time.Time         `json:"created_at"`
+	Timeout   time.Duration     `json:"timeout"`
+	Extra     any               `json:"extra"`
+	Address   T01Address        `json:"address"`
+	Secret    string            `json:"-"`
+	NoTag     string
+	T01Embedded
+	hidden string
+}
+
+type T01Address struct {
+	Street string `json:"street"`
+	City   string `json:"city"`
+}
+
+type T01Embedded struct {
+	Note string `json:"note"`
+}
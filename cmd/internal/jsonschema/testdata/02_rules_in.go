@@ -0,0 +1,36 @@
+package testdata
+
+type T02Validator struct {
+	Name     string            `json:"name" is:"required,len:1:64"`
+	Code     string            `json:"code" is:"len:6"`
+	Title    string            `json:"title" is:"runecount::100"`
+	Age      int               `json:"age" is:"min:18,max:130"`
+	Level    int               `json:"level" is:"rng:1:10"`
+	Ratio    float64           `json:"ratio" is:"gt:0,lt:1"`
+	Kind     string            `json:"kind" is:"eq:a:b:c"`
+	Mode     string            `json:"mode" is:"ne:off"`
+	Status   T02Status         `json:"status" is:"enum"`
+	Email    *string           `json:"email" is:"required,email"`
+	ID       string            `json:"id" is:"uuid"`
+	Addr     string            `json:"addr" is:"ip:v4"`
+	Host     string            `json:"host" is:"ip"`
+	Site     string            `json:"site" is:"url"`
+	Slug     string            `json:"slug" is:"re:\"^[a-z0-9-]+$\""`
+	Path     string            `json:"path" is:"prefix:\"/api/\":\"/v1/\""`
+	File     string            `json:"file" is:"suffix:\".go\""`
+	Tags     []string          `json:"tags" is:"unique,len:1:,[]len:1:32"`
+	Labels   map[string]string `json:"labels" is:"len::10,[len:1:32]"`
+	Color    string            `json:"color" is:"!eq:red"`
+	Contact  string            `json:"contact" is:"(email|fqdn)"`
+	Phone    string            `json:"phone" is:"phone"`
+	Password string            `json:"password" is:"required"`
+	Confirm  string            `json:"confirm" is:"eq:&password"`
+	Limit    int               `json:"limit" default:"25"`
+}
+
+type T02Status string
+
+const (
+	T02StatusActive   T02Status = "active"
+	T02StatusInactive T02Status = "inactive"
+)
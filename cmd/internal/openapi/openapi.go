@@ -0,0 +1,76 @@
+// Package openapi implements the conversion of rule-checked validator
+// types into the component schemas of an OpenAPI 3.1 document.
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/frk/valid/cmd/internal/jsonschema"
+	"github.com/frk/valid/cmd/internal/rules"
+
+	"gopkg.in/yaml.v3"
+)
+
+// The prefix of the URIs that reference the component schemas.
+const RefPrefix = "#/components/schemas/"
+
+// Generate returns the YAML encoding of an OpenAPI 3.1 document fragment
+// whose "components.schemas" section contains the schemas of the validator
+// types of the given infos. Nested struct types are described by separate
+// component schemas that are referenced with "$ref", and the rules that have
+// no equivalent in OpenAPI are listed by the "x-valid-rule" extension.
+//
+// The fragment is intended to be merged into an existing OpenAPI document.
+func Generate(infos []*rules.Info) ([]byte, error) {
+	b := &jsonschema.Builder{RefPrefix: RefPrefix, OpenAPI: true}
+	for _, info := range infos {
+		b.Add(info)
+	}
+
+	doc := document{}
+	doc.Components.Schemas = b.Defs
+	return toYAML(doc)
+}
+
+type document struct {
+	Components struct {
+		Schemas jsonschema.Properties `json:"schemas"`
+	} `json:"components"`
+}
+
+// toYAML returns the YAML encoding of v. The value is first encoded as JSON,
+// using the json.Marshaler implementations of the schema types, and then
+// converted to YAML while preserving the order of the object keys.
+func toYAML(v any) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return nil, err
+	}
+	resetStyle(&node)
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&node); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// resetStyle resets the style of the node and of its descendants, which
+// were decoded from JSON using the flow style, to the default block style.
+func resetStyle(n *yaml.Node) {
+	n.Style = 0
+	for _, c := range n.Content {
+		resetStyle(c)
+	}
+}
@@ -0,0 +1,39 @@
+package testdata
+
+import (
+	"time"
+)
+
+type UserCreateValidator struct {
+	Email     string         `json:"email" is:"required,email"`
+	Name      string         `json:"name" is:"len:1:64"`
+	Age       int32          `json:"age" is:"min:18"`
+	Phone     string         `json:"phone" is:"phone"`
+	Password  string         `json:"password" is:"required,strongpass"`
+	Confirm   string         `json:"confirm" is:"eq:&password"`
+	Avatar    []byte         `json:"avatar"`
+	Balance   float64        `json:"balance" is:"gte:0"`
+	BirthDate time.Time      `json:"birth_date" is:"past"`
+	Address   Address        `json:"address" is:"required"`
+	Billing   *Address       `json:"billing"`
+	Contacts  []Contact      `json:"contacts" is:"len::5"`
+	Meta      map[string]any `json:"meta"`
+}
+
+type UserUpdateValidator struct {
+	ID      int64    `json:"id" is:"required"`
+	Name    *string  `json:"name" is:"len:1:64"`
+	Address *Address `json:"address" is:"notnil"`
+}
+
+type Address struct {
+	Street  string `json:"street" is:"required"`
+	City    string `json:"city" is:"required"`
+	Zip     string `json:"zip" is:"zip:us,required_with:.street"`
+	Country string `json:"country" is:"len:2"`
+}
+
+type Contact struct {
+	Kind  string `json:"kind" is:"eq:email:phone"`
+	Value string `json:"value" is:"required"`
+}
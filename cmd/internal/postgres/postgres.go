@@ -0,0 +1,527 @@
+// Package postgres implements the conversion of rule-checked validator
+// types into PostgreSQL scripts that declare the equivalent constraints.
+package postgres
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/frk/tagutil"
+	"github.com/frk/valid/cmd/internal/gotype"
+	"github.com/frk/valid/cmd/internal/rules"
+)
+
+// The comment at the top of the generated files.
+const preamble = `-- DO NOT EDIT. This file was generated by "github.com/frk/valid".`
+
+// Generate returns a PostgreSQL script that, for each of the validator
+// types of the given infos, alters the validator's table by adding the
+// CHECK and NOT NULL constraints that are equivalent to the rules of
+// the validator's fields. The named types of the fields with the "enum"
+// rule are declared as domains, which then become the columns' types.
+//
+// The tag is the struct tag used for mapping the fields to columns, and
+// the validator to its table, the latter by the tag of a blank field.
+// Fields without the tag are mapped to the snake_case of their names,
+// and validators without a table tag to the snake_case of their names
+// without the "Validator" suffix.
+//
+// Rules that have no equivalent in SQL, e.g. rules that reference
+// other fields, or custom rules, are listed in a comment instead.
+func Generate(infos []*rules.Info, tag string) ([]byte, error) {
+	g := &gen{tag: tag, domainSeen: make(map[*gotype.Type]bool)}
+
+	var tables bytes.Buffer
+	for _, info := range infos {
+		t := g.table(info)
+
+		tables.WriteString("\n")
+		tables.WriteString("-- " + info.Validator.Type.Name + "\n")
+		if len(t.unsupported) > 0 {
+			tables.WriteString("--\n")
+			tables.WriteString("-- unsupported rules:\n")
+			for _, u := range t.unsupported {
+				tables.WriteString("--   " + u + "\n")
+			}
+		}
+		if len(t.clauses) == 0 {
+			tables.WriteString("-- no constraints\n")
+			continue
+		}
+		tables.WriteString("ALTER TABLE " + ident(t.name) + "\n")
+		tables.WriteString("  " + strings.Join(t.clauses, ",\n  ") + ";\n")
+	}
+
+	var out bytes.Buffer
+	out.WriteString(preamble + "\n")
+	for _, d := range g.domains {
+		out.WriteString("\n")
+		out.WriteString(d)
+	}
+	out.Write(tables.Bytes())
+	return out.Bytes(), nil
+}
+
+// gen maintains the state of the script being generated.
+type gen struct {
+	// the struct tag used for the table and column names
+	tag string
+	// the CREATE DOMAIN statements, in the order of declaration
+	domains []string
+	// set of the types that were already declared as domains
+	domainSeen map[*gotype.Type]bool
+}
+
+// table holds the constraints of a single validator's table.
+type table struct {
+	name string
+	// the ALTER TABLE clauses, e.g. "ALTER COLUMN ..." or "ADD CONSTRAINT ..."
+	clauses []string
+	// the rules that have no equivalent in SQL,
+	// each prefixed with the quoted column name
+	unsupported []string
+}
+
+// table returns the constraints of the table of the given validator.
+func (g *gen) table(info *rules.Info) *table {
+	t := &table{name: g.tableName(info.Validator.Type)}
+	g.columns(info, info.RootNode, t)
+	return t
+}
+
+// tableName returns the name of the table of the given validator type.
+func (g *gen) tableName(typ *gotype.Type) string {
+	for _, f := range typ.Fields {
+		if f.Name == "_" {
+			if v := tagutil.New(f.Tag).First(g.tag); len(v) > 0 {
+				return v
+			}
+		}
+	}
+
+	name := strings.TrimSuffix(typ.Name, "Validator")
+	if len(name) == 0 {
+		name = typ.Name
+	}
+	return snakeCase(name)
+}
+
+// columns adds the constraints of the columns of the struct node n to t.
+// Embedded structs without a column name of their own have their fields
+// mapped directly to the columns of t.
+func (g *gen) columns(info *rules.Info, n *rules.Node, t *table) {
+	for _, f := range n.Fields {
+		if h := info.Validator.ErrorHandlerField; h != nil && n == info.RootNode && h.Name == f.Field.Name {
+			continue
+		}
+		if f.Field.Name == "_" {
+			continue
+		}
+
+		name, tagged := snakeCase(f.Field.Name), false
+		if v := tagutil.New(f.Field.Tag).First(g.tag); v == "-" {
+			continue
+		} else if len(v) > 0 {
+			name, tagged = v, true
+		}
+		if f.Field.IsEmbedded && !tagged && f.Type.Base().IsStruct() {
+			g.columns(info, f.Type.Base(), t)
+			continue
+		}
+		if !f.Field.IsExported {
+			continue
+		}
+
+		c := &column{g: g, info: info, name: name}
+		c.build(f.Type)
+
+		if c.notNull {
+			t.clauses = append(t.clauses, "ALTER COLUMN "+ident(name)+" SET NOT NULL")
+		}
+		if c.domain != nil {
+			t.clauses = append(t.clauses, "ALTER COLUMN "+ident(name)+" TYPE "+ident(snakeCase(c.domain.Name)))
+		}
+		if len(c.conds) > 0 {
+			check := strings.Join(c.conds, " AND ")
+			if c.optional != "" {
+				check = c.optional + " OR (" + check + ")"
+			}
+			constraint := ident(t.name + "_" + name + "_check")
+			t.clauses = append(t.clauses, "ADD CONSTRAINT "+constraint+" CHECK ("+check+")")
+		}
+		for _, u := range c.unsupported {
+			t.unsupported = append(t.unsupported, ident(name)+": "+u)
+		}
+	}
+}
+
+// column builds the constraints of a single column.
+type column struct {
+	g    *gen
+	info *rules.Info
+	name string
+	// indicates that the column must not be NULL
+	notNull bool
+	// the enum type that is to become the column's type, or nil
+	domain *gotype.Type
+	// the conditions of the column's CHECK constraint
+	conds []string
+	// the condition that, if the "optional" rule is present,
+	// allows the zero value to bypass the CHECK constraint
+	optional string
+	// the rules that have no equivalent in SQL
+	unsupported []string
+}
+
+// build translates the rules of the field node n into the column's constraints.
+func (c *column) build(n *rules.Node) {
+	for _, r := range n.CondRules {
+		c.unsupported = append(c.unsupported, r.String())
+	}
+	if n.IsPtr() {
+		if n.IsRequired() {
+			c.notNull = true
+		}
+		n = n.Base()
+	}
+
+	x := ident(c.name)
+	for _, r := range n.IsRules {
+		switch r.Spec.Kind {
+		case rules.NOGUARD, rules.REMOVE:
+			continue
+		case rules.OPTIONAL:
+			if r.Name == "optional" {
+				c.optional, _ = zeroCond(x, n.Type, true)
+			}
+			continue
+		case rules.REQUIRED:
+			c.notNull = true
+			if r.Name == "required" {
+				if cond, ok := zeroCond(x, n.Type, false); ok {
+					c.conds = append(c.conds, cond)
+				}
+			}
+			continue
+		case rules.ENUM:
+			if c.g.domain(c.info, n.Type) {
+				c.domain = n.Type
+				continue
+			}
+		}
+
+		cond, ok := c.cond(x, n, r)
+		if !ok {
+			c.unsupported = append(c.unsupported, strings.TrimRight(r.String(), ":"))
+			continue
+		}
+		c.conds = append(c.conds, cond)
+	}
+
+	// the rules of the elements, keys, and of the fields of
+	// nested structs, which are not mapped to columns, have
+	// no equivalent
+	if n.IsStruct() && !n.Type.IsTime() && len(n.Fields) > 0 && n.HasRules() {
+		c.unsupported = append(c.unsupported, "rules of the struct's fields")
+	}
+	if n.Key != nil {
+		c.elemRules(n.Key, "[", "]")
+	}
+	if n.Elem != nil && !n.IsPtr() {
+		c.elemRules(n.Elem, "[]", "")
+	}
+}
+
+// elemRules adds the rules of the key, or element, node n
+// to the column's unsupported rules, in the tag syntax.
+func (c *column) elemRules(n *rules.Node, prefix, suffix string) {
+	n = n.Base()
+	for _, r := range n.IsRules {
+		switch r.Spec.Kind {
+		case rules.OPTIONAL, rules.NOGUARD, rules.REMOVE:
+			continue
+		}
+		c.unsupported = append(c.unsupported, prefix+strings.TrimRight(r.String(), ":")+suffix)
+	}
+}
+
+// cond returns the SQL condition, on the column expression x, that holds
+// for the values of the node n that pass the rule r. The result will be
+// false if the rule has no equivalent in SQL.
+func (c *column) cond(x string, n *rules.Node, r *rules.Rule) (string, bool) {
+	if r.Negated {
+		rr := *r
+		rr.Negated = false
+
+		cond, ok := c.cond(x, n, &rr)
+		return "NOT (" + cond + ")", ok
+	}
+
+	switch r.Spec.Kind {
+	case rules.ALTERNATION:
+		alts := make([]string, len(r.Alt))
+		for i, a := range r.Alt {
+			cond, ok := c.cond(x, n, a)
+			if !ok {
+				return "", false
+			}
+			alts[i] = cond
+		}
+		return "(" + strings.Join(alts, " OR ") + ")", true
+	case rules.COMPARABLE:
+		list, ok := literals(n, r.Args)
+		if !ok {
+			return "", false
+		}
+		if len(list) == 1 {
+			if r.Name == "ne" {
+				return x + " <> " + list[0], true
+			}
+			return x + " = " + list[0], true
+		}
+		if r.Name == "ne" {
+			return x + " NOT IN (" + strings.Join(list, ", ") + ")", true
+		}
+		return x + " IN (" + strings.Join(list, ", ") + ")", true
+	case rules.ORDERED:
+		if !n.Type.Kind.IsNumeric() || n.Type.IsDuration() {
+			return "", false
+		}
+		list, ok := literals(n, r.Args)
+		if !ok {
+			return "", false
+		}
+		return x + " " + orderedOps[r.Name] + " " + list[0], true
+	case rules.RANGE:
+		if !n.Type.Kind.IsNumeric() || n.Type.IsDuration() {
+			return "", false
+		}
+		list, ok := literals(n, r.Args)
+		if !ok {
+			return "", false
+		}
+		return x + " BETWEEN " + list[0] + " AND " + list[1], true
+	case rules.LENGTH:
+		return lengthCond(x, n, r)
+	case rules.FUNCTION:
+		if n.Type.Kind != gotype.K_STRING {
+			return "", false
+		}
+		return funcCond(x, r)
+	}
+	return "", false
+}
+
+// The SQL operators of the ORDERED rules.
+var orderedOps = map[string]string{
+	"gt":  ">",
+	"lt":  "<",
+	"gte": ">=",
+	"lte": "<=",
+	"min": ">=",
+	"max": "<=",
+}
+
+// lengthCond returns the condition of the "len" and "runecount" rules. The
+// length of a string, as counted by the "len" rule, is its length in bytes.
+func lengthCond(x string, n *rules.Node, r *rules.Rule) (string, bool) {
+	switch t := n.Type; {
+	case t.Kind == gotype.K_STRING && r.Name == "runecount":
+		x = "char_length(" + x + ")"
+	case t.Kind == gotype.K_STRING:
+		x = "octet_length(" + x + ")"
+	case t.Is(gotype.K_ARRAY, gotype.K_SLICE) && t.Elem.Kind.IsBasic() && t.Elem.Kind != gotype.K_UINT8:
+		x = "cardinality(" + x + ")"
+	default:
+		return "", false
+	}
+
+	if len(r.Args) == 1 {
+		return x + " = " + r.Args[0].Value, true
+	}
+
+	lo, hi := r.Args[0], r.Args[1]
+	switch {
+	case !lo.IsEmpty() && !hi.IsEmpty():
+		return x + " BETWEEN " + lo.Value + " AND " + hi.Value, true
+	case !lo.IsEmpty():
+		return x + " >= " + lo.Value, true
+	case !hi.IsEmpty():
+		return x + " <= " + hi.Value, true
+	}
+	return "", false
+}
+
+// funcCond returns the condition of the function rule r. Only the
+// "re" rule, and the "prefix", "suffix", and "contains" rules are
+// supported. The regular expressions are matched using the "~"
+// operator whose POSIX syntax is, for the most part, compatible
+// with the RE2 syntax of the Go implementation.
+func funcCond(x string, r *rules.Rule) (string, bool) {
+	for _, a := range r.Args {
+		if a.Type == rules.ARG_FIELD_ABS || a.Type == rules.ARG_FIELD_REL {
+			return "", false
+		}
+	}
+
+	if r.Spec.FType.IsIncluded() && r.Name == "re" {
+		return x + " ~ " + literal(r.Args[0].Value), true
+	}
+	if r.Spec.FType.Pkg.Path != "strings" {
+		return "", false
+	}
+
+	list := make([]string, len(r.Args))
+	for i, a := range r.Args {
+		switch r.Spec.FName {
+		case "HasPrefix":
+			list[i] = "starts_with(" + x + ", " + literal(a.Value) + ")"
+		case "HasSuffix":
+			n := strconv.Itoa(utf8.RuneCountInString(a.Value))
+			list[i] = "right(" + x + ", " + n + ") = " + literal(a.Value)
+		case "Contains":
+			list[i] = "strpos(" + x + ", " + literal(a.Value) + ") > 0"
+		default:
+			return "", false
+		}
+	}
+	if len(list) == 1 {
+		return list[0], true
+	}
+	return "(" + strings.Join(list, " OR ") + ")", true
+}
+
+// domain declares the given enum type as a domain whose CHECK constraint
+// allows only the values of the type's constants. The result will be
+// false if the type cannot be declared as a domain.
+func (g *gen) domain(info *rules.Info, t *gotype.Type) bool {
+	if g.domainSeen[t] {
+		return true
+	}
+
+	typ, ok := sqlTypes[t.Kind]
+	consts := info.EnumMap[t]
+	if !ok || len(consts) == 0 || len(t.Name) == 0 {
+		return false
+	}
+
+	list := make([]string, len(consts))
+	for i, c := range consts {
+		lit, ok := constLiteral(c)
+		if !ok {
+			return false
+		}
+		list[i] = lit
+	}
+
+	g.domainSeen[t] = true
+	g.domains = append(g.domains, "CREATE DOMAIN "+ident(snakeCase(t.Name))+" AS "+typ+"\n"+
+		"  CHECK (VALUE IN ("+strings.Join(list, ", ")+"));\n")
+	return true
+}
+
+// The SQL types of the domains of the basic Go types.
+var sqlTypes = map[gotype.Kind]string{
+	gotype.K_STRING:  "text",
+	gotype.K_INT:     "bigint",
+	gotype.K_INT8:    "smallint",
+	gotype.K_INT16:   "smallint",
+	gotype.K_INT32:   "integer",
+	gotype.K_INT64:   "bigint",
+	gotype.K_UINT:    "bigint",
+	gotype.K_UINT8:   "smallint",
+	gotype.K_UINT16:  "integer",
+	gotype.K_UINT32:  "bigint",
+	gotype.K_FLOAT32: "real",
+	gotype.K_FLOAT64: "double precision",
+}
+
+// zeroCond returns the condition, on the column expression x, that
+// holds if the value is the zero value of the type t, or, if not is
+// false, if the value is not the zero value of the type t.
+func zeroCond(x string, t *gotype.Type, zero bool) (string, bool) {
+	var val string
+	switch {
+	case t.Kind == gotype.K_STRING:
+		val = "''"
+	case t.Kind.IsNumeric():
+		val = "0"
+	case t.Kind == gotype.K_BOOL:
+		val = "FALSE"
+	default:
+		return "", false
+	}
+	if zero {
+		return x + " = " + val, true
+	}
+	return x + " <> " + val, true
+}
+
+// literals returns the SQL literals of the arguments applied to the node n.
+func literals(n *rules.Node, args []*rules.Arg) ([]string, bool) {
+	list := make([]string, len(args))
+	for i, a := range args {
+		switch a.Type {
+		case rules.ARG_BOOL:
+			list[i] = strings.ToUpper(a.Value)
+		case rules.ARG_INT, rules.ARG_FLOAT:
+			list[i] = a.Value
+		case rules.ARG_STRING:
+			if n.Type.IsTime() || n.Type.IsDuration() {
+				return nil, false
+			}
+			list[i] = literal(a.Value)
+		case rules.ARG_UNKNOWN:
+			if n.Type.Kind.IsNumeric() {
+				list[i] = "0"
+			} else {
+				list[i] = literal(a.Value)
+			}
+		default:
+			return nil, false
+		}
+	}
+	return list, true
+}
+
+// constLiteral returns the SQL literal of the given constant.
+func constLiteral(c gotype.Const) (string, bool) {
+	switch v := c.Value; {
+	case strings.HasPrefix(v, `"`) || strings.HasPrefix(v, "`"):
+		s, err := strconv.Unquote(v)
+		return literal(s), err == nil
+	case len(v) > 0:
+		return v, true
+	}
+	return "", false
+}
+
+// literal returns the given string as an SQL string literal.
+func literal(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// ident returns the given name as a quoted SQL identifier.
+func ident(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// snakeCase returns the snake_case form of the given Go identifier,
+// e.g. "CreatedAt" becomes "created_at" and "UserID" becomes "user_id".
+func snakeCase(name string) string {
+	rs := []rune(name)
+
+	var b strings.Builder
+	for i, r := range rs {
+		if unicode.IsUpper(r) && i > 0 && rs[i-1] != '_' &&
+			(unicode.IsLower(rs[i-1]) || unicode.IsDigit(rs[i-1]) ||
+				(i+1 < len(rs) && unicode.IsLower(rs[i+1]))) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
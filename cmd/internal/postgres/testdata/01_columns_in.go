@@ -0,0 +1,26 @@
+package testdata
+
+import (
+	"time"
+)
+
+type T01UserValidator struct {
+	_         struct{}  `db:"users"`
+	ID        int64     `db:"id" is:"min:1"`
+	Name      string    `db:"full_name" is:"required,len:1:64"`
+	Email     *string   `db:"email" is:"required"`
+	Bio       *string   `db:"bio" is:"runecount::500"`
+	Website   string    `is:"optional,prefix:\"https://\""`
+	CreatedAt time.Time `db:"created_at" is:"required"`
+	Ignored   string    `db:"-" is:"required"`
+	T01Audit
+	hidden string
+}
+
+type T01Audit struct {
+	UpdatedBy string `db:"updated_by" is:"len:1:"`
+}
+
+type T01EmptyValidator struct {
+	Note string
+}
@@ -0,0 +1,16 @@
+-- DO NOT EDIT. This file was generated by "github.com/frk/valid".
+
+-- T01UserValidator
+ALTER TABLE "users"
+  ADD CONSTRAINT "users_id_check" CHECK ("id" >= 1),
+  ALTER COLUMN "full_name" SET NOT NULL,
+  ADD CONSTRAINT "users_full_name_check" CHECK ("full_name" <> '' AND octet_length("full_name") BETWEEN 1 AND 64),
+  ALTER COLUMN "email" SET NOT NULL,
+  ADD CONSTRAINT "users_email_check" CHECK ("email" <> ''),
+  ADD CONSTRAINT "users_bio_check" CHECK (char_length("bio") <= 500),
+  ADD CONSTRAINT "users_website_check" CHECK ("website" = '' OR (starts_with("website", 'https://'))),
+  ALTER COLUMN "created_at" SET NOT NULL,
+  ADD CONSTRAINT "users_updated_by_check" CHECK (octet_length("updated_by") >= 1);
+
+-- T01EmptyValidator
+-- no constraints
@@ -0,0 +1,30 @@
+package testdata
+
+type T02AccountValidator struct {
+	Code     string            `db:"code" is:"len:6"`
+	Age      int               `db:"age" is:"min:18,max:130"`
+	Level    int               `db:"level" is:"rng:1:10"`
+	Ratio    float64           `db:"ratio" is:"gt:0,lt:1"`
+	Kind     string            `db:"kind" is:"eq:a:b:c"`
+	Mode     string            `db:"mode" is:"ne:off"`
+	Color    string            `db:"color" is:"!eq:red"`
+	Status   T02Status         `db:"status" is:"enum"`
+	Backup   T02Status         `db:"backup_status" is:"enum"`
+	Slug     string            `db:"slug" is:"re:\"^[a-z0-9-]+$\""`
+	Path     string            `db:"path" is:"prefix:\"/api/\":\"/v1/\""`
+	File     string            `db:"file" is:"suffix:\".go\""`
+	Text     string            `db:"text" is:"!contains:\"it's\""`
+	Tags     []string          `db:"tags" is:"len:1:,[]len:1:32"`
+	Labels   map[string]string `db:"labels" is:"len::10"`
+	Code2    string            `db:"code2" is:"(len:2|len:3)"`
+	Email    string            `db:"email" is:"email"`
+	Password string            `db:"password" is:"required"`
+	Confirm  string            `db:"confirm" is:"eq:&Password"`
+}
+
+type T02Status string
+
+const (
+	T02StatusActive   T02Status = "active"
+	T02StatusInactive T02Status = "inactive"
+)
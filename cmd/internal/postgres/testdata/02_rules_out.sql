@@ -0,0 +1,30 @@
+-- DO NOT EDIT. This file was generated by "github.com/frk/valid".
+
+CREATE DOMAIN "t02_status" AS text
+  CHECK (VALUE IN ('active', 'inactive'));
+
+-- T02AccountValidator
+--
+-- unsupported rules:
+--   "tags": []len:1:32
+--   "labels": len::10
+--   "email": email
+--   "confirm": eq:&Password
+ALTER TABLE "t02_account"
+  ADD CONSTRAINT "t02_account_code_check" CHECK (octet_length("code") = 6),
+  ADD CONSTRAINT "t02_account_age_check" CHECK ("age" >= 18 AND "age" <= 130),
+  ADD CONSTRAINT "t02_account_level_check" CHECK ("level" BETWEEN 1 AND 10),
+  ADD CONSTRAINT "t02_account_ratio_check" CHECK ("ratio" > 0 AND "ratio" < 1),
+  ADD CONSTRAINT "t02_account_kind_check" CHECK ("kind" IN ('a', 'b', 'c')),
+  ADD CONSTRAINT "t02_account_mode_check" CHECK ("mode" <> 'off'),
+  ADD CONSTRAINT "t02_account_color_check" CHECK (NOT ("color" = 'red')),
+  ALTER COLUMN "status" TYPE "t02_status",
+  ALTER COLUMN "backup_status" TYPE "t02_status",
+  ADD CONSTRAINT "t02_account_slug_check" CHECK ("slug" ~ '^[a-z0-9-]+$'),
+  ADD CONSTRAINT "t02_account_path_check" CHECK ((starts_with("path", '/api/') OR starts_with("path", '/v1/'))),
+  ADD CONSTRAINT "t02_account_file_check" CHECK (right("file", 3) = '.go'),
+  ADD CONSTRAINT "t02_account_text_check" CHECK (NOT (strpos("text", 'it''s') > 0)),
+  ADD CONSTRAINT "t02_account_tags_check" CHECK (cardinality("tags") >= 1),
+  ADD CONSTRAINT "t02_account_code2_check" CHECK ((octet_length("code2") = 2 OR octet_length("code2") = 3)),
+  ALTER COLUMN "password" SET NOT NULL,
+  ADD CONSTRAINT "t02_account_password_check" CHECK ("password" <> '');
@@ -25,6 +25,31 @@ type Info struct {
 	// EnumMap maps types to a slice of
 	// constants declared with that type.
 	EnumMap map[*gotype.Type][]gotype.Const
+	// DepMap maps the specs of rules whose functions are methods
+	// of a dependency type to the selector of the validator's field
+	// that holds the instance of that dependency type.
+	DepMap map[*Spec]gotype.FieldSelector
+	// KeyIndex is the style in which the generated code should include
+	// the indexes of collection elements in the field keys. See the
+	// IndexStyle field of config.FieldKeyConfig for the valid values.
+	KeyIndex string
+	// KeySep is the separator used for joining the field keys.
+	KeySep string
+	// KeyTag is the struct tag whose values are used as the field
+	// keys, or empty if the field keys are the fields' names.
+	KeyTag string
+	// Groups holds the Node representations of the Validator for each
+	// of the validation groups that are referenced by the Validator.
+	Groups []*Group
+}
+
+// Group holds the Node representation of a Validator that contains
+// only those rules that apply to a specific validation group.
+type Group struct {
+	// The name of the validation group.
+	Name string
+	// The Node representation of the Validator.
+	RootNode *Node
 }
 
 // Checker maintains the state of the rule checker.
@@ -42,13 +67,22 @@ type Checker struct {
 	vs *gotype.Validator
 	// The function used for generating unique field keys.
 	fieldKey FieldKeyFunc
+	// The field key configuration, used to reset fieldKey.
+	fkCfg *config.FieldKeyConfig
+	// The name of the validation group for which the
+	// Node tree is being constructed, empty for none.
+	group string
+	// The set of validation groups referenced by the
+	// struct tags of the Validtor struct being rule-checked.
+	groups map[string]bool
 }
 
 // NewChecker returns a new Checker instance.
 // The optional info argument, will be populated during rule-checking.
 func NewChecker(ast *search.AST, pkg search.Pkg, fkCfg *config.FieldKeyConfig, info *Info) (c *Checker) {
-	c = &Checker{ast: ast, pkg: gotype.Pkg(pkg)}
+	c = &Checker{ast: ast, pkg: gotype.Pkg(pkg), fkCfg: fkCfg}
 	c.fieldKey = fkFunc(fkCfg)
+	c.groups = make(map[string]bool)
 
 	c.Info = info
 	if c.Info == nil {
@@ -56,6 +90,12 @@ func NewChecker(ast *search.AST, pkg search.Pkg, fkCfg *config.FieldKeyConfig, i
 	}
 	c.Info.KeyMap = make(map[string]*FieldNode)
 	c.Info.EnumMap = make(map[*gotype.Type][]gotype.Const)
+	c.Info.DepMap = make(map[*Spec]gotype.FieldSelector)
+	if fkCfg != nil {
+		c.Info.KeyIndex = fkCfg.IndexStyle.Value
+		c.Info.KeySep = fkCfg.Separator.Value
+		c.Info.KeyTag = fkCfg.Tag.Value
+	}
 	return c
 }
 
@@ -80,7 +120,9 @@ func (c *Checker) Check(match *search.Match) error {
 	// 4. populate c.Info (if no error)
 	c.Info.Validator = c.vs
 	c.Info.RootNode = rootNode
-	return nil
+
+	// 5. convert & rule-check the Node trees of the validation groups
+	return c.checkGroups()
 }
 
 func (c *Checker) check(n *Node) error {
@@ -96,6 +138,16 @@ func (c *Checker) check(n *Node) error {
 			return err
 		}
 	}
+	if len(n.CondRules) > 0 {
+		if err := c.checkCondRules(n); err != nil {
+			return err
+		}
+	}
+	if n.Default != nil {
+		if err := c.defaultCheck(n); err != nil {
+			return err
+		}
+	}
 
 	switch n.Type.Kind {
 	case gotype.K_PTR:
@@ -119,7 +171,106 @@ func (c *Checker) check(n *Node) error {
 
 func (c *Checker) checkRules(n *Node) error {
 	for _, r := range n.IsRules {
-		// Ensure that the Value of a Arg of type ARG_FIELD
+		if err := c.checkRule(n, r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkRule rule-checks the given rule of Node n.
+func (c *Checker) checkRule(n *Node, r *Rule) error {
+	if r.Negated && !canCompose(r) {
+		return &Error{C: ERR_NEGATION_KIND, ty: n.Type, r: r}
+	}
+
+	// Ensure that the Value of a Arg of type ARG_FIELD
+	// references a valid field key which will be indicated
+	// by a presence of a selector in the analyzer's KeyMap.
+	for _, a := range r.Args {
+		if a.Type == ARG_FIELD_ABS || a.Type == ARG_FIELD_REL {
+			if _, ok := c.Info.KeyMap[a.Value]; !ok {
+				return &Error{C: ERR_FIELD_UNKNOWN, ty: n.Type, r: r, ra: a}
+			}
+		}
+	}
+
+	c.fixRuleArgs(r)
+
+	// Check that the number of arguments provided
+	// to the rule is allowed by the spec.
+	if r.Spec.ArgMin > -1 && r.Spec.ArgMin > len(r.Args) {
+		return &Error{C: ERR_RULE_ARGMIN, ty: n.Type, r: r}
+	}
+	if r.Spec.ArgMax > -1 && r.Spec.ArgMax < len(r.Args) {
+		return &Error{C: ERR_RULE_ARGMAX, ty: n.Type, r: r}
+	}
+
+	// run type specific rule-check
+	switch r.Spec.Kind {
+	case REQUIRED:
+		if err := c.requiredCheck(n, r); err != nil {
+			return err
+		}
+	case COMPARABLE:
+		if err := c.comparableCheck(n, r); err != nil {
+			return err
+		}
+	case ORDERED:
+		if err := c.orderedCheck(n, r); err != nil {
+			return err
+		}
+	case LENGTH:
+		if err := c.lengthCheck(n, r); err != nil {
+			return err
+		}
+	case RANGE:
+		if err := c.rangeCheck(n, r); err != nil {
+			return err
+		}
+	case ENUM:
+		if err := c.enumCheck(n, r); err != nil {
+			return err
+		}
+	case TEMPORAL:
+		if err := c.temporalCheck(n, r); err != nil {
+			return err
+		}
+	case ALTERNATION:
+		if err := c.alternationCheck(n, r); err != nil {
+			return err
+		}
+	case UNIQUE:
+		if err := c.uniqueCheck(n, r); err != nil {
+			return err
+		}
+	case FUNCTION:
+		if err := c.functionCheck(n, r); err != nil {
+			return err
+		}
+	case METHOD:
+		if err := c.methodCheck(n, r); err != nil {
+			return err
+		}
+	case OPTIONAL:
+		if err := c.optionalCheck(n, r); err != nil {
+			return err
+		}
+	case REMOVE:
+		// if err := c.checkRemove(n, r, spec); err != nil {
+		// 	return err
+		// }
+	}
+	return nil
+}
+
+func (c *Checker) checkPreproc(n *Node) error {
+	for _, r := range n.PreRules {
+		if r.Spec.Kind != PREPROC {
+			return &Error{C: ERR_PREPROC_INVALID, ty: n.Type, r: r}
+		}
+
+		// Ensure that the Value of a Arg of kind AFIELD
 		// references a valid field key which will be indicated
 		// by a presence of a selector in the analyzer's KeyMap.
 		for _, a := range r.Args {
@@ -141,57 +292,18 @@ func (c *Checker) checkRules(n *Node) error {
 			return &Error{C: ERR_RULE_ARGMAX, ty: n.Type, r: r}
 		}
 
-		// run type specific rule-check
-		switch r.Spec.Kind {
-		case REQUIRED:
-			if err := c.requiredCheck(n, r); err != nil {
-				return err
-			}
-		case COMPARABLE:
-			if err := c.comparableCheck(n, r); err != nil {
-				return err
-			}
-		case ORDERED:
-			if err := c.orderedCheck(n, r); err != nil {
-				return err
-			}
-		case LENGTH:
-			if err := c.lengthCheck(n, r); err != nil {
-				return err
-			}
-		case RANGE:
-			if err := c.rangeCheck(n, r); err != nil {
-				return err
-			}
-		case ENUM:
-			if err := c.enumCheck(n, r); err != nil {
-				return err
-			}
-		case FUNCTION:
-			if err := c.functionCheck(n, r); err != nil {
-				return err
-			}
-		case METHOD:
-			if err := c.methodCheck(n, r); err != nil {
-				return err
-			}
-		case OPTIONAL:
-			if err := c.optionalCheck(n, r); err != nil {
-				return err
-			}
-		case REMOVE:
-			// if err := c.checkRemove(n, r, spec); err != nil {
-			// 	return err
-			// }
+		// run spec specific rule-check
+		if err := c.preprocessorCheck(n, r); err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
-func (c *Checker) checkPreproc(n *Node) error {
-	for _, r := range n.PreRules {
-		if r.Spec.Kind != PREPROC {
-			return &Error{C: ERR_PREPROC_INVALID, ty: n.Type, r: r}
+func (c *Checker) checkCondRules(n *Node) error {
+	for _, r := range n.CondRules {
+		if r.Negated {
+			return &Error{C: ERR_NEGATION_KIND, ty: n.Type, r: r}
 		}
 
 		// Ensure that the Value of a Arg of kind AFIELD
@@ -205,8 +317,6 @@ func (c *Checker) checkPreproc(n *Node) error {
 			}
 		}
 
-		c.fixRuleArgs(r)
-
 		// Check that the number of arguments provided
 		// to the rule is allowed by the spec.
 		if r.Spec.ArgMin > -1 && r.Spec.ArgMin > len(r.Args) {
@@ -217,7 +327,7 @@ func (c *Checker) checkPreproc(n *Node) error {
 		}
 
 		// run spec specific rule-check
-		if err := c.preprocessorCheck(n, r); err != nil {
+		if err := c.condRequiredCheck(n, r); err != nil {
 			return err
 		}
 	}
@@ -32,6 +32,8 @@ func TestMain(m *testing.M) {
 	//fmt.Println(pkgs)
 
 	T.loc = gotype.MustGetType("time", "Location", &test_ast)
+	T.time = gotype.MustGetType("time", "Time", &test_ast)
+	T.duration = gotype.MustGetType("time", "Duration", &test_ast)
 
 	if err := loadIncludedSpecs(&test_ast); err != nil {
 		log.Fatal(err)
@@ -268,19 +270,21 @@ type test_values struct {
 	_func *types.Func
 	_err  error
 
-	string  *gotype.Type
-	int     *gotype.Type
-	int32   *gotype.Type
-	int64   *gotype.Type
-	uint    *gotype.Type
-	uint8   *gotype.Type
-	uint16  *gotype.Type
-	uint64  *gotype.Type
-	bool    *gotype.Type
-	float64 *gotype.Type
-	rune    *gotype.Type
-	byte    *gotype.Type
-	loc     *gotype.Type
+	string   *gotype.Type
+	int      *gotype.Type
+	int32    *gotype.Type
+	int64    *gotype.Type
+	uint     *gotype.Type
+	uint8    *gotype.Type
+	uint16   *gotype.Type
+	uint64   *gotype.Type
+	bool     *gotype.Type
+	float64  *gotype.Type
+	rune     *gotype.Type
+	byte     *gotype.Type
+	loc      *gotype.Type
+	time     *gotype.Type
+	duration *gotype.Type
 
 	pkg gotype.Pkg
 }
@@ -324,19 +328,21 @@ var T = test_values{
 	_func: &types.Func{},
 	_err:  fmt.Errorf(""),
 
-	string:  &gotype.Type{Kind: gotype.K_STRING},
-	int:     &gotype.Type{Kind: gotype.K_INT},
-	int32:   &gotype.Type{Kind: gotype.K_INT32},
-	int64:   &gotype.Type{Kind: gotype.K_INT64},
-	uint:    &gotype.Type{Kind: gotype.K_UINT},
-	uint8:   &gotype.Type{Kind: gotype.K_UINT8},
-	uint16:  &gotype.Type{Kind: gotype.K_UINT16},
-	uint64:  &gotype.Type{Kind: gotype.K_UINT64},
-	float64: &gotype.Type{Kind: gotype.K_FLOAT64},
-	bool:    &gotype.Type{Kind: gotype.K_BOOL},
-	rune:    &gotype.Type{Kind: gotype.K_INT32, IsRune: true},
-	byte:    &gotype.Type{Kind: gotype.K_UINT8, IsByte: true},
-	loc:     nil,
+	string:   &gotype.Type{Kind: gotype.K_STRING},
+	int:      &gotype.Type{Kind: gotype.K_INT},
+	int32:    &gotype.Type{Kind: gotype.K_INT32},
+	int64:    &gotype.Type{Kind: gotype.K_INT64},
+	uint:     &gotype.Type{Kind: gotype.K_UINT},
+	uint8:    &gotype.Type{Kind: gotype.K_UINT8},
+	uint16:   &gotype.Type{Kind: gotype.K_UINT16},
+	uint64:   &gotype.Type{Kind: gotype.K_UINT64},
+	float64:  &gotype.Type{Kind: gotype.K_FLOAT64},
+	bool:     &gotype.Type{Kind: gotype.K_BOOL},
+	rune:     &gotype.Type{Kind: gotype.K_INT32, IsRune: true},
+	byte:     &gotype.Type{Kind: gotype.K_UINT8, IsByte: true},
+	loc:      nil,
+	time:     nil,
+	duration: nil,
 
 	pkg: gotype.Pkg{
 		Path: "github.com/frk/valid/cmd/internal/rules/testdata",
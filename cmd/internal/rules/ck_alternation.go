@@ -0,0 +1,29 @@
+package rules
+
+// alternationCheck checks that each of the alternation group's rules can
+// be composed with the other rules of the group, and then rule-checks them.
+func (c *Checker) alternationCheck(n *Node, r *Rule) error {
+	for _, a := range r.Alt {
+		if !canCompose(a) {
+			return &Error{C: ERR_ALTERNATION_KIND, ty: n.Type, r: a}
+		}
+		if err := c.checkRule(n, a); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// canCompose reports whether or not the given rule can be negated or used
+// in an alternation group. This is the case for rules whose validity can be
+// determined by a single boolean expression, i.e. basic comparisons and
+// non-erroring functions and methods.
+func canCompose(r *Rule) bool {
+	switch r.Spec.Kind {
+	case COMPARABLE, ORDERED, LENGTH, RANGE, ENUM, TEMPORAL, METHOD:
+		return true
+	case FUNCTION:
+		return r.IsBasic()
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+package rules
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/frk/valid/cmd/internal/config"
+	"github.com/frk/valid/cmd/internal/gotype"
+
+	"github.com/frk/compare"
+)
+
+func TestChecker_alternationCheck(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		show bool
+	}{{
+		name: "Test_alternation_Validator", err: nil,
+	}, {
+		name: "Test_ERR_NEGATION_KIND_1_Validator",
+		err: &Error{C: ERR_NEGATION_KIND, a: T._ast, sfv: T._var,
+			sf: &gotype.StructField{
+				Pkg:  T.pkg,
+				Name: "F", IsExported: true,
+				Tag:  `is:"!required"`,
+				Type: T.string,
+				Var:  T._var,
+			},
+			ty: T.string,
+			r: &Rule{
+				Name:    "required",
+				Negated: true,
+				Spec:    GetSpec("required"),
+			},
+		},
+	}, {
+		name: "Test_ERR_ALTERNATION_KIND_1_Validator",
+		err: &Error{C: ERR_ALTERNATION_KIND, a: T._ast, sfv: T._var,
+			sf: &gotype.StructField{
+				Pkg:  T.pkg,
+				Name: "F", IsExported: true,
+				Tag:  `is:"(unique|len:1)"`,
+				Type: T.Slice(T.string),
+				Var:  T._var,
+			},
+			ty: T.Slice(T.string),
+			r: &Rule{
+				Name: "unique",
+				Spec: GetSpec("unique"),
+			},
+		},
+	}}
+
+	compare := compare.Config{ObserveFieldTag: "cmp"}
+	fkCfg := &config.FieldKeyConfig{
+		Join:      config.Bool{Value: true, IsSet: true},
+		Separator: config.String{Value: ".", IsSet: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match := testMatch(t, tt.name)
+
+			info := new(Info)
+			checker := NewChecker(&test_ast, test_pkg.Pkg(), fkCfg, info)
+			err := checker.Check(match)
+
+			got := _ttError(err)
+			want := _ttError(tt.err)
+			if e := compare.Compare(got, want); e != nil {
+				t.Errorf("Error: %v", e)
+			}
+
+			if tt.show && err != nil {
+				fmt.Println(err)
+			}
+		})
+	}
+}
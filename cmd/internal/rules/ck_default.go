@@ -0,0 +1,27 @@
+package rules
+
+// defaultCheck checks that the node's default value can be assigned to the node.
+func (c *Checker) defaultCheck(n *Node) error {
+	a := n.Default
+
+	// a field with a default value is never zero, and therefore
+	// the default value is in conflict with any REQUIRED rule
+	for _, r := range n.IsRules {
+		if r.Spec.Kind == REQUIRED {
+			return &Error{C: ERR_DEFAULT_CONFLICT, ty: n.Type, r: r, ra: a}
+		}
+	}
+
+	if a.Type == ARG_FIELD_ABS || a.Type == ARG_FIELD_REL {
+		if _, ok := c.Info.KeyMap[a.Value]; !ok {
+			return &Error{C: ERR_FIELD_UNKNOWN, ty: n.Type, ra: a}
+		}
+	}
+
+	// the default value is assigned to the pointer's base
+	t := n.Base().Type
+	if !t.Kind.IsBasic() || a.Type == ARG_UNKNOWN || !a.CanAssignTo(t, c.KeyMap) {
+		return &Error{C: ERR_DEFAULT_TYPE, ty: t, ra: a}
+	}
+	return nil
+}
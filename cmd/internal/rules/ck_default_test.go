@@ -0,0 +1,113 @@
+package rules
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/frk/valid/cmd/internal/config"
+	"github.com/frk/valid/cmd/internal/gotype"
+
+	"github.com/frk/compare"
+)
+
+func TestChecker_defaultCheck(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		show bool
+	}{{
+		name: "Test_default_Validator", err: nil,
+	}, {
+		name: "Test_ERR_DEFAULT_CONFLICT_1_Validator",
+		err: &Error{C: ERR_DEFAULT_CONFLICT, a: T._ast, sfv: T._var,
+			sf: &gotype.StructField{
+				Pkg:  T.pkg,
+				Name: "F", IsExported: true,
+				Tag:  `is:"required" default:"foo"`,
+				Type: T.string,
+				Var:  T._var,
+			},
+			ty: T.string,
+			r:  &Rule{Name: "required", Spec: GetSpec("required")},
+			ra: &Arg{Type: ARG_STRING, Value: "foo"},
+		},
+	}, {
+		name: "Test_ERR_DEFAULT_CONFLICT_2_Validator",
+		err: &Error{C: ERR_DEFAULT_CONFLICT, a: T._ast, sfv: T._var,
+			sf: &gotype.StructField{
+				Pkg:  T.pkg,
+				Name: "F", IsExported: true,
+				Tag:  `is:"notnil" default:"42"`,
+				Type: T.Ptr(T.int),
+				Var:  T._var,
+			},
+			ty: T.Ptr(T.int),
+			r:  &Rule{Name: "notnil", Spec: GetSpec("notnil")},
+			ra: &Arg{Type: ARG_INT, Value: "42"},
+		},
+	}, {
+		name: "Test_ERR_DEFAULT_TYPE_1_Validator",
+		err: &Error{C: ERR_DEFAULT_TYPE, a: T._ast, sfv: T._var,
+			sf: &gotype.StructField{
+				Pkg:  T.pkg,
+				Name: "F", IsExported: true,
+				Tag:  `default:"foo"`,
+				Type: T.int,
+				Var:  T._var,
+			},
+			ty: T.int,
+			ra: &Arg{Type: ARG_STRING, Value: "foo"},
+		},
+	}, {
+		name: "Test_ERR_DEFAULT_TYPE_2_Validator",
+		err: &Error{C: ERR_DEFAULT_TYPE, a: T._ast, sfv: T._var,
+			sf: &gotype.StructField{
+				Pkg:  T.pkg,
+				Name: "F", IsExported: true,
+				Tag:  `default:"foo"`,
+				Type: T.Slice(T.string),
+				Var:  T._var,
+			},
+			ty: T.Slice(T.string),
+			ra: &Arg{Type: ARG_STRING, Value: "foo"},
+		},
+	}, {
+		name: "Test_ERR_DEFAULT_TYPE_3_Validator",
+		err: &Error{C: ERR_DEFAULT_TYPE, a: T._ast, sfv: T._var,
+			sf: &gotype.StructField{
+				Pkg:  T.pkg,
+				Name: "F", IsExported: true,
+				Tag:  `default:"-1"`,
+				Type: T.Ptr(T.uint),
+				Var:  T._var,
+			},
+			ty: T.uint,
+			ra: &Arg{Type: ARG_INT, Value: "-1"},
+		},
+	}}
+
+	compare := compare.Config{ObserveFieldTag: "cmp"}
+	fkCfg := &config.FieldKeyConfig{
+		Join:      config.Bool{Value: true, IsSet: true},
+		Separator: config.String{Value: ".", IsSet: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match := testMatch(t, tt.name)
+
+			info := new(Info)
+			checker := NewChecker(&test_ast, test_pkg.Pkg(), fkCfg, info)
+			err := checker.Check(match)
+
+			got := _ttError(err)
+			want := _ttError(tt.err)
+			if e := compare.Compare(got, want); e != nil {
+				t.Errorf("Error: %v", e)
+			}
+			if tt.show && err != nil {
+				fmt.Println(err)
+			}
+		})
+	}
+}
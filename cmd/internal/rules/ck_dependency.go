@@ -0,0 +1,65 @@
+package rules
+
+import (
+	"github.com/frk/valid/cmd/internal/gotype"
+)
+
+// dependencyCheck checks that the validator struct has a field that holds
+// an instance of the dependency type on which the rule's function is declared
+// as a method. The field can either be a direct field of the validator, or a
+// field of the validator's "Deps" struct field. If found, the field's selector
+// is recorded in the DepMap. A "Deps" field of a pointer type is not allowed
+// since the generated code does not check it for nil.
+func (c *Checker) dependencyCheck(n *Node, r *Rule) error {
+	if _, ok := c.Info.DepMap[r.Spec]; ok {
+		return nil
+	}
+
+	if fs := findDepField(c.vs.Type, r.Spec.Dep); fs != nil {
+		if len(fs) > 1 && fs[0].Type.Kind == gotype.K_PTR {
+			return &Error{C: ERR_DEPENDENCY_DEPSPTR, ty: n.Type, r: r}
+		}
+		c.Info.DepMap[r.Spec] = fs
+		return nil
+	}
+	return &Error{C: ERR_DEPENDENCY_MISSING, ty: n.Type, r: r}
+}
+
+// findDepField returns the selector of the field of the struct type t that
+// holds an instance of the dependency type dep. If t has no such field, the
+// fields of t's "Deps" field will be searched next. If no field is found, nil
+// will be returned.
+func findDepField(t, dep *gotype.Type) gotype.FieldSelector {
+	for _, f := range t.Fields {
+		if isDepType(f.Type, dep) {
+			return gotype.FieldSelector{f}
+		}
+	}
+	for _, f := range t.Fields {
+		if f.Name != "Deps" {
+			continue
+		}
+
+		ft := f.Type
+		if ft.Kind == gotype.K_PTR {
+			ft = ft.Elem
+		}
+		if ft.Kind != gotype.K_STRUCT {
+			continue
+		}
+		for _, f2 := range ft.Fields {
+			if isDepType(f2.Type, dep) {
+				return gotype.FieldSelector{f, f2}
+			}
+		}
+	}
+	return nil
+}
+
+// isDepType reports whether t is the dependency type dep, or a pointer to it.
+func isDepType(t, dep *gotype.Type) bool {
+	if t.Kind == gotype.K_PTR {
+		t = t.Elem
+	}
+	return len(t.Name) > 0 && t.Name == dep.Name && t.Pkg.Path == dep.Pkg.Path
+}
@@ -0,0 +1,122 @@
+package rules
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/frk/valid/cmd/internal/config"
+	"github.com/frk/valid/cmd/internal/gotype"
+
+	"github.com/frk/compare"
+)
+
+func TestChecker_dependencyCheck(t *testing.T) {
+	cfg := loadConfig("testdata/configs/dep_custom_rules.yaml")
+	if err := initCustomSpecs(cfg, &test_ast); err != nil {
+		t.Fatalf("loadConfig(testdata/configs/dep_custom_rules.yaml) failed: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		deps map[string][]string
+		err  error
+		show bool
+	}{{
+		name: "Test_dependency_Validator",
+		deps: map[string][]string{
+			"unique_email":  {"Repo"},
+			"entitled":      {"Deps", "Ent"},
+			"pre:normalize": {"Deps", "Norm"},
+		},
+	}, {
+		name: "Test_ERR_DEPENDENCY_MISSING_1_Validator",
+		err: &Error{C: ERR_DEPENDENCY_MISSING, a: T._ast, sfv: T._var,
+			sf: &gotype.StructField{
+				Pkg:  T.pkg,
+				Name: "F", IsExported: true,
+				Tag:  `is:"unique_email"`,
+				Type: T.string,
+				Var:  T._var,
+			},
+			ty: T.string,
+			r:  &Rule{Name: "unique_email", Spec: GetSpec("unique_email")},
+		},
+	}, {
+		name: "Test_ERR_DEPENDENCY_MISSING_2_Validator",
+		err: &Error{C: ERR_DEPENDENCY_MISSING, a: T._ast, sfv: T._var,
+			sf: &gotype.StructField{
+				Pkg:  T.pkg,
+				Name: "F", IsExported: true,
+				Tag:  `pre:"normalize"`,
+				Type: T.string,
+				Var:  T._var,
+			},
+			ty: T.string,
+			r:  &Rule{Name: "normalize", Spec: GetSpec("pre:normalize")},
+		},
+	}, {
+		name: "Test_ERR_DEPENDENCY_MISSING_3_Validator",
+		err: &Error{C: ERR_DEPENDENCY_MISSING, a: T._ast, sfv: T._var,
+			sf: &gotype.StructField{
+				Pkg:  T.pkg,
+				Name: "F", IsExported: true,
+				Tag:  `is:"entitled"`,
+				Type: T.string,
+				Var:  T._var,
+			},
+			ty: T.string,
+			r:  &Rule{Name: "entitled", Spec: GetSpec("entitled")},
+		},
+	}, {
+		name: "Test_ERR_DEPENDENCY_DEPSPTR_1_Validator",
+		err: &Error{C: ERR_DEPENDENCY_DEPSPTR, a: T._ast, sfv: T._var,
+			sf: &gotype.StructField{
+				Pkg:  T.pkg,
+				Name: "F", IsExported: true,
+				Tag:  `is:"entitled"`,
+				Type: T.string,
+				Var:  T._var,
+			},
+			ty: T.string,
+			r:  &Rule{Name: "entitled", Spec: GetSpec("entitled")},
+		},
+	}}
+
+	compare := compare.Config{ObserveFieldTag: "cmp"}
+	fkCfg := &config.FieldKeyConfig{
+		Join:      config.Bool{Value: true, IsSet: true},
+		Separator: config.String{Value: ".", IsSet: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match := testMatch(t, tt.name)
+
+			info := new(Info)
+			checker := NewChecker(&test_ast, test_pkg.Pkg(), fkCfg, info)
+			err := checker.Check(match)
+
+			got := _ttError(err)
+			want := _ttError(tt.err)
+			if e := compare.Compare(got, want); e != nil {
+				t.Errorf("Error: %v", e)
+			}
+
+			if err == nil {
+				deps := make(map[string][]string)
+				for spec, fs := range info.DepMap {
+					for _, f := range fs {
+						deps[spec.Name] = append(deps[spec.Name], f.Name)
+					}
+				}
+				if e := compare.Compare(deps, tt.deps); e != nil {
+					t.Errorf("DepMap: %v", e)
+				}
+			}
+
+			if tt.show && err != nil {
+				fmt.Println(err)
+			}
+		})
+	}
+}
@@ -2,6 +2,8 @@ package rules
 
 import (
 	"regexp"
+	"strings"
+	"time"
 
 	"github.com/frk/valid"
 	"github.com/frk/valid/cmd/internal/gotype"
@@ -11,6 +13,14 @@ import (
 
 // functionCheck ...
 func (c *Checker) functionCheck(n *Node, r *Rule) error {
+	// If the function is a method of a dependency type make
+	// sure that the validator has access to the dependency.
+	if r.Spec.Dep != nil {
+		if err := c.dependencyCheck(n, r); err != nil {
+			return err
+		}
+	}
+
 	// Check if an instance of n can be passed
 	// to the function as its first argument.
 	p := r.Spec.FType.In[0].Type
@@ -34,6 +44,9 @@ func (c *Checker) functionCheck(n *Node, r *Rule) error {
 	// Some included functions accept arguments of a known set of valid
 	// values, check that the rule argument's values belong to that set.
 	if r.Spec.FType.IsIncluded() {
+		if err := c.checkIncludedRuleArgFields(r); err != nil {
+			return c.err(err, errOpts{C: ERR_FUNCTION_ARGTYPE, ty: n.Type})
+		}
 		if err := c.checkIncludedRuleArgValues(r); err != nil {
 			return c.err(err, errOpts{C: ERR_FUNCTION_ARGVALUE, ty: n.Type})
 		}
@@ -93,6 +106,27 @@ func (c *Checker) checkRuleArgsAsFuncParams(r *Rule) error {
 	return nil
 }
 
+// checkIncludedRuleArgFields is used to validate the field arguments provided
+// to the rules of the github.com/frk/valid package. Rules that expect a country
+// code accept a field argument only if the field's type is of the string kind,
+// a field of any other kind, even if convertible to string (e.g. []byte or
+// rune), would produce a country code that is not what the user intended.
+func (c *Checker) checkIncludedRuleArgFields(r *Rule) error {
+	switch r.Spec.Name {
+	case "ic", "iso31662", "passport", "phone", "vat", "zip":
+		for i, a := range r.Args {
+			if a.Type != ARG_FIELD_ABS && a.Type != ARG_FIELD_REL {
+				continue
+			}
+			if f := c.KeyMap[a.Value].Type; f.Type.Kind != gotype.K_STRING {
+				p, pi := r.Spec.getFuncParamByArgIndex(i)
+				return &Error{r: r, ra: a, fp: p, fpi: &pi}
+			}
+		}
+	}
+	return nil
+}
+
 // checkIncludedRuleArgValues is used to validate the literal arguments
 // provided to the rules of the github.com/frk/valid package.
 func (c *Checker) checkIncludedRuleArgValues(r *Rule) error {
@@ -124,6 +158,14 @@ func (c *Checker) checkIncludedRuleArgValues(r *Rule) error {
 			return &Error{r: r, ra: a0, fp: p, fpi: &pi}
 		}
 
+	// date expects either a Go time layout, or the name of
+	// one of the layouts predefined by the "time" package
+	case "date":
+		if a0 != nil && !isTimeLayout(a0.Value) {
+			p, pi := r.Spec.getFuncParamByArgIndex(0)
+			return &Error{r: r, ra: a0, fp: p, fpi: &pi}
+		}
+
 	// decimal expects a cldr-supported locale as argument
 	case "decimal":
 		if a0 != nil {
@@ -215,8 +257,16 @@ func (c *Checker) checkIncludedRuleArgValues(r *Rule) error {
 			}
 		}
 
-	// phone, var, and zip all expect a valid ISO-3166-1A country code
-	case "phone", "vat", "zip":
+	// iso31662 expects an optional ISO-3166-1A country code
+	case "iso31662":
+		if a0 != nil && len(a0.Value) > 0 && !valid.ISO31661A(a0.Value, 0) {
+			p, pi := r.Spec.getFuncParamByArgIndex(0)
+			return &Error{r: r, ra: a0, fp: p, fpi: &pi}
+		}
+
+	// ic, passport, phone, vat, zip, and the e164 preprocessor
+	// all expect a valid ISO-3166-1A country code
+	case "ic", "passport", "phone", "vat", "zip", "pre:e164":
 		if a0 != nil && !valid.ISO31661A(a0.Value, 0) {
 			p, pi := r.Spec.getFuncParamByArgIndex(0)
 			return &Error{r: r, ra: a0, fp: p, fpi: &pi}
@@ -224,3 +274,17 @@ func (c *Checker) checkIncludedRuleArgValues(r *Rule) error {
 	}
 	return nil
 }
+
+// isTimeLayout reports whether or not s is the name of one of the layouts
+// predefined by the "time" package, or a layout that contains at least one
+// of the layout elements recognized by the "time" package.
+func isTimeLayout(s string) bool {
+	if _, ok := tables.TimeLayout[strings.ToLower(s)]; ok {
+		return true
+	}
+
+	// A layout with no elements formats to itself. The reference
+	// time here differs from the layout's in every element.
+	t := time.Date(2001, 3, 4, 5, 6, 7, 8, time.FixedZone("XYZ", 3600))
+	return t.Format(s) != s
+}
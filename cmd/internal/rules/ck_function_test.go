@@ -58,6 +58,29 @@ func TestChecker_functionCheck(t *testing.T) {
 			fp:  &gotype.Var{Name: "ver", Type: T.int},
 			fpi: T.iptr(0),
 		},
+	}, {
+		name: "Test_ERR_FUNCTION_ARGTYPE_2_Validator",
+		err: &Error{C: ERR_FUNCTION_ARGTYPE, a: T._ast, sfv: T._var,
+			sf: &gotype.StructField{
+				Pkg:  T.pkg,
+				Name: "F", IsExported: true,
+				Tag:  `is:"zip:&G"`,
+				Type: T.string,
+				Var:  T._var,
+			},
+			ty: T.string,
+			r: &Rule{
+				Name: "zip",
+				Args: []*Arg{
+					{Type: ARG_FIELD_ABS, Value: "G"},
+				},
+				Spec: GetSpec("zip"),
+			},
+			ra:  &Arg{Type: ARG_FIELD_ABS, Value: "G"},
+			raf: T._sf(),
+			fp:  &gotype.Var{Name: "cc", Type: T.string},
+			fpi: T.iptr(0),
+		},
 	}, {
 		name: "Test_ERR_FUNCTION_ARGVALUE_1_Validator",
 		err: &Error{C: ERR_FUNCTION_ARGVALUE, a: T._ast, sfv: T._var,
@@ -390,6 +413,94 @@ func TestChecker_functionCheck(t *testing.T) {
 			fp:  &gotype.Var{Name: "cc", Type: T.string},
 			fpi: T.iptr(0),
 		},
+	}, {
+		name: "Test_ERR_FUNCTION_ARGVALUE_16_Validator",
+		err: &Error{C: ERR_FUNCTION_ARGVALUE, a: T._ast, sfv: T._var,
+			sf: &gotype.StructField{
+				Pkg:  T.pkg,
+				Name: "F", IsExported: true,
+				Tag:  `is:"ic:foo"`,
+				Type: T.string,
+				Var:  T._var,
+			},
+			ty: T.string,
+			r: &Rule{
+				Name: "ic",
+				Args: []*Arg{
+					{Type: ARG_STRING, Value: "foo"},
+				},
+				Spec: GetSpec("ic"),
+			},
+			ra:  &Arg{Type: ARG_STRING, Value: "foo"},
+			fp:  &gotype.Var{Name: "cc", Type: T.string},
+			fpi: T.iptr(0),
+		},
+	}, {
+		name: "Test_ERR_FUNCTION_ARGVALUE_17_Validator",
+		err: &Error{C: ERR_FUNCTION_ARGVALUE, a: T._ast, sfv: T._var,
+			sf: &gotype.StructField{
+				Pkg:  T.pkg,
+				Name: "F", IsExported: true,
+				Tag:  `is:"passport:foo"`,
+				Type: T.string,
+				Var:  T._var,
+			},
+			ty: T.string,
+			r: &Rule{
+				Name: "passport",
+				Args: []*Arg{
+					{Type: ARG_STRING, Value: "foo"},
+				},
+				Spec: GetSpec("passport"),
+			},
+			ra:  &Arg{Type: ARG_STRING, Value: "foo"},
+			fp:  &gotype.Var{Name: "cc", Type: T.string},
+			fpi: T.iptr(0),
+		},
+	}, {
+		name: "Test_ERR_FUNCTION_ARGVALUE_18_Validator",
+		err: &Error{C: ERR_FUNCTION_ARGVALUE, a: T._ast, sfv: T._var,
+			sf: &gotype.StructField{
+				Pkg:  T.pkg,
+				Name: "F", IsExported: true,
+				Tag:  `is:"iso31662:foo"`,
+				Type: T.string,
+				Var:  T._var,
+			},
+			ty: T.string,
+			r: &Rule{
+				Name: "iso31662",
+				Args: []*Arg{
+					{Type: ARG_STRING, Value: "foo"},
+				},
+				Spec: GetSpec("iso31662"),
+			},
+			ra:  &Arg{Type: ARG_STRING, Value: "foo"},
+			fp:  &gotype.Var{Name: "cc", Type: T.string},
+			fpi: T.iptr(0),
+		},
+	}, {
+		name: "Test_ERR_FUNCTION_ARGVALUE_19_Validator",
+		err: &Error{C: ERR_FUNCTION_ARGVALUE, a: T._ast, sfv: T._var,
+			sf: &gotype.StructField{
+				Pkg:  T.pkg,
+				Name: "F", IsExported: true,
+				Tag:  `is:"date:foo"`,
+				Type: T.string,
+				Var:  T._var,
+			},
+			ty: T.string,
+			r: &Rule{
+				Name: "date",
+				Args: []*Arg{
+					{Type: ARG_STRING, Value: "foo"},
+				},
+				Spec: GetSpec("date"),
+			},
+			ra:  &Arg{Type: ARG_STRING, Value: "foo"},
+			fp:  &gotype.Var{Name: "layout", Type: T.string},
+			fpi: T.iptr(0),
+		},
 	}}
 
 	cfg := loadConfig("testdata/configs/test_custom_rules.yaml")
@@ -0,0 +1,135 @@
+package rules
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/frk/valid/cmd/internal/config"
+	"github.com/frk/valid/cmd/internal/global"
+	"github.com/frk/valid/cmd/internal/gotype"
+
+	"github.com/frk/compare"
+)
+
+func TestChecker_groups(t *testing.T) {
+	global.Groups = []string{"create", "update", "delete_all"}
+	defer global.Unset()
+
+	tests := []struct {
+		name   string
+		groups map[string]map[string][]string
+		err    error
+		show   bool
+	}{{
+		name: "Test_group_Validator",
+		groups: map[string]map[string][]string{
+			"": {
+				"F1": {"email"},
+				"F2": {"len:1:64"},
+				"F3": {"[email]"},
+				"F4": {"min:1"},
+			},
+			"create": {
+				"F1": {"required@create", "email"},
+				"F2": {"len:1:64"},
+				"F3": {"[email]"},
+				"F4": {"min:1"},
+			},
+			"update": {
+				"F1": {"email"},
+				"F2": {"required@update", "len:1:64"},
+				"F3": {"[email]required@update"},
+				"F4": {"min@update:2"},
+			},
+		},
+	}, {
+		name: "Test_ERR_GROUP_UNKNOWN_1_Validator",
+		err: &Error{C: ERR_GROUP_UNKNOWN, a: T._ast, sfv: T._var,
+			sf: &gotype.StructField{
+				Pkg:  T.pkg,
+				Name: "F", IsExported: true,
+				Tag:  `is:"required@delete"`,
+				Type: T.string,
+				Var:  T._var,
+			},
+			tag: &Tag{Rules: []*Rule{{Name: "required", Group: "delete"}}},
+			r:   &Rule{Name: "required", Group: "delete"},
+		},
+	}, {
+		name: "Test_ERR_GROUP_UNKNOWN_2_Validator",
+		err: &Error{C: ERR_GROUP_UNKNOWN, a: T._ast, sfv: T._var,
+			sf: &gotype.StructField{
+				Pkg:  T.pkg,
+				Name: "F", IsExported: true,
+				Tag:  `is:"email" is.delete:"required"`,
+				Type: T.string,
+				Var:  T._var,
+			},
+			tag: &Tag{Rules: []*Rule{{Name: "required", Group: "delete"}}},
+			r:   &Rule{Name: "required", Group: "delete"},
+		},
+	}}
+
+	compare := compare.Config{ObserveFieldTag: "cmp"}
+	fkCfg := &config.FieldKeyConfig{
+		Join:      config.Bool{Value: true, IsSet: true},
+		Separator: config.String{Value: ".", IsSet: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match := testMatch(t, tt.name)
+
+			info := new(Info)
+			checker := NewChecker(&test_ast, test_pkg.Pkg(), fkCfg, info)
+			err := checker.Check(match)
+
+			got := _ttError(err)
+			want := _ttError(tt.err)
+			if e := compare.Compare(got, want); e != nil {
+				t.Errorf("Error: %v", e)
+			}
+
+			if err == nil {
+				groups := map[string]map[string][]string{
+					"": groupRules(info.RootNode),
+				}
+				for _, g := range info.Groups {
+					groups[g.Name] = groupRules(g.RootNode)
+				}
+				if e := compare.Compare(groups, tt.groups); e != nil {
+					t.Errorf("Groups: %v", e)
+				}
+			}
+
+			if tt.show && err != nil {
+				fmt.Println(err)
+			}
+		})
+	}
+}
+
+// groupRules returns the string representation of the
+// is-rules of the given node's fields, mapped by field name.
+func groupRules(n *Node) map[string][]string {
+	out := make(map[string][]string)
+	for _, f := range n.Fields {
+		var rules []string
+		for _, r := range f.Type.IsRules {
+			rules = append(rules, r.String())
+		}
+		if k := f.Type.Key; k != nil && len(k.IsRules) > 0 {
+			s := "["
+			for _, r := range k.IsRules {
+				s += r.String()
+			}
+			s += "]"
+			for _, r := range f.Type.Elem.IsRules {
+				s += r.String()
+			}
+			rules = append(rules, s)
+		}
+		out[f.Field.Name] = rules
+	}
+	return out
+}
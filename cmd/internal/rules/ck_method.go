@@ -11,6 +11,9 @@ loop:
 			continue loop
 		}
 
+		if m.Type.HasContext != r.Spec.FType.HasContext {
+			continue loop
+		}
 		if len(m.Type.In) != len(r.Spec.FType.In) {
 			continue loop
 		}
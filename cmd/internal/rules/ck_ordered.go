@@ -15,6 +15,12 @@ func (c *Checker) orderedCheck(n *Node, r *Rule) error {
 
 	// rule args must be comparable with n.Type
 	for _, a := range r.Args {
+		// a time.Duration can be compared to a duration literal
+		if n.Type.IsDuration() && a.Type == ARG_STRING {
+			if _, ok := ParseDurationArg(a); ok {
+				continue
+			}
+		}
 		if !c.canConvertRuleArg(n.Type, a) {
 			return &Error{C: ERR_ORDERED_ARGTYPE, ty: n.Type, r: r, ra: a}
 		}
@@ -106,6 +106,24 @@ func TestChecker_orderedCheck(t *testing.T) {
 			ra:  &Arg{Type: ARG_FIELD_ABS, Value: "S.F"},
 			raf: T._sf(),
 		},
+	}, {
+		name: "Test_ERR_ORDERED_ARGTYPE_4_Validator",
+		err: &Error{C: ERR_ORDERED_ARGTYPE, a: T._ast, sfv: T._var,
+			sf: &gotype.StructField{
+				Pkg:  T.pkg,
+				Name: "F", IsExported: true,
+				Tag:  `is:"max:30x"`,
+				Type: T.duration,
+				Var:  T._var,
+			},
+			ty: T.duration,
+			r: &Rule{
+				Name: "max",
+				Args: []*Arg{{Type: ARG_STRING, Value: "30x"}},
+				Spec: GetSpec("max"),
+			},
+			ra: &Arg{Type: ARG_STRING, Value: "30x"},
+		},
 	}}
 
 	compare := compare.Config{ObserveFieldTag: "cmp"}
@@ -5,6 +5,14 @@ import (
 )
 
 func (c *Checker) preprocessorCheck(n *Node, r *Rule) error {
+	// If the function is a method of a dependency type make
+	// sure that the validator has access to the dependency.
+	if r.Spec.Dep != nil {
+		if err := c.dependencyCheck(n, r); err != nil {
+			return err
+		}
+	}
+
 	// Make sure that an instance of n can be passed
 	// to the function as its first argument.
 	paramType := r.Spec.FType.In[0].Type
@@ -27,5 +35,13 @@ func (c *Checker) preprocessorCheck(n *Node, r *Rule) error {
 	if err := c.checkRuleArgsAsFuncParams(r); err != nil {
 		return c.err(err, errOpts{C: ERR_PREPROC_ARGTYPE, ty: n.Type})
 	}
+
+	// Some included functions accept arguments of a known set of valid
+	// values, check that the rule argument's values belong to that set.
+	if r.Spec.FType.IsIncluded() {
+		if err := c.checkIncludedRuleArgValues(r); err != nil {
+			return c.err(err, errOpts{C: ERR_PREPROC_ARGVALUE, ty: n.Type})
+		}
+	}
 	return nil
 }
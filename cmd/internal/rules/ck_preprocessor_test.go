@@ -137,6 +137,26 @@ func TestChecker_preprocessorCheck(t *testing.T) {
 			fp:  &gotype.Var{Name: "opt", Type: T.uint},
 			fpi: T.iptr(0),
 		},
+	}, {
+		name: "Test_ERR_PREPROC_ARGVALUE_1_Validator",
+		err: &Error{C: ERR_PREPROC_ARGVALUE, a: T._ast, sfv: T._var,
+			sf: &gotype.StructField{
+				Pkg:  T.pkg,
+				Name: "F", IsExported: true,
+				Tag:  `pre:"e164:zz"`,
+				Type: T.string,
+				Var:  T._var,
+			},
+			ty: T.string,
+			r: &Rule{
+				Name: "e164",
+				Args: []*Arg{{Type: ARG_STRING, Value: "zz"}},
+				Spec: GetSpec("pre:e164"),
+			},
+			ra:  &Arg{Type: ARG_STRING, Value: "zz"},
+			fp:  &gotype.Var{Name: "cc", Type: T.string},
+			fpi: T.iptr(0),
+		},
 	}}
 
 	compare := compare.Config{ObserveFieldTag: "cmp"}
@@ -1,5 +1,9 @@
 package rules
 
+import (
+	"github.com/frk/valid/cmd/internal/gotype"
+)
+
 // requiredCheck checks that the REQUIRED rule can be applied to the node.
 func (c *Checker) requiredCheck(n *Node, r *Rule) error {
 	switch r.Name {
@@ -13,3 +17,55 @@ func (c *Checker) requiredCheck(n *Node, r *Rule) error {
 	}
 	return nil
 }
+
+// condRequiredCheck checks that the CONDREQUIRED rule can be applied to the node.
+func (c *Checker) condRequiredCheck(n *Node, r *Rule) error {
+	// the field's zero value must be checkable
+	if !canCheckZero(n.Type) {
+		return &Error{C: ERR_CONDREQUIRED_TYPE, ty: n.Type, r: r}
+	}
+
+	switch r.Name {
+	case "required_if", "required_unless":
+		// the first argument must reference the controlling
+		// field, the rest are the values to compare it against
+		a0 := r.Args[0]
+		if a0.Type != ARG_FIELD_ABS && a0.Type != ARG_FIELD_REL {
+			return &Error{C: ERR_CONDREQUIRED_NOFIELD, ty: n.Type, r: r, ra: a0}
+		}
+		ctl := c.KeyMap[a0.Value].Field
+		if t := ctl.Type; t.Kind != gotype.K_STRING && t.Kind != gotype.K_BOOL && !t.Kind.IsNumeric() {
+			return &Error{C: ERR_CONDREQUIRED_FIELDTYPE, ty: n.Type, r: r, ra: a0}
+		}
+		for _, a := range r.Args[1:] {
+			if !c.canConvertRuleArg(ctl.Type, a) {
+				return &Error{C: ERR_CONDREQUIRED_ARGTYPE, ty: n.Type, r: r, ra: a, ctl: ctl}
+			}
+		}
+	case "required_with", "required_without":
+		// all arguments must reference the controlling fields
+		for _, a := range r.Args {
+			if a.Type != ARG_FIELD_ABS && a.Type != ARG_FIELD_REL {
+				return &Error{C: ERR_CONDREQUIRED_NOFIELD, ty: n.Type, r: r, ra: a}
+			}
+			if f := c.KeyMap[a.Value].Type.Type; !canCheckZero(f) {
+				return &Error{C: ERR_CONDREQUIRED_FIELDTYPE, ty: n.Type, r: r, ra: a}
+			}
+		}
+	}
+	return nil
+}
+
+// canCheckZero reports whether or not a value of the type t
+// can be compared against the type's zero value.
+func canCheckZero(t *gotype.Type) bool {
+	switch {
+	case t.Kind == gotype.K_STRING, t.Kind == gotype.K_BOOL, t.Kind.IsNumeric():
+		return true
+	case t.Is(gotype.K_MAP, gotype.K_SLICE, gotype.K_INTERFACE, gotype.K_PTR):
+		return true
+	case t.Kind == gotype.K_STRUCT:
+		return len(t.Name) > 0 && t.IsComparable()
+	}
+	return false
+}
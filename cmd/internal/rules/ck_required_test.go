@@ -56,6 +56,131 @@ func TestChecker_requiredCheck(t *testing.T) {
 			ty: T.float64,
 			r:  &Rule{Name: "notnil", Spec: GetSpec("notnil")},
 		},
+	}, {
+		name: "Test_condrequired_Validator", err: nil,
+	}, {
+		name: "Test_ERR_CONDREQUIRED_TYPE_1_Validator",
+		err: &Error{C: ERR_CONDREQUIRED_TYPE, a: T._ast, sfv: T._var,
+			sf: &gotype.StructField{
+				Pkg:  T.pkg,
+				Name: "F", IsExported: true,
+				Tag:  `is:"required_with:&G"`,
+				Type: T.Array(2, T.int),
+				Var:  T._var,
+			},
+			ty: T.Array(2, T.int),
+			r: &Rule{
+				Name: "required_with",
+				Args: []*Arg{{Type: ARG_FIELD_ABS, Value: "G"}},
+				Spec: GetSpec("required_with"),
+			},
+		},
+	}, {
+		name: "Test_ERR_CONDREQUIRED_NOFIELD_1_Validator",
+		err: &Error{C: ERR_CONDREQUIRED_NOFIELD, a: T._ast, sfv: T._var,
+			sf: &gotype.StructField{
+				Pkg:  T.pkg,
+				Name: "F", IsExported: true,
+				Tag:  `is:"required_if:card:visa"`,
+				Type: T.string,
+				Var:  T._var,
+			},
+			ty: T.string,
+			r: &Rule{
+				Name: "required_if",
+				Args: []*Arg{
+					{Type: ARG_STRING, Value: "card"},
+					{Type: ARG_STRING, Value: "visa"},
+				},
+				Spec: GetSpec("required_if"),
+			},
+			ra: &Arg{Type: ARG_STRING, Value: "card"},
+		},
+	}, {
+		name: "Test_ERR_CONDREQUIRED_NOFIELD_2_Validator",
+		err: &Error{C: ERR_CONDREQUIRED_NOFIELD, a: T._ast, sfv: T._var,
+			sf: &gotype.StructField{
+				Pkg:  T.pkg,
+				Name: "F", IsExported: true,
+				Tag:  `is:"required_without:&G:foo"`,
+				Type: T.string,
+				Var:  T._var,
+			},
+			ty: T.string,
+			r: &Rule{
+				Name: "required_without",
+				Args: []*Arg{
+					{Type: ARG_FIELD_ABS, Value: "G"},
+					{Type: ARG_STRING, Value: "foo"},
+				},
+				Spec: GetSpec("required_without"),
+			},
+			ra: &Arg{Type: ARG_STRING, Value: "foo"},
+		},
+	}, {
+		name: "Test_ERR_CONDREQUIRED_FIELDTYPE_1_Validator",
+		err: &Error{C: ERR_CONDREQUIRED_FIELDTYPE, a: T._ast, sfv: T._var,
+			sf: &gotype.StructField{
+				Pkg:  T.pkg,
+				Name: "F", IsExported: true,
+				Tag:  `is:"required_if:&G:foo"`,
+				Type: T.string,
+				Var:  T._var,
+			},
+			ty: T.string,
+			r: &Rule{
+				Name: "required_if",
+				Args: []*Arg{
+					{Type: ARG_FIELD_ABS, Value: "G"},
+					{Type: ARG_STRING, Value: "foo"},
+				},
+				Spec: GetSpec("required_if"),
+			},
+			ra:  &Arg{Type: ARG_FIELD_ABS, Value: "G"},
+			raf: T._sf(),
+		},
+	}, {
+		name: "Test_ERR_CONDREQUIRED_FIELDTYPE_2_Validator",
+		err: &Error{C: ERR_CONDREQUIRED_FIELDTYPE, a: T._ast, sfv: T._var,
+			sf: &gotype.StructField{
+				Pkg:  T.pkg,
+				Name: "F", IsExported: true,
+				Tag:  `is:"required_with:&G"`,
+				Type: T.string,
+				Var:  T._var,
+			},
+			ty: T.string,
+			r: &Rule{
+				Name: "required_with",
+				Args: []*Arg{{Type: ARG_FIELD_ABS, Value: "G"}},
+				Spec: GetSpec("required_with"),
+			},
+			ra:  &Arg{Type: ARG_FIELD_ABS, Value: "G"},
+			raf: T._sf(),
+		},
+	}, {
+		name: "Test_ERR_CONDREQUIRED_ARGTYPE_1_Validator",
+		err: &Error{C: ERR_CONDREQUIRED_ARGTYPE, a: T._ast, sfv: T._var,
+			sf: &gotype.StructField{
+				Pkg:  T.pkg,
+				Name: "F", IsExported: true,
+				Tag:  `is:"required_unless:&G:1:foo"`,
+				Type: T.string,
+				Var:  T._var,
+			},
+			ty: T.string,
+			r: &Rule{
+				Name: "required_unless",
+				Args: []*Arg{
+					{Type: ARG_FIELD_ABS, Value: "G"},
+					{Type: ARG_INT, Value: "1"},
+					{Type: ARG_STRING, Value: "foo"},
+				},
+				Spec: GetSpec("required_unless"),
+			},
+			ra:  &Arg{Type: ARG_STRING, Value: "foo"},
+			ctl: T._sf(),
+		},
 	}}
 
 	compare := compare.Config{ObserveFieldTag: "cmp"}
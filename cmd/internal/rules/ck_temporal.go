@@ -0,0 +1,66 @@
+package rules
+
+import (
+	"time"
+)
+
+// temporalCheck checks that the Node's type is time.Time and that
+// the Rule's arguments are either time.Time fields or time literals,
+// or, in the case of the "within" rule, a positive duration literal.
+func (c *Checker) temporalCheck(n *Node, r *Rule) error {
+	if !n.Type.IsTime() {
+		return &Error{C: ERR_TEMPORAL_TYPE, ty: n.Type, r: r}
+	}
+
+	for _, a := range r.Args {
+		switch {
+		case r.Name == "within":
+			if d, ok := ParseDurationArg(a); !ok || d <= 0 {
+				return &Error{C: ERR_TEMPORAL_ARGTYPE, ty: n.Type, r: r, ra: a}
+			}
+		case a.Type == ARG_FIELD_ABS || a.Type == ARG_FIELD_REL:
+			if f := c.KeyMap[a.Value]; f == nil || !f.Type.Type.IsTime() {
+				return &Error{C: ERR_TEMPORAL_ARGTYPE, ty: n.Type, r: r, ra: a}
+			}
+		default:
+			if _, ok := ParseTimeArg(a); !ok {
+				return &Error{C: ERR_TEMPORAL_ARGTYPE, ty: n.Type, r: r, ra: a}
+			}
+		}
+	}
+	return nil
+}
+
+// The layouts, in order of preference, that
+// can be used to parse a time literal argument.
+var timeArgLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+}
+
+// ParseTimeArg parses the given argument as a time literal. A literal
+// without an explicit time zone offset is interpreted as UTC.
+func ParseTimeArg(a *Arg) (time.Time, bool) {
+	if a.Type != ARG_STRING {
+		return time.Time{}, false
+	}
+	for _, layout := range timeArgLayouts {
+		if t, err := time.Parse(layout, a.Value); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// ParseDurationArg parses the given argument as a duration literal, e.g. "1h30m".
+func ParseDurationArg(a *Arg) (time.Duration, bool) {
+	if a.Type != ARG_STRING {
+		return 0, false
+	}
+	d, err := time.ParseDuration(a.Value)
+	if err != nil {
+		return 0, false
+	}
+	return d, true
+}
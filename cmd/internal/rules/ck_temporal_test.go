@@ -0,0 +1,135 @@
+package rules
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/frk/valid/cmd/internal/config"
+	"github.com/frk/valid/cmd/internal/gotype"
+
+	"github.com/frk/compare"
+)
+
+func TestChecker_temporalCheck(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		show bool
+	}{{
+		name: "Test_temporal_Validator", err: nil,
+	}, {
+		name: "Test_ERR_TEMPORAL_TYPE_1_Validator",
+		err: &Error{C: ERR_TEMPORAL_TYPE, a: T._ast, sfv: T._var,
+			sf: &gotype.StructField{
+				Pkg:  T.pkg,
+				Name: "F", IsExported: true,
+				Tag:  `is:"before:2030-01-01"`,
+				Type: T.string,
+				Var:  T._var,
+			},
+			ty: T.string,
+			r: &Rule{
+				Name: "before",
+				Args: []*Arg{{Type: ARG_STRING, Value: "2030-01-01"}},
+				Spec: GetSpec("before"),
+			},
+		},
+	}, {
+		name: "Test_ERR_TEMPORAL_TYPE_2_Validator",
+		err: &Error{C: ERR_TEMPORAL_TYPE, a: T._ast, sfv: T._var,
+			sf: &gotype.StructField{
+				Pkg:  T.pkg,
+				Name: "F", IsExported: true,
+				Tag:  `is:"future"`,
+				Type: T.Ptr(T.int64),
+				Var:  T._var,
+			},
+			ty: T.int64,
+			r: &Rule{
+				Name: "future",
+				Spec: GetSpec("future"),
+			},
+		},
+	}, {
+		name: "Test_ERR_TEMPORAL_ARGTYPE_1_Validator",
+		err: &Error{C: ERR_TEMPORAL_ARGTYPE, a: T._ast, sfv: T._var,
+			sf: &gotype.StructField{
+				Pkg:  T.pkg,
+				Name: "F", IsExported: true,
+				Tag:  `is:"after:foo"`,
+				Type: T.time,
+				Var:  T._var,
+			},
+			ty: T.time,
+			r: &Rule{
+				Name: "after",
+				Args: []*Arg{{Type: ARG_STRING, Value: "foo"}},
+				Spec: GetSpec("after"),
+			},
+			ra: &Arg{Type: ARG_STRING, Value: "foo"},
+		},
+	}, {
+		name: "Test_ERR_TEMPORAL_ARGTYPE_2_Validator",
+		err: &Error{C: ERR_TEMPORAL_ARGTYPE, a: T._ast, sfv: T._var,
+			sf: &gotype.StructField{
+				Pkg:  T.pkg,
+				Name: "F", IsExported: true,
+				Tag:  `is:"before:&S"`,
+				Type: T.time,
+				Var:  T._var,
+			},
+			ty: T.time,
+			r: &Rule{
+				Name: "before",
+				Args: []*Arg{{Type: ARG_FIELD_ABS, Value: "S"}},
+				Spec: GetSpec("before"),
+			},
+			ra:  &Arg{Type: ARG_FIELD_ABS, Value: "S"},
+			raf: T._sf(),
+		},
+	}, {
+		name: "Test_ERR_TEMPORAL_ARGTYPE_3_Validator",
+		err: &Error{C: ERR_TEMPORAL_ARGTYPE, a: T._ast, sfv: T._var,
+			sf: &gotype.StructField{
+				Pkg:  T.pkg,
+				Name: "F", IsExported: true,
+				Tag:  `is:"within:-1h"`,
+				Type: T.time,
+				Var:  T._var,
+			},
+			ty: T.time,
+			r: &Rule{
+				Name: "within",
+				Args: []*Arg{{Type: ARG_STRING, Value: "-1h"}},
+				Spec: GetSpec("within"),
+			},
+			ra: &Arg{Type: ARG_STRING, Value: "-1h"},
+		},
+	}}
+
+	compare := compare.Config{ObserveFieldTag: "cmp"}
+	fkCfg := &config.FieldKeyConfig{
+		Join:      config.Bool{Value: true, IsSet: true},
+		Separator: config.String{Value: ".", IsSet: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match := testMatch(t, tt.name)
+
+			info := new(Info)
+			checker := NewChecker(&test_ast, test_pkg.Pkg(), fkCfg, info)
+			err := checker.Check(match)
+
+			got := _ttError(err)
+			want := _ttError(tt.err)
+			if e := compare.Compare(got, want); e != nil {
+				t.Errorf("Error: %v", e)
+			}
+
+			if tt.show && err != nil {
+				fmt.Println(err)
+			}
+		})
+	}
+}
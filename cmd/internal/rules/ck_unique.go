@@ -0,0 +1,70 @@
+package rules
+
+import (
+	"github.com/frk/valid/cmd/internal/gotype"
+)
+
+// uniqueCheck checks that the Node's type is a slice, an array, or a map
+// with a hashable element type. If the Rule has an argument it checks
+// that the element type is a struct with an accessible and hashable
+// field whose name matches the argument's value.
+func (c *Checker) uniqueCheck(n *Node, r *Rule) error {
+	if !n.Type.Is(gotype.K_SLICE, gotype.K_ARRAY, gotype.K_MAP) {
+		return &Error{C: ERR_UNIQUE_TYPE, ty: n.Type, r: r}
+	}
+	if len(r.Args) == 0 {
+		if !isHashable(n.Type.Elem) {
+			return &Error{C: ERR_UNIQUE_ELEM, ty: n.Type, r: r}
+		}
+		return nil
+	}
+
+	a := r.Args[0]
+	if a.Type != ARG_STRING {
+		return &Error{C: ERR_UNIQUE_FIELD, ty: n.Type, r: r, ra: a}
+	}
+	f := UniqueField(n.Type.Elem, a.Value)
+	if f == nil || !f.CanAccess(c.pkg) {
+		return &Error{C: ERR_UNIQUE_FIELD, ty: n.Type, r: r, ra: a}
+	}
+	if !isHashable(f.Type) {
+		return &Error{C: ERR_UNIQUE_ELEM, ty: n.Type, r: r, ra: a}
+	}
+	return nil
+}
+
+// UniqueField returns the field of the struct type t with the given
+// name, or nil if t is not a struct type or if it has no such field.
+func UniqueField(t *gotype.Type, name string) *gotype.StructField {
+	if t.Kind != gotype.K_STRUCT {
+		return nil
+	}
+	for _, f := range t.VisibleFields() {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// isHashable reports whether or not values of type t can be used as map
+// keys without causing a run-time panic, i.e. t is comparable and it is
+// not, and it does not contain, an interface type.
+func isHashable(t *gotype.Type) bool {
+	if !t.IsComparable() {
+		return false
+	}
+	switch t.Kind {
+	case gotype.K_INTERFACE:
+		return false
+	case gotype.K_ARRAY:
+		return isHashable(t.Elem)
+	case gotype.K_STRUCT:
+		for _, f := range t.Fields {
+			if !isHashable(f.Type) {
+				return false
+			}
+		}
+	}
+	return true
+}
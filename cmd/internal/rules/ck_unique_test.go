@@ -0,0 +1,187 @@
+package rules
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/frk/valid/cmd/internal/config"
+	"github.com/frk/valid/cmd/internal/gotype"
+
+	"github.com/frk/compare"
+)
+
+func TestChecker_uniqueCheck(t *testing.T) {
+	anyType := &gotype.Type{Kind: gotype.K_INTERFACE}
+	anyStruct := &gotype.Type{
+		Kind: gotype.K_STRUCT,
+		Fields: []*gotype.StructField{{
+			Pkg:  T.pkg,
+			Name: "V", IsExported: true,
+			Type: anyType,
+			Var:  T._var,
+		}},
+	}
+	idStruct := func(id *gotype.Type) *gotype.Type {
+		return &gotype.Type{
+			Kind: gotype.K_STRUCT,
+			Fields: []*gotype.StructField{{
+				Pkg:  T.pkg,
+				Name: "ID", IsExported: true,
+				Type: id,
+				Var:  T._var,
+			}},
+		}
+	}
+
+	tests := []struct {
+		name string
+		err  error
+		show bool
+	}{{
+		name: "Test_unique_Validator", err: nil,
+	}, {
+		name: "Test_ERR_UNIQUE_TYPE_1_Validator",
+		err: &Error{C: ERR_UNIQUE_TYPE, a: T._ast, sfv: T._var,
+			sf: &gotype.StructField{
+				Pkg:  T.pkg,
+				Name: "F", IsExported: true,
+				Tag:  `is:"unique"`,
+				Type: T.string,
+				Var:  T._var,
+			},
+			ty: T.string,
+			r: &Rule{
+				Name: "unique",
+				Spec: GetSpec("unique"),
+			},
+		},
+	}, {
+		name: "Test_ERR_UNIQUE_ELEM_1_Validator",
+		err: &Error{C: ERR_UNIQUE_ELEM, a: T._ast, sfv: T._var,
+			sf: &gotype.StructField{
+				Pkg:  T.pkg,
+				Name: "F", IsExported: true,
+				Tag:  `is:"unique"`,
+				Type: T.Slice(T.Map(T.string, T.int)),
+				Var:  T._var,
+			},
+			ty: T.Slice(T.Map(T.string, T.int)),
+			r: &Rule{
+				Name: "unique",
+				Spec: GetSpec("unique"),
+			},
+		},
+	}, {
+		name: "Test_ERR_UNIQUE_ELEM_2_Validator",
+		err: &Error{C: ERR_UNIQUE_ELEM, a: T._ast, sfv: T._var,
+			sf: &gotype.StructField{
+				Pkg:  T.pkg,
+				Name: "F", IsExported: true,
+				Tag:  `is:"unique"`,
+				Type: T.Slice(anyType),
+				Var:  T._var,
+			},
+			ty: T.Slice(anyType),
+			r: &Rule{
+				Name: "unique",
+				Spec: GetSpec("unique"),
+			},
+		},
+	}, {
+		name: "Test_ERR_UNIQUE_ELEM_3_Validator",
+		err: &Error{C: ERR_UNIQUE_ELEM, a: T._ast, sfv: T._var,
+			sf: &gotype.StructField{
+				Pkg:  T.pkg,
+				Name: "F", IsExported: true,
+				Tag:  `is:"unique:ID"`,
+				Type: T.Slice(idStruct(anyType)),
+				Var:  T._var,
+			},
+			ty: T.Slice(idStruct(anyType)),
+			r: &Rule{
+				Name: "unique",
+				Args: []*Arg{{Type: ARG_STRING, Value: "ID"}},
+				Spec: GetSpec("unique"),
+			},
+			ra: &Arg{Type: ARG_STRING, Value: "ID"},
+		},
+	}, {
+		name: "Test_ERR_UNIQUE_ELEM_4_Validator",
+		err: &Error{C: ERR_UNIQUE_ELEM, a: T._ast, sfv: T._var,
+			sf: &gotype.StructField{
+				Pkg:  T.pkg,
+				Name: "F", IsExported: true,
+				Tag:  `is:"unique:ID"`,
+				Type: T.Slice(idStruct(anyStruct)),
+				Var:  T._var,
+			},
+			ty: T.Slice(idStruct(anyStruct)),
+			r: &Rule{
+				Name: "unique",
+				Args: []*Arg{{Type: ARG_STRING, Value: "ID"}},
+				Spec: GetSpec("unique"),
+			},
+			ra: &Arg{Type: ARG_STRING, Value: "ID"},
+		},
+	}, {
+		name: "Test_ERR_UNIQUE_ELEM_5_Validator",
+		err: &Error{C: ERR_UNIQUE_ELEM, a: T._ast, sfv: T._var,
+			sf: &gotype.StructField{
+				Pkg:  T.pkg,
+				Name: "F", IsExported: true,
+				Tag:  `is:"unique"`,
+				Type: T.Slice(T.Array(2, anyStruct)),
+				Var:  T._var,
+			},
+			ty: T.Slice(T.Array(2, anyStruct)),
+			r: &Rule{
+				Name: "unique",
+				Spec: GetSpec("unique"),
+			},
+		},
+	}, {
+		name: "Test_ERR_UNIQUE_FIELD_1_Validator",
+		err: &Error{C: ERR_UNIQUE_FIELD, a: T._ast, sfv: T._var,
+			sf: &gotype.StructField{
+				Pkg:  T.pkg,
+				Name: "F", IsExported: true,
+				Tag:  `is:"unique:ID"`,
+				Type: T.Slice(T.string),
+				Var:  T._var,
+			},
+			ty: T.Slice(T.string),
+			r: &Rule{
+				Name: "unique",
+				Args: []*Arg{{Type: ARG_STRING, Value: "ID"}},
+				Spec: GetSpec("unique"),
+			},
+			ra: &Arg{Type: ARG_STRING, Value: "ID"},
+		},
+	}}
+
+	compare := compare.Config{ObserveFieldTag: "cmp"}
+	fkCfg := &config.FieldKeyConfig{
+		Join:      config.Bool{Value: true, IsSet: true},
+		Separator: config.String{Value: ".", IsSet: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match := testMatch(t, tt.name)
+
+			info := new(Info)
+			checker := NewChecker(&test_ast, test_pkg.Pkg(), fkCfg, info)
+			err := checker.Check(match)
+
+			got := _ttError(err)
+			want := _ttError(tt.err)
+			if e := compare.Compare(got, want); e != nil {
+				t.Errorf("Error: %v", e)
+			}
+
+			if tt.show && err != nil {
+				fmt.Println(err)
+			}
+		})
+	}
+}
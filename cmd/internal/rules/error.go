@@ -65,6 +65,9 @@ type Error struct {
 	// rule argument and that argument is a field
 	// reference, otherwise nil.
 	raf *gotype.StructField
+	// Set if the error is related to the controlling
+	// field of a CONDREQUIRED rule, otherwise nil.
+	ctl *gotype.StructField
 	// A function parameter. Set if the error is related
 	// to a specific rule function parameter, otherwise nil.
 	fp *gotype.Var
@@ -294,6 +297,14 @@ func (e *Error) RuleFuncNameWithType() string {
 	return e.r.Spec.FName + strings.TrimPrefix(t, "func")
 }
 
+func (e *Error) RuleGroup() string {
+	return e.r.Group
+}
+
+func (e *Error) RuleDepType() string {
+	return e.r.Spec.Dep.TypeString(nil)
+}
+
 func (e *Error) RuleFuncIn0Type() string {
 	return e.r.Spec.FType.In[0].Type.TypeString(nil)
 }
@@ -363,6 +374,14 @@ func (e *Error) RuleArgFieldType() string {
 	return e.raf.Type.TypeString(nil)
 }
 
+func (e *Error) CtlFieldName() string {
+	return e.ctl.Name
+}
+
+func (e *Error) CtlFieldType() string {
+	return e.ctl.Type.TypeString(nil)
+}
+
 type ErrorCode uint
 
 const (
@@ -392,8 +411,16 @@ const (
 
 	ERR_NOTNIL_TYPE // illegal rule "notnil" on non-nilable field
 
+	ERR_CONDREQUIRED_TYPE      // illegal CONDREQUIRED rule on field whose zero value cannot be checked
+	ERR_CONDREQUIRED_NOFIELD   // CONDREQUIRED rule argument does not reference a field
+	ERR_CONDREQUIRED_FIELDTYPE // field referenced by CONDREQUIRED rule has an incompatible type
+	ERR_CONDREQUIRED_ARGTYPE   // bad argument type in CONDREQUIRED rule
+
 	ERR_OPTIONAL_CONFLICT // an optional rule is in conflict with a required rule
 
+	ERR_DEFAULT_CONFLICT // the "default" tag is in conflict with a required rule
+	ERR_DEFAULT_TYPE     // the "default" tag's value is incompatible with the field's type
+
 	ERR_ENUM_NONAME  // illegal rule "enum" on field with unnamed type
 	ERR_ENUM_KIND    // illegal rule "enum" on field with type of non-basic kind
 	ERR_ENUM_NOCONST // "enum" rule on field with type that has no "known" constants declared
@@ -412,10 +439,21 @@ const (
 	ERR_ORDERED_TYPE    // illegal ORDERED rule on non-numeric/non-string field
 	ERR_ORDERED_ARGTYPE // bad argument type in ORDERED rule
 
-	ERR_PREPROC_INTYPE  // bad PREPROC rule function's input type, incompatible with node
-	ERR_PREPROC_OUTTYPE // bad PREPROC rule function's output type, incompatible with node
-	ERR_PREPROC_ARGTYPE // bad argument type in PREPROC rule
-	ERR_PREPROC_INVALID // invalid PREPROC rule
+	ERR_TEMPORAL_TYPE    // illegal TEMPORAL rule on non-time.Time field
+	ERR_TEMPORAL_ARGTYPE // bad argument type in TEMPORAL rule
+
+	ERR_NEGATION_KIND    // illegal negation of a rule of a non-composable kind
+	ERR_ALTERNATION_KIND // illegal rule of a non-composable kind in an alternation group
+
+	ERR_UNIQUE_TYPE  // illegal rule "unique" on non-slice, non-array and non-map field
+	ERR_UNIQUE_ELEM  // illegal rule "unique" on field with non-comparable element type
+	ERR_UNIQUE_FIELD // bad field argument in rule "unique"
+
+	ERR_PREPROC_INTYPE   // bad PREPROC rule function's input type, incompatible with node
+	ERR_PREPROC_OUTTYPE  // bad PREPROC rule function's output type, incompatible with node
+	ERR_PREPROC_ARGTYPE  // bad argument type in PREPROC rule
+	ERR_PREPROC_ARGVALUE // bad argument value in PREPROC rule
+	ERR_PREPROC_INVALID  // invalid PREPROC rule
 
 	ERR_FUNCTION_INTYPE   // bad FUNCTION rule function's input type, incompatible with node
 	ERR_FUNCTION_ARGTYPE  // bad argument type in FUNCTION rule
@@ -423,6 +461,11 @@ const (
 
 	ERR_METHOD_TYPE // illegal METHOD rule on type that does not have the specified method
 
+	ERR_DEPENDENCY_MISSING // rule function's dependency not found in validator
+	ERR_DEPENDENCY_DEPSPTR // rule function's dependency found in a pointer "Deps" field
+
+	ERR_GROUP_UNKNOWN // rule restricted to a group that was not declared in the config
+
 	// TODO rename
 	ERR_ARG_BADCMP // argument's type incompatible with field's type (for comparison)
 
@@ -615,6 +658,43 @@ var error_template = `
 	` types like pointers, slices, maps, etc.
 {{ end }}
 
+{{ define "` + ERR_CONDREQUIRED_TYPE.ident() + `" -}}
+{{ ERROR }} Illegal use of "{{wb .RuleName}}" rule in field {{wb .FieldName}}` +
+	` of type "{{R .FieldType}}".
+  > FILE: {{W .FieldPos}}
+  > FIELD: {{W .Field}}
+  > HINT: The "{{wb .RuleName}}" rule can ONLY be applied to fields whose {{wb "zero value"}}` +
+	`{{NT}}  can be checked, i.e. basic types, pointers, slices, maps, interfaces, and named comparable structs.
+{{ end }}
+
+{{ define "` + ERR_CONDREQUIRED_NOFIELD.ident() + `" -}}
+{{ ERROR }} Cannot use "{{R .RuleArgValue}}" as a field reference in argument to the "{{wb .RuleName}}" rule.
+  > FILE: {{W .FieldPos}}
+  > FIELD: {{W .Field}}
+  > HINT: The "{{wb "required_if"}}" and "{{wb "required_unless"}}" rules expect a {{wb "field reference"}}` +
+	`{{NT}}  as their first argument, e.g. "required_if:&Method:card", the "{{wb "required_with"}}" and` +
+	`{{NT}}  "{{wb "required_without"}}" rules expect ONLY {{wb "field references"}}, e.g. "required_with:&A:&B".
+{{ end }}
+
+{{ define "` + ERR_CONDREQUIRED_FIELDTYPE.ident() + `" -}}
+{{ ERROR }} Cannot use field "{{R .RuleArgValue}}" (type {{R .RuleArgType}}) as the controlling field` +
+	` of the "{{wb .RuleName}}" rule.
+  > FILE: {{W .FieldPos}}
+  > FIELD: {{W .Field}}
+  > HINT: The field referenced by the "{{wb "required_if"}}" and "{{wb "required_unless"}}" rules MUST be` +
+	`{{NT}}  of a {{wb "string"}}, {{wb "boolean"}}, or {{wb "numeric"}} type, the fields referenced by the` +
+	`{{NT}}  "{{wb "required_with"}}" and "{{wb "required_without"}}" rules MUST have a {{wb "checkable"}} zero value.
+{{ end }}
+
+{{ define "` + ERR_CONDREQUIRED_ARGTYPE.ident() + `" -}}
+{{ ERROR }} Cannot use "{{R .RuleArgValue}}" (type {{R .RuleArgType}}) as {{wb .CtlFieldType}}` +
+	` value in argument to the "{{wb .RuleName}}" rule.
+  > FILE: {{W .FieldPos}}
+  > FIELD: {{W .Field}}
+  > HINT: The values in the "{{wb .RuleName}}" rule's arguments MUST be {{wb "comparable"}} to` +
+	` the field {{wb .CtlFieldName}} (type "{{wb .CtlFieldType}}").
+{{ end }}
+
 {{ define "` + ERR_OPTIONAL_CONFLICT.ident() + `" -}}
 {{ ERROR }} Conflicting use of "{{wb .RuleName}}" rule together with the "{{wb .Rule2Name}}"` +
 	` rule in field {{wb .FieldName}} (type {{wb .FieldType}}).
@@ -622,6 +702,24 @@ var error_template = `
   > FIELD: {{W .Field}}
 {{ end }}
 
+{{ define "` + ERR_DEFAULT_CONFLICT.ident() + `" -}}
+{{ ERROR }} Conflicting use of the "{{wb "default"}}" tag together with the "{{wb .RuleName}}"` +
+	` rule in field {{wb .FieldName}} (type {{wb .FieldType}}).
+  > FILE: {{W .FieldPos}}
+  > FIELD: {{W .Field}}
+  > HINT: A field with a {{wb "default"}} value is never zero or nil, remove either the` +
+	`{{NT}}  "{{wb "default"}}" tag or the "{{wb .RuleName}}" rule.
+{{ end }}
+
+{{ define "` + ERR_DEFAULT_TYPE.ident() + `" -}}
+{{ ERROR }} Cannot use "{{R .RuleArgValue}}" (type {{R .RuleArgType}}) as {{wb .Type}}` +
+	` value in the "{{wb "default"}}" tag of field {{wb .FieldName}}.
+  > FILE: {{W .FieldPos}}
+  > FIELD: {{W .Field}}
+  > HINT: The value of the "{{wb "default"}}" tag MUST be {{wb "assignable"}} to the field's (base) type` +
+	`{{NT}}  and the type MUST be of a {{wb "basic"}} kind, e.g. string, int, bool, etc.
+{{ end }}
+
 {{ define "` + ERR_ENUM_NONAME.ident() + `" -}}
 {{ ERROR }} Illegal use of "{{wb "enum"}}" rule in field {{wb .FieldName}}` +
 	` of an {{R "unnamed"}} type "{{wb .FieldType}}".
@@ -741,6 +839,66 @@ var error_template = `
 	` the field {{wb .FieldName}} (type "{{wb .FieldType}}").
 {{ end }}
 
+{{ define "` + ERR_TEMPORAL_TYPE.ident() + `" -}}
+{{ ERROR }} Illegal use of the "{{wb .RuleName}}" rule in field {{wb .FieldName}}` +
+	` of a {{R "non-time.Time"}} type "{{wb .FieldType}}".
+  > FILE: {{W .FieldPos}}
+  > FIELD: {{W .Field}}
+  > HINT: The "{{wb .RuleName}}" rule can ONLY be applied to fields of type {{wb "time.Time"}}.
+{{ end }}
+
+{{ define "` + ERR_TEMPORAL_ARGTYPE.ident() + `" -}}
+{{ ERROR }} Cannot use "{{R .RuleArgValue}}" (type {{R .RuleArgType}}) as argument to the "{{wb .RuleName}}" rule.
+  > FILE: {{W .FieldPos}}
+  > FIELD: {{W .Field}}
+  > HINT: The "{{wb "within"}}" rule's argument MUST be a {{wb "positive duration"}}, e.g. "24h" or "1h30m";` +
+	`{{NT}}  the arguments of the other time rules MUST reference a {{wb "time.Time"}} field, e.g. "&StartsAt",` +
+	`{{NT}}  or be a {{wb "date"}} or a quoted {{wb "RFC3339 timestamp"}}, e.g. 2030-01-01.
+{{ end }}
+
+{{ define "` + ERR_NEGATION_KIND.ident() + `" -}}
+{{ ERROR }} Illegal negation of the "{{wb .RuleName}}" rule in field {{wb .FieldName}} (type "{{wb .FieldType}}").
+  > FILE: {{W .FieldPos}}
+  > FIELD: {{W .Field}}
+  > HINT: Only rules that compare the field's value, e.g. "{{wb "eq"}}", "{{wb "len"}}", or "{{wb "contains"}}",` +
+	`{{NT}}  and rules whose function does not return an error can be negated.
+{{ end }}
+
+{{ define "` + ERR_ALTERNATION_KIND.ident() + `" -}}
+{{ ERROR }} Illegal use of the "{{wb .RuleName}}" rule in an alternation group` +
+	` in field {{wb .FieldName}} (type "{{wb .FieldType}}").
+  > FILE: {{W .FieldPos}}
+  > FIELD: {{W .Field}}
+  > HINT: Only rules that compare the field's value, e.g. "{{wb "eq"}}", "{{wb "len"}}", or "{{wb "contains"}}",` +
+	`{{NT}}  and rules whose function does not return an error can be used in an alternation group.
+{{ end }}
+
+{{ define "` + ERR_UNIQUE_TYPE.ident() + `" -}}
+{{ ERROR }} Illegal use of the "{{wb .RuleName}}" rule in field {{wb .FieldName}}` +
+	` of a {{R "non-slice"}}, {{R "non-array"}} and {{R "non-map"}} type "{{wb .FieldType}}".
+  > FILE: {{W .FieldPos}}
+  > FIELD: {{W .Field}}
+  > HINT: The "{{wb .RuleName}}" rule can ONLY be applied to fields of {{wb "slice"}}, {{wb "array"}} or {{wb "map"}} types.
+{{ end }}
+
+{{ define "` + ERR_UNIQUE_ELEM.ident() + `" -}}
+{{ ERROR }} Illegal use of the "{{wb .RuleName}}" rule in field {{wb .FieldName}}` +
+	` of type "{{wb .FieldType}}" with a {{R "non-hashable"}} element type.
+  > FILE: {{W .FieldPos}}
+  > FIELD: {{W .Field}}
+  > HINT: The "{{wb .RuleName}}" rule can ONLY be applied to fields whose element type is {{wb "comparable"}}` +
+	`{{NT}}  and is not, nor does it contain, an {{wb "interface"}} type.
+{{ end }}
+
+{{ define "` + ERR_UNIQUE_FIELD.ident() + `" -}}
+{{ ERROR }} Cannot use "{{R .RuleArgValue}}" as argument to the "{{wb .RuleName}}" rule` +
+	` in field {{wb .FieldName}} (type "{{wb .FieldType}}").
+  > FILE: {{W .FieldPos}}
+  > FIELD: {{W .Field}}
+  > HINT: The "{{wb .RuleName}}" rule's argument MUST be the name of an {{wb "exported"}}, {{wb "comparable"}}` +
+	`{{NT}}  field of the element type, and the element type MUST be a {{wb "struct"}}, e.g. "unique:ID".
+{{ end }}
+
 {{ define "` + ERR_PREPROC_INTYPE.ident() + `" -}}
 {{ ERROR }} Illegal use of the "{{wb .RuleName}}" rule with function {{wb .RuleFuncIdent}}` +
 	` (type {{wb .RuleFuncType}}) in field {{wb .FieldName}} (type "{{wb .FieldType}}").
@@ -768,6 +926,14 @@ var error_template = `
 	`{{NT}}  the {{wb .RuleFuncIdent}} function's {{wb .FuncParamIdent}} parameter (type {{wb .RuleFuncParamType}}).
 {{ end }}
 
+{{ define "` + ERR_PREPROC_ARGVALUE.ident() + `" -}}
+{{ ERROR }} Cannot use value "{{R .RuleArgValue}}" as the {{wb .FuncParamIdent}} argument to the "{{wb .RuleName}}" rule.
+  > FILE: {{W .FieldPos}}
+  > FIELD: {{W .Field}}
+  > HINT: For a set of valid argument values, see the "{{wb .RuleName}}" rule's spec as defined` +
+	`{{NT}}  in the config file or in the {{wb .RuleFuncIdent}} function's documentation.
+{{ end }}
+
 {{ define "` + ERR_PREPROC_INVALID.ident() + `" -}}
 {{ ERROR }} Cannot use "{{wb .RuleName}}" (kind {{R .RuleSpecKind}}) as a preprocessor.` +
 	` Only preprocessor rules can be used in {{wb "pre:"}}"..." tags.
@@ -812,6 +978,31 @@ var error_template = `
   > FIELD: {{W .Field}}
 {{ end }}
 
+{{ define "` + ERR_DEPENDENCY_MISSING.ident() + `" -}}
+{{ ERROR }} Missing dependency for the "{{wb .RuleName}}" rule in field {{wb .FieldName}}.` +
+	` The rule's function {{wb .RuleFuncName}} is a method of type {{wb .RuleDepType}}.
+  > FILE: {{W .FieldPos}}
+  > FIELD: {{W .Field}}
+  > HINT: To use the "{{wb .RuleName}}" rule the validator struct MUST have a field of type {{wb .RuleDepType}},` +
+	`{{NT}}  or a "{{wb "Deps"}}" struct field that has a field of type {{wb .RuleDepType}}.
+{{ end }}
+
+{{ define "` + ERR_DEPENDENCY_DEPSPTR.ident() + `" -}}
+{{ ERROR }} Illegal "{{wb "Deps"}}" field type for the "{{wb .RuleName}}" rule in field {{wb .FieldName}}.` +
+	` The rule's function {{wb .RuleFuncName}} is a method of type {{wb .RuleDepType}}` +
+	` which is held by a "{{wb "Deps"}}" field of pointer type.
+  > FILE: {{W .FieldPos}}
+  > FIELD: {{W .Field}}
+  > HINT: The "{{wb "Deps"}}" field MUST be of a struct type, not a pointer to a struct type.
+{{ end }}
+
+{{ define "` + ERR_GROUP_UNKNOWN.ident() + `" -}}
+{{ ERROR }} Unknown validation group "{{R .RuleGroup}}" of rule "{{R .RuleName}}" in "{{R .TagSTKey}}" struct tag.
+  > FILE: {{W .FieldPos}}
+  > FIELD: {{W .Field}}
+  > HINT: A validation group MUST be declared in the "{{wb "groups"}}" list of the config file.
+{{ end }}
+
 {{ define "` + ERR_ARG_BADCMP.ident() + `" -}}
 {{ ERROR }} Invalid rule "{{wb .Rule}}", argument {{wb .RuleArgValue}} of type "{{R .RuleArgType}}"` +
 	`{{if .RuleArgIsField}} and type "{{R .RuleArgFieldType}}"{{end}}` +
@@ -31,6 +31,7 @@ type ttError struct {
 	r2   *Rule               //`cmp:"+"`
 	ra   *Arg                //`cmp:"+"`
 	raf  *gotype.StructField `cmp:"+"`
+	ctl  *gotype.StructField `cmp:"+"`
 	fp   *gotype.Var         //`cmp:"+"`
 	fpi  *int                //`cmp:"+"`
 	err  error               `cmp:"+"`
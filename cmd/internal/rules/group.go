@@ -0,0 +1,168 @@
+package rules
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/frk/tagutil"
+	"github.com/frk/valid/cmd/internal/global"
+	"github.com/frk/valid/cmd/internal/gotype"
+)
+
+// groupTag parses the field's "is" struct tag together with any group
+// specific "is.<group>" struct tags and returns a Tag that contains only
+// the rules that apply to the validation group currently being checked.
+//
+// Rules restricted to a group that was not declared in the config
+// will result in an error.
+func (c *Checker) groupTag(f *gotype.StructField) (*Tag, error) {
+	is := parseTag(f.Tag, "is")
+	if err := c.addGroups(is, f); err != nil {
+		return nil, err
+	}
+
+	// The keys are sorted to make the merge order deterministic.
+	var keys []string
+	for key := range tagutil.New(f.Tag) {
+		if strings.HasPrefix(key, "is.") {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		gt := parseTag(f.Tag, key)
+		gt.setGroup(key[len("is."):])
+		if err := c.addGroups(gt, f); err != nil {
+			return nil, err
+		}
+		is = mergeTags(is, gt)
+	}
+	return is.forGroup(c.group), nil
+}
+
+// addGroups adds the groups referenced by the rules of the given
+// Tag to the set of the Validator's groups. An error is returned
+// if any of the referenced groups was not declared in the config.
+func (c *Checker) addGroups(t *Tag, f *gotype.StructField) error {
+	if t == nil {
+		return nil
+	}
+	for _, r := range t.Rules {
+		if len(r.Group) == 0 {
+			continue
+		}
+		if !isDeclaredGroup(r.Group) {
+			return &Error{C: ERR_GROUP_UNKNOWN, sf: f, tag: t, r: r}
+		}
+		c.groups[r.Group] = true
+	}
+	if err := c.addGroups(t.Key, f); err != nil {
+		return err
+	}
+	return c.addGroups(t.Elem, f)
+}
+
+// checkGroups converts the Validator into a Node tree, and then rule-checks
+// that tree, for each of the validation groups referenced by the Validator.
+func (c *Checker) checkGroups() error {
+	keyMap := c.Info.KeyMap
+	defer func() {
+		c.group = ""
+		c.Info.KeyMap = keyMap
+	}()
+
+	// Use the config's order to make the output deterministic.
+	for _, name := range global.Groups {
+		if !c.groups[name] {
+			continue
+		}
+
+		// The field keys must be the same as those of the
+		// default Node tree, therefore reset the key state.
+		c.group = name
+		c.fieldKey = fkFunc(c.fkCfg)
+		c.Info.KeyMap = make(map[string]*FieldNode)
+
+		rootNode, err := c.makeNode(c.vs.Type, nil, nil, nil)
+		if err != nil {
+			return c.err(err, errOpts{a: c.ast})
+		}
+		if err := c.check(rootNode); err != nil {
+			return c.err(err, errOpts{a: c.ast})
+		}
+		c.Info.Groups = append(c.Info.Groups, &Group{Name: name, RootNode: rootNode})
+	}
+	return nil
+}
+
+// isDeclaredGroup reports whether or not a group
+// with the given name was declared in the config.
+func isDeclaredGroup(name string) bool {
+	for _, g := range global.Groups {
+		if g == name {
+			return true
+		}
+	}
+	return false
+}
+
+// setGroup restricts all of the Tag's rules to the given group.
+func (t *Tag) setGroup(name string) {
+	if t != nil {
+		for _, r := range t.Rules {
+			r.Group = name
+		}
+		t.Key.setGroup(name)
+		t.Elem.setGroup(name)
+	}
+}
+
+// forGroup returns a copy of the Tag that contains only those rules that
+// apply to the named group. A rule restricted to the group takes precedence
+// over an unrestricted rule of the same name. If name is empty, only the
+// unrestricted rules are retained.
+func (t *Tag) forGroup(name string) *Tag {
+	if t == nil {
+		return nil
+	}
+
+	out := &Tag{stkey: t.stkey}
+	for _, r := range t.Rules {
+		if r.Group == name {
+			out.AddRule(r)
+		} else if r.Group == "" && !t.hasGroupRule(r.Name, name) {
+			out.AddRule(r)
+		}
+	}
+	out.Key = t.Key.forGroup(name)
+	out.Elem = t.Elem.forGroup(name)
+	return out
+}
+
+// hasGroupRule reports whether or not the Tag contains
+// a rule with the given name restricted to the given group.
+func (t *Tag) hasGroupRule(rule, group string) bool {
+	for _, r := range t.Rules {
+		if r.Name == rule && r.Group == group && group != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeTags returns a Tag that contains the rules of both a and b.
+func mergeTags(a, b *Tag) *Tag {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	for _, r := range b.Rules {
+		a.AddRule(r)
+	}
+	a.Key = mergeTags(a.Key, b.Key)
+	a.Elem = mergeTags(a.Elem, b.Elem)
+	return a
+}
@@ -14,6 +14,11 @@ type Node struct {
 	PreRules RuleList
 	// List of validation rules associated with the node.
 	IsRules RuleList
+	// List of conditional REQUIRED rules associated with the node.
+	CondRules RuleList
+	// The default value that should be assigned to the node
+	// if its value is zero or nil, otherwise nil.
+	Default *Arg
 	// If the Node represents a map, then Key
 	// will hold information about the map's key.
 	Key *Node
@@ -52,7 +57,7 @@ func (c *Checker) makeNode(t *gotype.Type, is, pre *Tag, fs gotype.FieldSelector
 			return nil, c.err(err, errOpts{sf: fs.Last()})
 		}
 	} else {
-		isList, preList, err := c.makeRuleLists(is, pre, fs)
+		isList, preList, condList, err := c.makeRuleLists(is, pre, fs)
 		if err != nil {
 			return nil, c.err(err, errOpts{sf: fs.Last(), ty: t})
 		}
@@ -60,6 +65,7 @@ func (c *Checker) makeNode(t *gotype.Type, is, pre *Tag, fs gotype.FieldSelector
 		root = &Node{Type: t}
 		root.PreRules = preList
 		root.IsRules = isList
+		root.CondRules = condList
 		base = root
 	}
 
@@ -142,6 +148,9 @@ func (c *Checker) makeNodeFromPtr(t *gotype.Type, is, pre *Tag, fs gotype.FieldS
 		omitnil  *Rule // should apply to pointers only
 		noguard  *Rule // should apply to pointers only
 
+		// conditional REQUIRED rules apply to the root pointer
+		cond []*Rule
+
 		// rest of the rules should apply to base only
 		rr []*Rule
 	)
@@ -151,8 +160,8 @@ func (c *Checker) makeNodeFromPtr(t *gotype.Type, is, pre *Tag, fs gotype.FieldS
 			continue
 		}
 
-		if r.Spec = GetSpec(r.Name); r.Spec == nil {
-			return nil, nil, &Error{C: ERR_RULE_UNDEFINED, ty: t, tag: is, r: r}
+		if u := loadSpecs(r); u != nil {
+			return nil, nil, &Error{C: ERR_RULE_UNDEFINED, ty: t, tag: is, r: u}
 		}
 
 		switch {
@@ -166,11 +175,13 @@ func (c *Checker) makeNodeFromPtr(t *gotype.Type, is, pre *Tag, fs gotype.FieldS
 			omitnil = r
 		case r.Spec.Kind == NOGUARD:
 			noguard = r
+		case r.Spec.Kind == CONDREQUIRED:
+			cond = append(cond, r)
 		default:
 			rr = append(rr, r)
 		}
 
-		for _, a := range r.Args {
+		for _, a := range r.ArgList() {
 			if a.Type == ARG_FIELD_REL {
 				c.normalizeRelFieldValue(a, fs)
 			}
@@ -187,6 +198,8 @@ func (c *Checker) makeNodeFromPtr(t *gotype.Type, is, pre *Tag, fs gotype.FieldS
 		omitnil = r
 	}
 
+	root.CondRules = cond
+
 	// apply the pointer specific rules to
 	// every pointer in the pointer-chain
 	for t.Kind == gotype.K_PTR {
@@ -246,7 +259,7 @@ func (c *Checker) makeNodeFromPtr(t *gotype.Type, is, pre *Tag, fs gotype.FieldS
 }
 
 // makeRuleLists creates RuleLists from the given Tags.
-func (c *Checker) makeRuleLists(is, pre *Tag, fs gotype.FieldSelector) (isList, preList RuleList, err error) {
+func (c *Checker) makeRuleLists(is, pre *Tag, fs gotype.FieldSelector) (isList, preList, condList RuleList, err error) {
 	// Load the specs for validation the rules
 	// and sort the rules according to "priority".
 	var (
@@ -258,17 +271,19 @@ func (c *Checker) makeRuleLists(is, pre *Tag, fs gotype.FieldSelector) (isList,
 			continue
 		}
 
-		if r.Spec = GetSpec(r.Name); r.Spec == nil {
-			return nil, nil, &Error{C: ERR_RULE_UNDEFINED, tag: is, r: r}
+		if u := loadSpecs(r); u != nil {
+			return nil, nil, nil, &Error{C: ERR_RULE_UNDEFINED, tag: is, r: u}
 		}
 		switch r.Spec.Kind {
 		case REQUIRED, OPTIONAL, NOGUARD:
 			r0 = append(r0, r)
+		case CONDREQUIRED:
+			condList = append(condList, r)
 		default:
 			rr = append(rr, r)
 		}
 
-		for _, a := range r.Args {
+		for _, a := range r.ArgList() {
 			if a.Type == ARG_FIELD_REL {
 				c.normalizeRelFieldValue(a, fs)
 			}
@@ -279,7 +294,7 @@ func (c *Checker) makeRuleLists(is, pre *Tag, fs gotype.FieldSelector) (isList,
 	// Load the specs for preprocessor rules.
 	for _, r := range pre.GetRules() {
 		if r.Spec = GetSpec("pre:" + r.Name); r.Spec == nil {
-			return nil, nil, &Error{C: ERR_RULE_UNDEFINED, tag: pre, r: r}
+			return nil, nil, nil, &Error{C: ERR_RULE_UNDEFINED, tag: pre, r: r}
 		}
 		preList = append(preList, r)
 
@@ -290,7 +305,7 @@ func (c *Checker) makeRuleLists(is, pre *Tag, fs gotype.FieldSelector) (isList,
 		}
 	}
 
-	return isList, preList, nil
+	return isList, preList, condList, nil
 }
 
 // makeFieldNode creates a FieldNode for the given struct field.
@@ -299,10 +314,19 @@ func (c *Checker) makeFieldNode(f *gotype.StructField, fs gotype.FieldSelector)
 	n.Selector = fs.CopyWith(f)
 	n.Key = c.fieldKey(n.Selector, false)
 
-	is, pre := parseTag(f.Tag, "is"), parseTag(f.Tag, "pre")
+	is, err := c.groupTag(f)
+	if err != nil {
+		return nil, err
+	}
+	pre := parseTag(f.Tag, "pre")
 	if n.Type, err = c.makeNode(f.Type, is, pre, n.Selector); err != nil {
 		return nil, err
 	}
+	if n.Type.Default = parseDefault(f.Tag); n.Type.Default != nil {
+		if n.Type.Default.Type == ARG_FIELD_REL {
+			c.normalizeRelFieldValue(n.Type.Default, n.Selector)
+		}
+	}
 
 	c.Info.KeyMap[n.Key] = n
 	return n, nil
@@ -375,7 +399,7 @@ func (n *Node) HasRules() bool {
 		return false
 	}
 
-	if len(n.PreRules) > 0 {
+	if len(n.PreRules) > 0 || len(n.CondRules) > 0 || n.Default != nil {
 		return true
 	}
 	for _, r := range n.IsRules {
@@ -1,6 +1,8 @@
 package rules
 
 import (
+	"sort"
+
 	"github.com/frk/valid/cmd/internal/gotype"
 )
 
@@ -17,6 +19,31 @@ func GetSpec(name string) *Spec {
 	return nil
 }
 
+// IncludedSpecs returns the specs of the rules that are implemented by
+// the github.com/frk/valid package, sorted by name. The returned list
+// will be empty if the specs were not yet loaded by InitSpecs.
+func IncludedSpecs() (specs []*Spec) {
+	for _, spec := range _included {
+		specs = append(specs, spec)
+	}
+	sort.Slice(specs, func(i, j int) bool {
+		return specs[i].Name < specs[j].Name
+	})
+	return specs
+}
+
+// BuiltinSpecs returns the specs of the builtin rules and of the rules that
+// are implemented with the standard library. The specs are keyed by the name
+// under which they can be looked up with GetSpec, i.e. the keys of the specs
+// of preprocessor rules have the "pre:" prefix.
+func BuiltinSpecs() map[string]*Spec {
+	specs := make(map[string]*Spec, len(_builtin_and_stdlib))
+	for key, spec := range _builtin_and_stdlib {
+		specs[key] = spec
+	}
+	return specs
+}
+
 // A set of rule specs populated by initCustomSpecs.
 var _custom = map[string]*Spec{}
 
@@ -57,6 +84,44 @@ var _builtin_specs = []*Spec{{
 	ArgMin: 0,
 	ArgMax: 0,
 	Err:    ErrSpec{Text: "cannot be nil"},
+}, {
+	Name:   "required_if",
+	Kind:   CONDREQUIRED,
+	ArgMin: 2,
+	ArgMax: -1,
+	Err: ErrSpec{
+		Text:   "is required if",
+		ArgSep: " or ",
+	},
+}, {
+	Name:   "required_unless",
+	Kind:   CONDREQUIRED,
+	ArgMin: 2,
+	ArgMax: -1,
+	Err: ErrSpec{
+		Text:   "is required unless",
+		ArgSep: " or ",
+	},
+}, {
+	Name:   "required_with",
+	Kind:   CONDREQUIRED,
+	ArgMin: 1,
+	ArgMax: -1,
+	Err: ErrSpec{
+		Text:      "is required if",
+		ArgSep:    " or ",
+		ArgSuffix: "is present",
+	},
+}, {
+	Name:   "required_without",
+	Kind:   CONDREQUIRED,
+	ArgMin: 1,
+	ArgMax: -1,
+	Err: ErrSpec{
+		Text:      "is required if",
+		ArgSep:    " or ",
+		ArgSuffix: "is not present",
+	},
 }, {
 	Name:   "optional",
 	Kind:   OPTIONAL,
@@ -160,6 +225,57 @@ var _builtin_specs = []*Spec{{
 		Text: "must be between", WithArgs: true,
 		ArgSep: " and ",
 	},
+}, {
+	Name:   "before",
+	Kind:   TEMPORAL,
+	ArgMin: 1,
+	ArgMax: 1,
+	Err: ErrSpec{
+		Text:     "must be before",
+		WithArgs: true,
+	},
+}, {
+	Name:   "after",
+	Kind:   TEMPORAL,
+	ArgMin: 1,
+	ArgMax: 1,
+	Err: ErrSpec{
+		Text:     "must be after",
+		WithArgs: true,
+	},
+}, {
+	Name:   "future",
+	Kind:   TEMPORAL,
+	ArgMin: 0,
+	ArgMax: 0,
+	Err:    ErrSpec{Text: "must be in the future"},
+}, {
+	Name:   "past",
+	Kind:   TEMPORAL,
+	ArgMin: 0,
+	ArgMax: 0,
+	Err:    ErrSpec{Text: "must be in the past"},
+}, {
+	Name:   "within",
+	Kind:   TEMPORAL,
+	ArgMin: 1,
+	ArgMax: 1,
+	Err: ErrSpec{
+		Text:      "must be within",
+		WithArgs:  true,
+		ArgSuffix: "of the current time",
+	},
+}, {
+	Name:   "unique",
+	Kind:   UNIQUE,
+	ArgMin: 0,
+	ArgMax: 1,
+	// NOTE the index, or the key, of the first duplicate
+	// element is appended to the message by the generator.
+	Err: ErrSpec{Text: "must contain unique elements"},
+	ErrOpts: map[string]ErrSpec{
+		"x": {Text: "must contain elements unique by field", WithArgs: true},
+	},
 }, {
 	Name:   "enum",
 	Kind:   ENUM,
@@ -190,6 +306,36 @@ var _builtin_specs = []*Spec{{
 	},
 }}
 
+// The spec of alternation groups, i.e. rules of the form "(rule|rule)".
+// The spec is assigned to the group by the Checker, it is not registered
+// under any name and can therefore not be looked up.
+var _alternation_spec = &Spec{
+	Name:   "()",
+	Kind:   ALTERNATION,
+	ArgMin: 0,
+	ArgMax: 0,
+}
+
+// loadSpecs looks up and assigns the spec of the given rule or, if the rule
+// is an alternation group, the specs of the group's rules. If a spec cannot
+// be found, then the rule whose spec is missing will be returned.
+func loadSpecs(r *Rule) (undefined *Rule) {
+	if len(r.Alt) == 0 {
+		if r.Spec = GetSpec(r.Name); r.Spec == nil {
+			return r
+		}
+		return nil
+	}
+
+	for _, a := range r.Alt {
+		if u := loadSpecs(a); u != nil {
+			return u
+		}
+	}
+	r.Spec = _alternation_spec
+	return nil
+}
+
 // A list of specs for "special" rules.
 var _special_specs = []*Spec{{
 	Name:   "noguard",
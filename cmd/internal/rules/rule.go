@@ -1,8 +1,11 @@
 package rules
 
 import (
+	"strings"
+
 	"github.com/frk/valid/cmd/internal/config"
 	"github.com/frk/valid/cmd/internal/gotype"
+	"github.com/frk/valid/internal/tag"
 )
 
 // Rule represents the rule as parsed from a struct tag.
@@ -11,13 +14,38 @@ type Rule struct {
 	Name string
 	// The arguments of the rule.
 	Args []*Arg
+	// The name of the validation group to which the rule is
+	// restricted, or empty if the rule applies to all groups.
+	Group string
+	// Indicates that the rule is negated, i.e. that the value
+	// is valid only if it fails the rule, e.g. `is:"!contains:foo"`.
+	Negated bool
+	// If the rule is an alternation group, e.g. `is:"(ip|fqdn)"`, then
+	// Alt holds the group's rules, at least one of which the value must
+	// pass. The group's Name is the names of its rules joined by "|".
+	Alt []*Rule
 	// The spec associated with the rule. Note that this is
 	// not populated by the parser but instead by the Checker.
 	Spec *Spec
 }
 
 func (r Rule) String() (out string) {
-	out = r.Name
+	if len(r.Alt) > 0 {
+		alts := make([]string, len(r.Alt))
+		for i := range r.Alt {
+			alts[i] = r.Alt[i].String()
+		}
+		out = "(" + strings.Join(alts, "|") + ")"
+		if len(r.Group) > 0 {
+			out += "@" + r.Group
+		}
+		return out
+	}
+
+	out = r.TagName()
+	if len(r.Group) > 0 {
+		out += "@" + r.Group
+	}
 	for i := range r.Args {
 		out += ":"
 		switch r.Args[i].Type {
@@ -31,6 +59,50 @@ func (r Rule) String() (out string) {
 	return out
 }
 
+// TagName returns the name of the rule as it appears in the
+// struct tag, i.e. prefixed with "!" if the rule is negated.
+func (r Rule) TagName() string {
+	if r.Negated {
+		return "!" + r.Name
+	}
+	return r.Name
+}
+
+// ArgList returns the rule's arguments or, if the rule is an
+// alternation group, the arguments of all of the group's rules.
+func (r Rule) ArgList() []*Arg {
+	if len(r.Alt) == 0 {
+		return r.Args
+	}
+
+	var args []*Arg
+	for _, a := range r.Alt {
+		args = append(args, a.ArgList()...)
+	}
+	return args
+}
+
+// ErrSpec returns the spec of the rule's error message, i.e. the entry
+// of the spec's ErrOpts that matches the pattern of the rule's arguments,
+// e.g. "x:" for `len:8:`, or the spec's Err if there's no such entry.
+func (r Rule) ErrSpec() ErrSpec {
+	if len(r.Spec.ErrOpts) > 0 && len(r.Args) > 0 {
+		var key string
+		for _, a := range r.Args {
+			key += ":"
+			if len(a.Value) > 0 {
+				key += "x"
+			}
+		}
+
+		key = key[1:]
+		if c, ok := r.Spec.ErrOpts[key]; ok {
+			return c
+		}
+	}
+	return r.Spec.Err
+}
+
 // IsBasic reports whether or not the rule is a basic rule.
 // What "basic" means at this point is that the rule is NOT a
 // function that returns an error as its second return value.
@@ -116,35 +188,18 @@ func (a *Arg) CanAssignTo(t *gotype.Type, keyMap map[string]*FieldNode) bool {
 }
 
 // ArgType indicates the type of a rule argument value.
-type ArgType uint
-
-func (t ArgType) String() string {
-	if int(t) < len(_argtypestring) {
-		return _argtypestring[t]
-	}
-	return "<invalid>"
-}
+type ArgType = tag.ArgType
 
 const (
-	ARG_UNKNOWN ArgType = iota
-	ARG_BOOL
-	ARG_INT
-	ARG_FLOAT
-	ARG_STRING
-	ARG_FIELD_ABS
-	ARG_FIELD_REL
+	ARG_UNKNOWN   = tag.ARG_UNKNOWN
+	ARG_BOOL      = tag.ARG_BOOL
+	ARG_INT       = tag.ARG_INT
+	ARG_FLOAT     = tag.ARG_FLOAT
+	ARG_STRING    = tag.ARG_STRING
+	ARG_FIELD_ABS = tag.ARG_FIELD_ABS
+	ARG_FIELD_REL = tag.ARG_FIELD_REL
 )
 
-var _argtypestring = [...]string{
-	ARG_UNKNOWN:   "<unknown>",
-	ARG_BOOL:      "bool",
-	ARG_INT:       "int",
-	ARG_FLOAT:     "float",
-	ARG_STRING:    "string",
-	ARG_FIELD_ABS: "<field_abs>",
-	ARG_FIELD_REL: "<field_rel>",
-}
-
 var _scalarargs = [...]ArgType{
 	config.NIL:    ARG_UNKNOWN,
 	config.BOOL:   ARG_BOOL,
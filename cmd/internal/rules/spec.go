@@ -23,14 +23,18 @@ func (k SpecKind) String() string {
 const (
 	_ SpecKind = iota
 
-	REQUIRED   // required, notnil
-	COMPARABLE // =, !=
-	ORDERED    // >, >=, <, <=, min, max
-	LENGTH     // len, runecount
-	RANGE      // rng
-	ENUM       // enum
-	FUNCTION   // <custom/builtin/included func rules>
-	METHOD     // isvalid (implicit), ...
+	REQUIRED     // required, notnil
+	CONDREQUIRED // required_if, required_unless, required_with, required_without
+	COMPARABLE   // =, !=
+	ORDERED      // >, >=, <, <=, min, max
+	LENGTH       // len, runecount
+	RANGE        // rng
+	ENUM         // enum
+	TEMPORAL     // before, after, future, past, within
+	UNIQUE       // unique
+	FUNCTION     // <custom/builtin/included func rules>
+	METHOD       // isvalid (implicit), ...
+	ALTERNATION  // (rule|rule...)
 
 	// "modifiers"
 	OPTIONAL // omitnil [is the default rule for pointers] (ptr only), optional (ptr & base)
@@ -42,18 +46,22 @@ const (
 )
 
 var _speckindstring = [...]string{
-	REQUIRED:   "REQUIRED",
-	COMPARABLE: "COMPARABLE",
-	ORDERED:    "ORDERED",
-	LENGTH:     "LENGTH",
-	RANGE:      "RANGE",
-	ENUM:       "ENUM",
-	FUNCTION:   "FUNCTION",
-	METHOD:     "METHOD",
-	OPTIONAL:   "OPTIONAL",
-	NOGUARD:    "NOGUARD",
-	REMOVE:     "REMOVE",
-	PREPROC:    "PREPROC",
+	REQUIRED:     "REQUIRED",
+	CONDREQUIRED: "CONDREQUIRED",
+	COMPARABLE:   "COMPARABLE",
+	ORDERED:      "ORDERED",
+	LENGTH:       "LENGTH",
+	RANGE:        "RANGE",
+	ENUM:         "ENUM",
+	TEMPORAL:     "TEMPORAL",
+	UNIQUE:       "UNIQUE",
+	FUNCTION:     "FUNCTION",
+	METHOD:       "METHOD",
+	ALTERNATION:  "ALTERNATION",
+	OPTIONAL:     "OPTIONAL",
+	NOGUARD:      "NOGUARD",
+	REMOVE:       "REMOVE",
+	PREPROC:      "PREPROC",
 }
 
 type Spec struct {
@@ -65,6 +73,9 @@ type Spec struct {
 	FName string
 	// Kind=FUNCTION only, the function's type.
 	FType *gotype.Type
+	// Kind=FUNCTION & Kind=PREPROC only, the type of the dependency
+	// on which the function is declared as a method, or nil.
+	Dep *gotype.Type
 	// ArgMin and ArgMax define bounds of allowed
 	// number of arguments for the rule.
 	ArgMin, ArgMax int
@@ -89,6 +100,10 @@ type ErrSpec struct {
 	// If true the generated error message
 	// will include the rule's arguments.
 	WithArgs bool
+	// If true the generated error message will include the
+	// values of those of the rule's arguments that reference
+	// other fields, regardless of the WithArgs setting.
+	WithFieldArgs bool
 	// The separator used to join the rule's
 	// arguments for the error message.
 	ArgSep string
@@ -96,6 +111,25 @@ type ErrSpec struct {
 	ArgSuffix string
 }
 
+// NegateErrText returns the negation of the given error message text,
+// e.g. "must contain" becomes "must not contain" and vice versa.
+func NegateErrText(text string) string {
+	switch {
+	case strings.HasPrefix(text, "must not "):
+		return "must " + text[len("must not "):]
+	case strings.HasPrefix(text, "must "):
+		return "must not " + text[len("must "):]
+	case strings.HasPrefix(text, "cannot "):
+		return "can " + text[len("cannot "):]
+	case strings.HasPrefix(text, "is not "):
+		return "is " + text[len("is not "):]
+	}
+	if i := strings.Index(text, " must "); i > -1 {
+		return text[:i] + " must not " + text[i+len(" must "):]
+	}
+	return "must not satisfy: " + text
+}
+
 // JoinOp represents the boolean operator that can be used
 // to join multiple instances of a rule into a single one.
 //
@@ -153,7 +187,14 @@ func loadIncludedSpecs(a *search.AST) error {
 // initCustomSpecs initializes custom rules from the given config.
 func initCustomSpecs(cfg config.Config, a *search.AST) error {
 	for _, rc := range cfg.Rules {
-		ftyp, rawCfg, err := search.FindFunc(rc.Func.Pkg, rc.Func.Name, a)
+		var ftyp *types.Func
+		var rawCfg []byte
+		var err error
+		if rc.Dep.Name != "" {
+			ftyp, rawCfg, err = search.FindMethod(rc.Dep.Pkg, rc.Dep.Name, rc.Method, a)
+		} else {
+			ftyp, rawCfg, err = search.FindFunc(rc.Func.Pkg, rc.Func.Name, a)
+		}
 		if err != nil {
 			return &Error{C: ERR_CONFIG_FUNCSEARCH, a: a, c: &cfg, err: err}
 		}
@@ -260,6 +301,13 @@ func specFromFunc(a *search.AST, f *types.Func, rs *config.RuleSpec) (*Spec, err
 	spec.FName = f.Name()
 	spec.FType = ty
 	spec.JoinOp = jop
+	if recv := f.Type().(*types.Signature).Recv(); recv != nil {
+		rt := recv.Type()
+		if ptr, ok := rt.(*types.Pointer); ok {
+			rt = ptr.Elem()
+		}
+		spec.Dep = an.Analyze(rt)
+	}
 	spec.Err = ErrSpec(rs.Error)
 
 	// the "re" (regexp) rule should use raw strings for arguments
@@ -168,6 +168,9 @@ func Test_initCustomSpecs(t *testing.T) {
 			fp:   &gotype.Var{Name: "opt", Type: T.bool},
 			fpi:  T.iptr(0),
 		},
+	}, {
+		name: "dep method does not exist",
+		want: &Error{C: ERR_CONFIG_FUNCSEARCH, a: T._ast, c: T._cfg, err: &search.Error{}},
 	}}
 
 	cfg := loadConfig("testdata/configs/bad_custom_rules.yaml")
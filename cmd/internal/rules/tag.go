@@ -4,7 +4,7 @@ import (
 	"reflect"
 	"strings"
 
-	"github.com/frk/valid"
+	"github.com/frk/valid/internal/tag"
 )
 
 // A Tag is a tree-like representation of a parsed "rule" struct tag.
@@ -103,7 +103,7 @@ func (t *Tag) GetRule(name string) (r *Rule, ok bool) {
 func (t *Tag) AddRule(r *Rule) {
 	if t != nil {
 		for i := range t.Rules {
-			if t.Rules[i].Name == r.Name {
+			if t.Rules[i].TagName() == r.TagName() && t.Rules[i].Group == r.Group {
 				return
 			}
 		}
@@ -143,34 +143,13 @@ func (t *Tag) IsEmpty() bool {
 	return true
 }
 
-// parseTag parses the given tag and returns the resulting AST.
-// The string "is" is used as the key in the struct tag, e.g. `is:"rule"`.
+// parseTag parses the given tag and returns the resulting AST. The key
+// argument is used to look up the rule string in the struct tag, e.g.
+// the key "is" for `is:"rule"`. For a description of the rule syntax
+// see the documentation of the internal/tag package's Parse function.
 //
-// If the "is" key is not present in the tag, or the associated value
+// If the key is not present in the tag, or the associated value
 // is empty or equal to "-", then the returned AST will be nil.
-//
-// Following is a description of the rule syntax using EBNF:
-//
-//      node      = rule | [ "[" [ node ] "]" ] [ ( node | rule "," node ) ] .
-//      rule      = rule_name [ { ":" rule_arg } ] { "," rule } .
-//      rule_name = identifier .
-//      rule_arg  = | boolean_lit | integer_lit | float_lit | string_lit | quoted_string_lit | field_reference .
-//
-//      boolean_lit       = "true" | "false" .
-//      integer_lit       = "0" | [ "-" ] "1"…"9" { "0"…"9" } .
-//      float_lit         = [ "-" ] ( "0" | "1"…"9" { "0"…"9" } ) "." "0"…"9" { "0"…"9" } .
-//      string_lit        = .
-//      quoted_string_lit = `"` `"` .
-//
-//      field_reference = field_ref_abs | field_ref_rel
-//      field_ref_abs   = "&" field_key .
-//      field_ref_rel   = "." field_key .
-//      field_key       = identifier { field_key_separator identifier } .
-//      field_key_sep   = "." | (* optionally specified by the user *)
-//
-//      identifier = letter { letter } .
-//      letter     = "A"…"Z" | "a"…"z" | "_" .
-//
 func parseTag(tag, key string) *Tag {
 	str, ok := reflect.StructTag(tag).Lookup(key)
 	if !ok || str == "-" || len(str) == 0 {
@@ -179,176 +158,54 @@ func parseTag(tag, key string) *Tag {
 	return parseRule(str, key)
 }
 
-// `is:".field.bar"`
+// parseDefault parses the "default" value of the given struct tag, if any.
+func parseDefault(tag string) *Arg {
+	str, ok := reflect.StructTag(tag).Lookup("default")
+	if !ok || len(str) == 0 {
+		return nil
+	}
+	return parseArg(str)
+}
 
 // parseRule parses the given rule string and returns the AST.
 func parseRule(str, stkey string) *Tag {
-	tag := &Tag{stkey: stkey}
-	for str != "" {
-		// skip leading space
-		i := 0
-		for i < len(str) && str[i] == ' ' {
-			i++
-		}
-		str = str[i:]
-		if str == "" {
-			break
-		}
-
-		// parse bracketed nodes
-		if str[0] == '[' {
-
-			// scan up to the *matching* closing bracket
-			i, n := 1, 0
-			for i < len(str) && (str[i] != ']' || n > 0) {
-				// adjust nesting level
-				if str[i] == '[' {
-					n++
-				} else if str[i] == ']' {
-					n--
-				}
-				i++
-
-				// scan quoted string, ignoring brackets inside quotes
-				if str[i-1] == '"' {
-					for i < len(str) && str[i] != '"' {
-						if str[i] == '\\' {
-							i++
-						}
-						i++
-					}
-
-					// keep the closing double quote, or
-					// else the subsequent parser calls
-					// will be confused without it
-					if i < len(str) {
-						i++
-					}
-				}
-			}
-
-			// recursively invoke parser for key
-			if ktag := str[1:i]; len(ktag) > 0 {
-				tag.Key = parseRule(ktag, stkey)
-			}
-			// recursively invoke parser for elem
-			if etag := str[i:]; len(etag) > 1 {
-				etag = etag[1:] // drop the leading ']'
-				tag.Elem = parseRule(etag, stkey)
-			}
-
-			// done; exit
-			return tag
-		}
-
-		// scan to the end of a rule's name
-		i = 0
-		for i < len(str) && str[i] != ',' && str[i] != ':' {
-			i++
-		}
-
-		// empty name's no good; next
-		if str[:i] == "" {
-			str = str[1:]
-			continue
-		}
-
-		rule := &Rule{Name: str[:i]}
-		tag.AddRule(rule)
-
-		// this rule's done; next or exit
-		if str = str[i:]; str == "" {
-			break
-		} else if str[0] == ',' {
-			str = str[1:]
-			continue
-		}
-
-		// scan the rule's arguments
-		for str != "" {
-			str = str[1:] // drop the leading ':'
-
-			// quoted argument value; scan to the end quote
-			if len(str) > 0 && str[0] == '"' {
-				i := 1
-				for i < len(str) && str[i] != '"' {
-					if str[i] == '\\' {
-						i++
-					}
-					i++
-				}
-
-				a := &Arg{}
-				a.Type = ARG_STRING
-				a.Value = str[1:i]
-				rule.Args = append(rule.Args, a)
-
-				str = str[i:]
-
-				// drop the closing quote
-				if len(str) > 0 && str[0] == '"' {
-					str = str[1:]
-				}
-
-				// next argument?
-				if len(str) > 0 && str[0] == ':' {
-					continue
-				}
-
-				// drop rule separator
-				if len(str) > 0 && str[0] == ',' {
-					str = str[1:]
-				}
-
-				// this rule's done; exit
-				break
-			}
+	return convertTag(tag.Parse(str), stkey)
+}
 
-			// scan to the end of a rule's argument
-			i := 0
-			for i < len(str) && str[i] != ':' && str[i] != ',' {
-				i++
-			}
+// parseArg parses the given string as an Arg and returns the result.
+func parseArg(str string) *Arg {
+	return convertArg(tag.ParseArg(str))
+}
 
-			astr := str[:i]
-			arg := parseArg(astr)
-			rule.Args = append(rule.Args, arg)
+// convertTag converts the AST produced by the internal/tag
+// parser to the Tag representation used by the Checker.
+func convertTag(t *tag.Tag, stkey string) *Tag {
+	if t == nil {
+		return nil
+	}
 
-			str = str[i:]
-			if str == "" {
-				break
-			} else if str[0] == ',' {
-				str = str[1:]
-				break
-			}
-		}
+	out := &Tag{stkey: stkey}
+	for _, r := range t.Rules {
+		out.Rules = append(out.Rules, convertRule(r))
 	}
-	return tag
+	out.Key = convertTag(t.Key, stkey)
+	out.Elem = convertTag(t.Elem, stkey)
+	return out
 }
 
-// parseArg parses the given string as an Arg and returns the result.
-func parseArg(str string) (a *Arg) {
-	a = &Arg{}
-	if len(str) > 0 {
-		if str[0] == '&' {
-			a.Type = ARG_FIELD_ABS
-			a.Value = str[1:]
-		} else if str[0] == '.' {
-			a.Type = ARG_FIELD_REL
-			a.Value = str[1:]
-		} else {
-			switch {
-			case valid.Int(str):
-				a.Type = ARG_INT
-			case valid.Float(str):
-				a.Type = ARG_FLOAT
-			case str == "true" || str == "false":
-				a.Type = ARG_BOOL
-			case str != `nil`:
-				a.Type = ARG_STRING
-			}
-			a.Value = str
-		}
+// convertRule converts the given rule produced by the internal/tag parser.
+func convertRule(r *tag.Rule) *Rule {
+	out := &Rule{Name: r.Name, Group: r.Group, Negated: r.Negated}
+	for _, a := range r.Args {
+		out.Args = append(out.Args, convertArg(a))
 	}
-	return a
+	for _, a := range r.Alt {
+		out.Alt = append(out.Alt, convertRule(a))
+	}
+	return out
+}
+
+// convertArg converts the given arg produced by the internal/tag parser.
+func convertArg(a *tag.Arg) *Arg {
+	return &Arg{Type: a.Type, Value: a.Value}
 }
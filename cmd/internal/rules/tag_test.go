@@ -29,6 +29,50 @@ func TestParse(t *testing.T) {
 		want: &Tag{Rules: []*Rule{{Name: "rule", Args: []*Arg{
 			{Value: "arg", Type: ARG_STRING},
 		}}}},
+	}, {
+		// rules restricted to groups
+		tag: `is:"required@create,email,len@update:1:64,required@update"`,
+		want: &Tag{Rules: []*Rule{
+			{Name: "required", Group: "create"},
+			{Name: "email"},
+			{Name: "len", Group: "update", Args: []*Arg{
+				{Value: "1", Type: ARG_INT},
+				{Value: "64", Type: ARG_INT},
+			}},
+			{Name: "required", Group: "update"},
+		}},
+	}, {
+		// negated rules
+		tag: `is:"!contains:admin,!ip@create,!=:foo"`,
+		want: &Tag{Rules: []*Rule{
+			{Name: "contains", Negated: true, Args: []*Arg{
+				{Value: "admin", Type: ARG_STRING},
+			}},
+			{Name: "ip", Group: "create", Negated: true},
+			{Name: "=", Negated: true, Args: []*Arg{
+				{Value: "foo", Type: ARG_STRING},
+			}},
+		}},
+	}, {
+		// alternation groups
+		tag: `is:"required,(ip|fqdn),(len:2:8|!prefix:\"a|b)\"|eq:&F)@update,email"`,
+		want: &Tag{Rules: []*Rule{
+			{Name: "required"},
+			{Name: "ip|fqdn", Alt: []*Rule{{Name: "ip"}, {Name: "fqdn"}}},
+			{Name: "len|!prefix|eq", Group: "update", Alt: []*Rule{
+				{Name: "len", Args: []*Arg{
+					{Value: "2", Type: ARG_INT},
+					{Value: "8", Type: ARG_INT},
+				}},
+				{Name: "prefix", Negated: true, Args: []*Arg{
+					{Value: "a|b)", Type: ARG_STRING},
+				}},
+				{Name: "eq", Args: []*Arg{
+					{Value: "F", Type: ARG_FIELD_ABS},
+				}},
+			}},
+			{Name: "email"},
+		}},
 	}, {
 		// single rule with arguments
 		tag: `is:"rule:arg:123:true:0.0064"`,
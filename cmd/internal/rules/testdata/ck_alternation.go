@@ -0,0 +1,21 @@
+package testdata
+
+type Test_ERR_NEGATION_KIND_1_Validator struct {
+	F string `is:"!required"`
+}
+
+type Test_ERR_ALTERNATION_KIND_1_Validator struct {
+	F []string `is:"(unique|len:1)"`
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// valid test cases
+////////////////////////////////////////////////////////////////////////////////
+
+type Test_alternation_Validator struct {
+	F1 string   `is:"(ip|fqdn)"`
+	F2 string   `is:"!contains:admin,(len:2:8|!prefix:x)"`
+	F3 *int     `is:"(eq:&F4|gt:10)"`
+	F4 int      `is:"!eq:0"`
+	F5 []string `is:"[](email|eq:\"root\")"`
+}
@@ -0,0 +1,35 @@
+package testdata
+
+type Test_ERR_DEFAULT_CONFLICT_1_Validator struct {
+	F string `is:"required" default:"foo"`
+}
+
+type Test_ERR_DEFAULT_CONFLICT_2_Validator struct {
+	F *int `is:"notnil" default:"42"`
+}
+
+type Test_ERR_DEFAULT_TYPE_1_Validator struct {
+	F int `default:"foo"`
+}
+
+type Test_ERR_DEFAULT_TYPE_2_Validator struct {
+	F []string `default:"foo"`
+}
+
+type Test_ERR_DEFAULT_TYPE_3_Validator struct {
+	F *uint `default:"-1"`
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// valid test cases
+////////////////////////////////////////////////////////////////////////////////
+
+type Test_default_Validator struct {
+	F1 string   `default:"foo"`
+	F2 *int     `default:"42"`
+	F3 **bool   `default:"true"`
+	F4 float64  `is:"gt:0" default:"0.5"`
+	F5 *string  `is:"optional,len:3" default:"bar"`
+	F6 string   `default:"&F1"`
+	F7 *float64 `default:"&F4"`
+}
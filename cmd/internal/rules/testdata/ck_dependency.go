@@ -0,0 +1,47 @@
+package testdata
+
+import (
+	"github.com/frk/valid/cmd/internal/rules/testdata/mypkg"
+)
+
+type Test_ERR_DEPENDENCY_MISSING_1_Validator struct {
+	F string `is:"unique_email"`
+}
+
+type Test_ERR_DEPENDENCY_MISSING_2_Validator struct {
+	F    string `pre:"normalize"`
+	Deps struct {
+		Repo *mypkg.UserRepo
+	}
+}
+
+type Test_ERR_DEPENDENCY_MISSING_3_Validator struct {
+	F    string `is:"entitled"`
+	Deps struct {
+		Deps struct {
+			Ent mypkg.Entitlements
+		}
+	}
+}
+
+type Test_ERR_DEPENDENCY_DEPSPTR_1_Validator struct {
+	F    string `is:"entitled"`
+	Deps *struct {
+		Ent mypkg.Entitlements
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// valid test cases
+////////////////////////////////////////////////////////////////////////////////
+
+type Test_dependency_Validator struct {
+	F1   string `is:"unique_email"`
+	F2   string `is:"entitled"`
+	F3   string `pre:"normalize"`
+	Repo *mypkg.UserRepo
+	Deps struct {
+		Ent  mypkg.Entitlements
+		Norm mypkg.Normalizer
+	}
+}
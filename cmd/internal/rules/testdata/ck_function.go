@@ -12,6 +12,11 @@ type Test_ERR_FUNCTION_ARGTYPE_1_Validator struct {
 	F string `is:"uuid:v6"`
 }
 
+type Test_ERR_FUNCTION_ARGTYPE_2_Validator struct {
+	F string `is:"zip:&G"`
+	G []byte
+}
+
 type Test_ERR_FUNCTION_ARGVALUE_1_Validator struct {
 	F string `is:"alpha:foo"`
 }
@@ -72,6 +77,22 @@ type Test_ERR_FUNCTION_ARGVALUE_15_Validator struct {
 	F string `is:"zip:foo"`
 }
 
+type Test_ERR_FUNCTION_ARGVALUE_16_Validator struct {
+	F string `is:"ic:foo"`
+}
+
+type Test_ERR_FUNCTION_ARGVALUE_17_Validator struct {
+	F string `is:"passport:foo"`
+}
+
+type Test_ERR_FUNCTION_ARGVALUE_18_Validator struct {
+	F string `is:"iso31662:foo"`
+}
+
+type Test_ERR_FUNCTION_ARGVALUE_19_Validator struct {
+	F string `is:"date:foo"`
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // valid test cases
 ////////////////////////////////////////////////////////////////////////////////
@@ -88,6 +109,11 @@ type Test_function_Validator struct {
 	UUID6 string `is:"uuid:5"`
 	UUID7 string `is:"uuid:v5"`
 
+	Date1 string `is:"date"`
+	Date2 string `is:"date:rfc1123"`
+	Date3 string `is:"date:\"02/01/2006 15:04\""`
+	Date4 string `is:"iso8601:strict,rfc3339,timezone"`
+
 	R8 string `is:"r8:&helper"`
 	R9 string `is:"r9:&helper2"`
 
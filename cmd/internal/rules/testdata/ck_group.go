@@ -0,0 +1,20 @@
+package testdata
+
+type Test_ERR_GROUP_UNKNOWN_1_Validator struct {
+	F string `is:"required@delete"`
+}
+
+type Test_ERR_GROUP_UNKNOWN_2_Validator struct {
+	F string `is:"email" is.delete:"required"`
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// valid test cases
+////////////////////////////////////////////////////////////////////////////////
+
+type Test_group_Validator struct {
+	F1 string            `is:"required@create,email"`
+	F2 string            `is:"len:1:64" is.update:"required"`
+	F3 map[string]string `is:"[email]required@update"`
+	F4 string            `is:"min:1,min@update:2"`
+}
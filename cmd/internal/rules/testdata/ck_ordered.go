@@ -1,5 +1,9 @@
 package testdata
 
+import (
+	"time"
+)
+
 type Test_ERR_ORDERED_TYPE_1_Validator struct {
 	F []string `is:"min:8"`
 }
@@ -21,6 +25,10 @@ type Test_ERR_ORDERED_ARGTYPE_3_Validator struct {
 	S struct{ F int }
 }
 
+type Test_ERR_ORDERED_ARGTYPE_4_Validator struct {
+	F time.Duration `is:"max:30x"`
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // valid test cases
 ////////////////////////////////////////////////////////////////////////////////
@@ -37,4 +45,5 @@ type Test_ordered_Validator struct {
 		F1 int
 		F2 float64
 	}
+	F8 time.Duration `is:"min:1s,max:1h30m"`
 }
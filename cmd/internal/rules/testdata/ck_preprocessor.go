@@ -12,10 +12,15 @@ type Test_ERR_PREPROC_ARGTYPE_1_Validator struct {
 	F string `pre:"p4:foo"`
 }
 
+type Test_ERR_PREPROC_ARGVALUE_1_Validator struct {
+	F string `pre:"e164:zz"`
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // valid test cases
 ////////////////////////////////////////////////////////////////////////////////
 
 type Test_preproc_Validator struct {
 	F1 string `pre:"trim"`
+	F2 string `pre:"e164:gb"`
 }
@@ -12,6 +12,35 @@ type Test_ERR_NOTNIL_TYPE_3_Validator struct {
 	F float64 `is:"notnil"`
 }
 
+type Test_ERR_CONDREQUIRED_TYPE_1_Validator struct {
+	F [2]int `is:"required_with:&G"`
+	G string
+}
+
+type Test_ERR_CONDREQUIRED_NOFIELD_1_Validator struct {
+	F string `is:"required_if:card:visa"`
+}
+
+type Test_ERR_CONDREQUIRED_NOFIELD_2_Validator struct {
+	F string `is:"required_without:&G:foo"`
+	G string
+}
+
+type Test_ERR_CONDREQUIRED_FIELDTYPE_1_Validator struct {
+	F string `is:"required_if:&G:foo"`
+	G []string
+}
+
+type Test_ERR_CONDREQUIRED_FIELDTYPE_2_Validator struct {
+	F string `is:"required_with:&G"`
+	G [3]int
+}
+
+type Test_ERR_CONDREQUIRED_ARGTYPE_1_Validator struct {
+	F string `is:"required_unless:&G:1:foo"`
+	G int
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // valid test cases
 ////////////////////////////////////////////////////////////////////////////////
@@ -25,3 +54,17 @@ type Test_required_Validator struct {
 	F6 int               `is:"required"`
 	F7 bool              `is:"required"`
 }
+
+type Test_condrequired_Validator struct {
+	F1 string            `is:"required_if:&G1:foo:bar"`
+	F2 *string           `is:"required_unless:&G2:0"`
+	F3 []byte            `is:"required_with:&G3:&G4"`
+	F4 map[string]string `is:"required_without:&G5"`
+	F5 interface{}       `is:"required_if:&G6:true"`
+	G1 string
+	G2 int
+	G3 *string
+	G4 []int
+	G5 map[string]int
+	G6 bool
+}
@@ -0,0 +1,41 @@
+package testdata
+
+import (
+	"time"
+)
+
+type Test_ERR_TEMPORAL_TYPE_1_Validator struct {
+	F string `is:"before:2030-01-01"`
+}
+
+type Test_ERR_TEMPORAL_TYPE_2_Validator struct {
+	F *int64 `is:"future"`
+}
+
+type Test_ERR_TEMPORAL_ARGTYPE_1_Validator struct {
+	F time.Time `is:"after:foo"`
+}
+
+type Test_ERR_TEMPORAL_ARGTYPE_2_Validator struct {
+	F time.Time `is:"before:&S"`
+	S string
+}
+
+type Test_ERR_TEMPORAL_ARGTYPE_3_Validator struct {
+	F time.Time `is:"within:-1h"`
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// valid test cases
+////////////////////////////////////////////////////////////////////////////////
+
+type Test_temporal_Validator struct {
+	F1 time.Time      `is:"before:2030-01-01"`
+	F2 time.Time      `is:"after:&F1,before:\"2030-01-01T12:30:00+02:00\""`
+	F3 time.Time      `is:"future"`
+	F4 *time.Time     `is:"past"`
+	F5 time.Time      `is:"within:24h"`
+	F6 time.Duration  `is:"min:1s,max:30m"`
+	F7 []time.Time    `is:"[]after:\"2006-01-02T15:04:05\""`
+	F8 *time.Duration `is:"gt:100ms"`
+}
@@ -0,0 +1,55 @@
+package testdata
+
+type Test_ERR_UNIQUE_TYPE_1_Validator struct {
+	F string `is:"unique"`
+}
+
+type Test_ERR_UNIQUE_ELEM_1_Validator struct {
+	F []map[string]int `is:"unique"`
+}
+
+type Test_ERR_UNIQUE_ELEM_2_Validator struct {
+	F []any `is:"unique"`
+}
+
+type Test_ERR_UNIQUE_ELEM_3_Validator struct {
+	F []struct {
+		ID any
+	} `is:"unique:ID"`
+}
+
+type Test_ERR_UNIQUE_ELEM_4_Validator struct {
+	F []struct {
+		ID struct {
+			V any
+		}
+	} `is:"unique:ID"`
+}
+
+type Test_ERR_UNIQUE_ELEM_5_Validator struct {
+	F [][2]struct {
+		V any
+	} `is:"unique"`
+}
+
+type Test_ERR_UNIQUE_FIELD_1_Validator struct {
+	F []string `is:"unique:ID"`
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// valid test cases
+////////////////////////////////////////////////////////////////////////////////
+
+type Test_unique_Validator struct {
+	F1 []string          `is:"unique"`
+	F2 [4]int            `is:"unique"`
+	F3 map[string]string `is:"unique"`
+	F4 *[]float64        `is:"unique"`
+	F5 []struct {
+		ID   int
+		Tags []string
+	} `is:"unique:ID"`
+	F6 map[int]struct {
+		Name string
+	} `is:"unique:Name"`
+}
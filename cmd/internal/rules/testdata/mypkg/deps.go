@@ -0,0 +1,21 @@
+package mypkg
+
+type UserRepo struct {
+	// ...
+}
+
+func (r *UserRepo) IsUniqueEmail(v string) bool {
+	return true
+}
+
+type Entitlements interface {
+	Allows(v string) (bool, error)
+}
+
+type Normalizer struct {
+	// ...
+}
+
+func (Normalizer) Normalize(v string) string {
+	return v
+}
@@ -42,6 +42,7 @@ const (
 
 	ERR_OBJECT_NOTFOUND // object (func or type) not found
 	ERR_FUNC_NOTFOUND   // func not found
+	ERR_METHOD_NOTFOUND // method not found
 	ERR_PKG_NOTFOUND    // package not found
 	ERR_PKG_LOADFAIL    // failed loading package
 	ERR_PKG_ERROR       // package contains errors
@@ -64,6 +65,10 @@ var error_template = `
 {{ ERROR }} Could not find function "{{W .ObjName}}" in package "{{W .PkgPath}}".
 {{ end }}
 
+{{ define "` + ERR_METHOD_NOTFOUND.ident() + `" -}}
+{{ ERROR }} Could not find method "{{W .ObjName}}" in package "{{W .PkgPath}}".
+{{ end }}
+
 {{ define "` + ERR_PKG_NOTFOUND.ident() + `" -}}
 {{ ERROR }} Could not find package "{{W .PkgPath}}" for function "{{W .ObjName}}".
 {{ end }}
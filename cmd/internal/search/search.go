@@ -397,6 +397,53 @@ func FindFunc(pkgpath, name string, a *AST) (fn *types.Func, rawCfg []byte, err
 	return nil, nil, &Error{C: ERR_FUNC_NOTFOUND, pkg: pkgpath, name: name}
 }
 
+// FindMethod scans the package identified by pkgpath looking for a named type
+// with the given typeName that has a method with the given name and, if
+// successful, returns the go/types.Func representation of that method.
+// The named type can be either a concrete type or an interface type.
+//
+// Like FindFunc, FindMethod is exepcted to be invoked *after* Search
+// and the AST argument is expected to be the same as the one given to Search.
+func FindMethod(pkgpath, typeName, name string, a *AST) (fn *types.Func, rawCfg []byte, err error) {
+	obj, err := FindObject(pkgpath, typeName, a)
+	if err != nil {
+		return nil, nil, err
+	}
+	tn, ok := obj.(*types.TypeName)
+	if !ok {
+		return nil, nil, &Error{C: ERR_OBJECT_NOTFOUND, pkg: pkgpath, name: typeName}
+	}
+
+	mobj, _, _ := types.LookupFieldOrMethod(tn.Type(), true, tn.Pkg(), name)
+	if fn, ok = mobj.(*types.Func); !ok {
+		return nil, nil, &Error{C: ERR_METHOD_NOTFOUND, pkg: pkgpath, name: typeName + "." + name}
+	}
+
+	// look for the method's documentation, it will either be
+	// in the method's declaration or in the interface's field
+	pkg, err := findpkg(pkgpath, name, a)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, syn := range pkg.Syntax {
+		ast.Inspect(syn, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.FuncDecl:
+				if n.Recv != nil && pkg.TypesInfo.Defs[n.Name] == fn {
+					rawCfg = extractRuleYAML(n.Doc)
+				}
+				return false
+			case *ast.Field:
+				if len(n.Names) == 1 && pkg.TypesInfo.Defs[n.Names[0]] == fn {
+					rawCfg = extractRuleYAML(n.Doc)
+				}
+			}
+			return rawCfg == nil
+		})
+	}
+	return fn, rawCfg, nil
+}
+
 // FindObject returns a top-level declared object that matches
 // the given pkgpath and name. The returned object will either
 // be a top-level declared type or a top-level declared function.
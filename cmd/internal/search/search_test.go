@@ -232,6 +232,65 @@ error: { text: "bar is not valid" }
 	}
 }
 
+func TestFindMethod(t *testing.T) {
+	tests := []struct {
+		pkg     string
+		typname string
+		name    string
+		rawCfg  []byte
+		err     error
+		show    bool
+	}{{
+		pkg:     "strings",
+		typname: "Builder",
+		name:    "String",
+	}, {
+		pkg:     "github.com/frk/valid/cmd/internal/search/testdata",
+		typname: "Repo",
+		name:    "IsUniqueName",
+		rawCfg:  []byte("\nname: unique_name\n"),
+	}, {
+		pkg:     "github.com/frk/valid/cmd/internal/search/testdata",
+		typname: "RepoIface",
+		name:    "IsUniqueEmail",
+		rawCfg:  []byte("\nname: unique_email\n"),
+	}, {
+		pkg:     "github.com/frk/valid/cmd/internal/search/testdata",
+		typname: "Repo",
+		name:    "Abracadabra",
+		err: &Error{C: ERR_METHOD_NOTFOUND,
+			pkg:  "github.com/frk/valid/cmd/internal/search/testdata",
+			name: "Repo.Abracadabra",
+		},
+	}, {
+		pkg:     "github.com/frk/valid/cmd/internal/search/testdata",
+		typname: "Oper",
+		name:    "IsUniqueName",
+		err: &Error{C: ERR_OBJECT_NOTFOUND,
+			pkg:  "github.com/frk/valid/cmd/internal/search/testdata",
+			name: "Oper",
+		},
+	}}
+
+	compare := compare.Config{ObserveFieldTag: "cmp"}
+	for i, tt := range tests {
+		fn, rawCfg, err := FindMethod(tt.pkg, tt.typname, tt.name, &testast)
+		if e := compare.Compare(err, tt.err); e != nil {
+			t.Errorf("Error: %v (%v)", e, err)
+		} else if err == nil {
+			if p := fn.Pkg(); p.Path() != tt.pkg || fn.Name() != tt.name {
+				t.Errorf("#%d: want=%s.%s.%s; got err=%v", i, tt.pkg, tt.typname, tt.name, fn)
+			}
+			if e := compare.Compare(rawCfg, tt.rawCfg); e != nil {
+				t.Errorf("#%d: rawCfg: %v\n%q", i, e, rawCfg)
+			}
+		}
+		if tt.show && tt.err != nil {
+			fmt.Println(err)
+		}
+	}
+}
+
 func TestFindConstantsByType(t *testing.T) {
 	type konst struct {
 		name string
@@ -18,3 +18,17 @@ func IsFoo(v string) bool {
 func isBar(v string, a1, a2 string) bool {
 	return false
 }
+
+type Repo struct{}
+
+// valid:rule.yaml
+//	name: unique_name
+func (*Repo) IsUniqueName(v string) bool {
+	return false
+}
+
+type RepoIface interface {
+	// valid:rule.yaml
+	//	name: unique_email
+	IsUniqueEmail(v string) bool
+}
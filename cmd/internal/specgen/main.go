@@ -0,0 +1,256 @@
+// Command specgen generates the tables of the rule specs for the
+// github.com/frk/valid/dynamic package. The specs are taken from the
+// same source that is used by the cmd/validgen tool, i.e. the specs of
+// the builtin and standard library rules are those declared in the rules
+// package and the specs of the included rules are loaded from the
+// "valid:rule.yaml" directives of the github.com/frk/valid package.
+//
+// To regenerate the tables run `go generate` in the dynamic package's directory.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/frk/valid/cmd/internal/config"
+	"github.com/frk/valid/cmd/internal/rules"
+	"github.com/frk/valid/cmd/internal/search"
+)
+
+func main() {
+	out := flag.String("o", "spectab.go", "the output file")
+	flag.Parse()
+
+	src, err := generate()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "specgen: %v\n", err)
+		os.Exit(2)
+	}
+	if err := os.WriteFile(*out, src, 0644); err != nil {
+		fmt.Fprintf(os.Stderr, "specgen: %v\n", err)
+		os.Exit(2)
+	}
+}
+
+// generate loads the rules' specs and returns the
+// formatted source of the dynamic package's tables.
+func generate() ([]byte, error) {
+	var a search.AST
+	if err := rules.InitSpecs(config.Config{}, &a); err != nil {
+		return nil, err
+	}
+
+	builtin := rules.BuiltinSpecs()
+	keys := make([]string, 0, len(builtin))
+	for k := range builtin {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	included := rules.IncludedSpecs()
+
+	// collect the imports of the rules' functions
+	imports := map[string]bool{"github.com/frk/valid/internal/tag": true}
+	for _, s := range builtin {
+		if hasFunc(s) {
+			imports[s.FType.Pkg.Path] = true
+		}
+	}
+	for _, s := range included {
+		if hasFunc(s) {
+			imports[s.FType.Pkg.Path] = true
+		}
+	}
+
+	var b bytes.Buffer
+	b.WriteString(`// DO NOT EDIT. This file was generated by "github.com/frk/valid/cmd/internal/specgen".
+
+package dynamic
+
+`)
+	writeImports(&b, imports)
+
+	b.WriteString(`
+// The specs of the rules that are implemented with the Go language's
+// primitive operators, builtin functions, and with the standard library.
+var _builtin = map[string]*spec{
+`)
+	for _, k := range keys {
+		writeSpec(&b, k, builtin[k])
+	}
+	b.WriteString("}\n")
+
+	b.WriteString(`
+// The specs of the rules that are implemented by the github.com/frk/valid package.
+var _included = map[string]*spec{
+`)
+	for _, s := range included {
+		writeSpec(&b, s.Name, s)
+	}
+	b.WriteString("}\n")
+
+	return format.Source(b.Bytes())
+}
+
+// writeImports writes the import declaration of the given packages,
+// the standard library packages are grouped before the other packages.
+func writeImports(b *bytes.Buffer, imports map[string]bool) {
+	var std, other []string
+	for path := range imports {
+		if strings.Contains(path, ".") {
+			other = append(other, path)
+		} else {
+			std = append(std, path)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(other)
+
+	b.WriteString("import (\n")
+	for _, path := range std {
+		fmt.Fprintf(b, "%q\n", path)
+	}
+	if len(std) > 0 && len(other) > 0 {
+		b.WriteString("\n")
+	}
+	for _, path := range other {
+		fmt.Fprintf(b, "%q\n", path)
+	}
+	b.WriteString(")\n")
+}
+
+// writeSpec writes the map entry of the given spec.
+func writeSpec(b *bytes.Buffer, key string, s *rules.Spec) {
+	fmt.Fprintf(b, "%q: {\n", key)
+	fmt.Fprintf(b, "name: %q,\n", s.Name)
+	fmt.Fprintf(b, "kind: %s,\n", _kinds[s.Kind])
+	if hasFunc(s) {
+		fmt.Fprintf(b, "fn: %s.%s,\n", s.FType.Pkg.Name, s.FName)
+	}
+	fmt.Fprintf(b, "argMin: %d,\n", s.ArgMin)
+	fmt.Fprintf(b, "argMax: %d,\n", s.ArgMax)
+	if len(s.ArgOpts) > 0 {
+		b.WriteString("argOpts: []map[string]tag.Arg{")
+		for i, opts := range s.ArgOpts {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			writeArgOpts(b, opts)
+		}
+		b.WriteString("},\n")
+	}
+	if s.JoinOp > 0 {
+		fmt.Fprintf(b, "joinOp: %s,\n", _joinOps[s.JoinOp])
+	}
+	if s.Err != (rules.ErrSpec{}) {
+		b.WriteString("err: errSpec")
+		writeErrSpec(b, s.Err)
+		b.WriteString(",\n")
+	}
+	if len(s.ErrOpts) > 0 {
+		keys := make([]string, 0, len(s.ErrOpts))
+		for k := range s.ErrOpts {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		b.WriteString("errOpts: map[string]errSpec{\n")
+		for _, k := range keys {
+			fmt.Fprintf(b, "%q: ", k)
+			writeErrSpec(b, s.ErrOpts[k])
+			b.WriteString(",\n")
+		}
+		b.WriteString("},\n")
+	}
+	b.WriteString("},\n")
+}
+
+// hasFunc reports whether or not the spec's rule is implemented by
+// a package-level function that the dynamic package should call.
+func hasFunc(s *rules.Spec) bool {
+	return (s.Kind == rules.FUNCTION || s.Kind == rules.PREPROC) &&
+		s.FType != nil && len(s.FType.Pkg.Path) > 0
+}
+
+// writeArgOpts writes the map literal of the given arg options.
+// The keys are sorted to make the output deterministic.
+func writeArgOpts(b *bytes.Buffer, opts map[string]rules.Arg) {
+	keys := make([]string, 0, len(opts))
+	for k := range opts {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	b.WriteString("{")
+	for i, k := range keys {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		a := opts[k]
+		fmt.Fprintf(b, "%q: {Type: tag.%s, Value: %q}", k, _argTypes[a.Type], a.Value)
+	}
+	b.WriteString("}")
+}
+
+// writeErrSpec writes the struct literal of the given error spec
+// without the type, which, if necessary, is written by the caller.
+func writeErrSpec(b *bytes.Buffer, e rules.ErrSpec) {
+	b.WriteString("{text: " + strconv.Quote(e.Text))
+	if e.WithArgs {
+		b.WriteString(", withArgs: true")
+	}
+	if e.WithFieldArgs {
+		b.WriteString(", withFieldArgs: true")
+	}
+	if len(e.ArgSep) > 0 {
+		b.WriteString(", argSep: " + strconv.Quote(e.ArgSep))
+	}
+	if len(e.ArgSuffix) > 0 {
+		b.WriteString(", argSuffix: " + strconv.Quote(e.ArgSuffix))
+	}
+	b.WriteString("}")
+}
+
+// The identifiers of the dynamic package's spec kinds.
+var _kinds = map[rules.SpecKind]string{
+	rules.REQUIRED:     "kindRequired",
+	rules.CONDREQUIRED: "kindCondRequired",
+	rules.COMPARABLE:   "kindComparable",
+	rules.ORDERED:      "kindOrdered",
+	rules.LENGTH:       "kindLength",
+	rules.RANGE:        "kindRange",
+	rules.ENUM:         "kindEnum",
+	rules.TEMPORAL:     "kindTemporal",
+	rules.UNIQUE:       "kindUnique",
+	rules.FUNCTION:     "kindFunction",
+	rules.METHOD:       "kindMethod",
+	rules.ALTERNATION:  "kindAlternation",
+	rules.OPTIONAL:     "kindOptional",
+	rules.NOGUARD:      "kindNoGuard",
+	rules.REMOVE:       "kindRemove",
+	rules.PREPROC:      "kindPreproc",
+}
+
+// The identifiers of the dynamic package's join operators.
+var _joinOps = map[rules.JoinOp]string{
+	rules.JOIN_NOT: "joinNot",
+	rules.JOIN_AND: "joinAnd",
+	rules.JOIN_OR:  "joinOr",
+}
+
+// The identifiers of the internal/tag package's arg types.
+var _argTypes = map[rules.ArgType]string{
+	rules.ARG_UNKNOWN:   "ARG_UNKNOWN",
+	rules.ARG_BOOL:      "ARG_BOOL",
+	rules.ARG_INT:       "ARG_INT",
+	rules.ARG_FLOAT:     "ARG_FLOAT",
+	rules.ARG_STRING:    "ARG_STRING",
+	rules.ARG_FIELD_ABS: "ARG_FIELD_ABS",
+	rules.ARG_FIELD_REL: "ARG_FIELD_REL",
+}
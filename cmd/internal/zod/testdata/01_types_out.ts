@@ -0,0 +1,25 @@
+// DO NOT EDIT. This file was generated by "github.com/frk/valid".
+
+import { z } from "zod";
+
+export const T01Validator = z.object({
+  name: z.string().default(""),
+  age: z.number().int().default(0),
+  score: z.number().default(0),
+  active: z.boolean().default(false),
+  nickname: z.string().nullish(),
+  tags: z.array(z.string()).default([]),
+  point: z.array(z.number()).length(2),
+  labels: z.record(z.string(), z.string()).default({}),
+  data: z.string().default(""),
+  created_at: z.string().datetime({ offset: true }),
+  extra: z.any(),
+  address: z.object({
+    street: z.string().default(""),
+    city: z.string().default(""),
+  }).default({}),
+  NoTag: z.string().default(""),
+  note: z.string().default(""),
+});
+
+export type T01Validator = z.infer<typeof T01Validator>;
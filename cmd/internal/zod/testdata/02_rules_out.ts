@@ -0,0 +1,81 @@
+// DO NOT EDIT. This file was generated by "github.com/frk/valid".
+
+import { z } from "zod";
+import validator from "validator";
+
+const runeCount = (v: string): number => [...v].length;
+const utf8Length = (v: string): number => new TextEncoder().encode(v).length;
+
+export const T02Validator = z.object({
+  name: z.string()
+    .refine((v) => v !== "", { message: "name is required" })
+    .refine((v) => utf8Length(v) >= 1 && utf8Length(v) <= 64, { message: "name must be of length between: 1 and 64 (inclusive)" }).default(""),
+  code: z.string()
+    .refine((v) => utf8Length(v) === 6, { message: "code must be of length: 6" }).default(""),
+  title: z.string()
+    .refine((v) => runeCount(v) <= 100, { message: "title must have rune count at most: 100" }).default(""),
+  age: z.number().int()
+    .refine((v) => v >= 18, { message: "age must be greater than or equal to: 18" })
+    .refine((v) => v <= 130, { message: "age must be less than or equal to: 130" }).default(0),
+  level: z.number().int()
+    .refine((v) => v >= 1 && v <= 10, { message: "level must be between: 1 and 10" }).default(0),
+  ratio: z.number()
+    .refine((v) => v > 0, { message: "ratio must be greater than: 0" })
+    .refine((v) => v < 1, { message: "ratio must be less than: 1" }).default(0),
+  kind: z.string()
+    .refine((v) => v === "a" || v === "b" || v === "c", { message: "kind must be equal to: \"a\" or \"b\" or \"c\"" }).default(""),
+  mode: z.string()
+    .refine((v) => v !== "off", { message: "mode must not be equal to: \"off\"" }).default(""),
+  status: z.string()
+    .refine((v) => ["active", "inactive"].includes(v), { message: "status must be one of" }).default(""),
+  email: z.string()
+    .refine((v) => v !== "", { message: "email is required" })
+    .refine((v) => validator.isEmail(v), { message: "email must be a valid email address" }),
+  id: z.string()
+    .refine((v) => validator.isUUID(v, "4"), { message: "id must be a valid UUID" }).default(""),
+  addr: z.string()
+    .refine((v) => validator.isIP(v, 4), { message: "addr must be a valid IP" }).default(""),
+  host: z.string()
+    .refine((v) => validator.isIP(v), { message: "host must be a valid IP" }).default(""),
+  site: z.string()
+    .refine((v) => validator.isURL(v), { message: "site must be a valid URL" }).default(""),
+  slug: z.string()
+    .refine((v) => new RegExp("^[a-z0-9-]+$").test(v), { message: "slug must match the regular expression: \"^[a-z0-9-]+$\"" }).default(""),
+  path: z.string()
+    .refine((v) => v.startsWith("/api/") || v.startsWith("/v1/"), { message: "path must be prefixed with: \"/api/\" or \"/v1/\"" }).default(""),
+  file: z.string()
+    .refine((v) => v.endsWith(".go"), { message: "file must be suffixed with: \".go\"" }).default(""),
+  tags: z.array(z.string()
+    .refine((v) => utf8Length(v) >= 1 && utf8Length(v) <= 32, { message: "tags must be of length between: 1 and 32 (inclusive)" }))
+    .refine((v) => new Set(v).size === v.length, { message: "tags must contain unique elements" })
+    .refine((v) => v.length >= 1, { message: "tags must be of length at least: 1" }).default([]),
+  labels: z.record(z.string()
+    .refine((v) => utf8Length(v) >= 1 && utf8Length(v) <= 32, { message: "labels must be of length between: 1 and 32 (inclusive)" }), z.string())
+    .refine((v) => Object.keys(v).length <= 10, { message: "labels must be of length at most: 10" }).default({}),
+  color: z.string()
+    .refine((v) => !(v === "red"), { message: "color must not be equal to: \"red\"" }).default(""),
+  contact: z.string()
+    .refine((v) => (validator.isEmail(v)) || (validator.isFQDN(v)), { message: "contact must be a valid email address or must be a valid FQDN" }).default(""),
+  // unsupported: phone:us
+  phone: z.string().default(""),
+  password: z.string()
+    .refine((v) => v !== "", { message: "password is required" }).default(""),
+  // unsupported: eq:&password
+  confirm: z.string().default(""),
+  limit: z.number().int().default(25),
+  hash: z.string()
+    .refine((v) => validator.isHash(v, "sha256"), { message: "hash must be a valid hash" }).default(""),
+  zip: z.string()
+    .refine((v) => validator.isPostalCode(v, "DE"), { message: "zip must be a valid zip code" }).default(""),
+  text: z.string()
+    .refine((v) => !(v.includes("foo")), { message: "text must not contain substring: \"foo\"" }).default(""),
+  nick: z.string()
+    .refine((v) => utf8Length(v) >= 2 && utf8Length(v) <= 20, { message: "nick must be of length between: 2 and 20 (inclusive)" }).nullish(),
+  backup: z.string(),
+  since: z.string().datetime({ offset: true })
+    .refine((v) => Date.parse(v) < Date.now(), { message: "since must be in the past" }),
+  wait: z.number().int()
+    .refine((v) => v <= 60000000000, { message: "wait must be less than or equal to: 1m" }).default(0),
+});
+
+export type T02Validator = z.infer<typeof T02Validator>;
@@ -0,0 +1,136 @@
+package zod
+
+import (
+	"strings"
+
+	"github.com/frk/valid/cmd/internal/rules"
+)
+
+// The validator.js functions of the included rules that
+// take no arguments, or whose arguments can be ignored.
+var validatorjsFuncs = map[string]string{
+	"ascii":     "isAscii",
+	"base32":    "isBase32",
+	"base58":    "isBase58",
+	"bic":       "isBIC",
+	"bool":      "isBoolean",
+	"btc":       "isBtcAddress",
+	"cidr":      "isIPRange",
+	"datauri":   "isDataURI",
+	"ean":       "isEAN",
+	"email":     "isEmail",
+	"eth":       "isEthereumAddress",
+	"float":     "isFloat",
+	"fqdn":      "isFQDN",
+	"hex":       "isHexadecimal",
+	"hexcolor":  "isHexColor",
+	"hsl":       "isHSL",
+	"iban":      "isIBAN",
+	"imei":      "isIMEI",
+	"int":       "isInt",
+	"iprange":   "isIPRange",
+	"isin":      "isISIN",
+	"iso4217":   "isISO4217",
+	"isrc":      "isISRC",
+	"json":      "isJSON",
+	"jwt":       "isJWT",
+	"locale":    "isLocale",
+	"lower":     "isLowercase",
+	"magneturi": "isMagnetURI",
+	"md5":       "isMD5",
+	"mime":      "isMimeType",
+	"mongoid":   "isMongoId",
+	"numeric":   "isNumeric",
+	"octal":     "isOctal",
+	"port":      "isPort",
+	"rfc3339":   "isRFC3339",
+	"rgb":       "isRgbColor",
+	"semver":    "isSemVer",
+	"slug":      "isSlug",
+	"upper":     "isUppercase",
+}
+
+// validatorjsCall returns the validator.js call expression that checks
+// the included rule r. The result will be false if the rule, or the
+// rule's arguments, have no counterpart in validator.js.
+func validatorjsCall(r *rules.Rule) (string, bool) {
+	// the value of the rule's first argument, if any
+	var arg string
+	if len(r.Args) > 0 {
+		arg = r.Args[0].Value
+	}
+
+	var fn string
+	var args []string
+	switch r.Name {
+	case "alpha", "alnum", "decimal":
+		// the locales of the Go implementation
+		// do not map onto those of validator.js
+		if arg != "en" {
+			return "", false
+		}
+		fn = map[string]string{"alpha": "isAlpha", "alnum": "isAlphanumeric", "decimal": "isDecimal"}[r.Name]
+	case "base64":
+		fn = "isBase64"
+		if arg == "true" {
+			args = append(args, "{ urlSafe: true }")
+		}
+	case "date":
+		// only the default layout is supported
+		if arg != "2006-01-02" {
+			return "", false
+		}
+		fn = "isDate"
+		args = append(args, `{ format: "YYYY-MM-DD", strictMode: true, delimiters: ["-"] }`)
+	case "hash":
+		fn = "isHash"
+		args = append(args, jsString(arg))
+	case "ip":
+		fn = "isIP"
+		if arg != "0" {
+			args = append(args, arg)
+		}
+	case "isbn":
+		fn = "isISBN"
+		if arg != "0" {
+			args = append(args, arg)
+		}
+	case "iso8601":
+		fn = "isISO8601"
+		if arg == "true" {
+			args = append(args, "{ strict: true }")
+		}
+	case "latlong":
+		if arg == "true" {
+			return "", false
+		}
+		fn = "isLatLong"
+	case "mac":
+		fn = "isMACAddress"
+		switch arg {
+		case "6":
+			args = append(args, `{ eui: "48" }`)
+		case "8":
+			args = append(args, `{ eui: "64" }`)
+		}
+	case "passport", "vat", "zip":
+		fn = map[string]string{"passport": "isPassportNumber", "vat": "isVAT", "zip": "isPostalCode"}[r.Name]
+		args = append(args, jsString(strings.ToUpper(arg)))
+	case "strongpass", "url":
+		// only the default options are supported
+		if arg != "" {
+			return "", false
+		}
+		fn = map[string]string{"strongpass": "isStrongPassword", "url": "isURL"}[r.Name]
+	case "uuid":
+		fn = "isUUID"
+		args = append(args, jsString(arg))
+	default:
+		if fn = validatorjsFuncs[r.Name]; len(fn) == 0 {
+			return "", false
+		}
+	}
+
+	args = append([]string{"v"}, args...)
+	return "validator." + fn + "(" + strings.Join(args, ", ") + ")", true
+}
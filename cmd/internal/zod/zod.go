@@ -0,0 +1,658 @@
+// Package zod implements the conversion of rule-checked validator types
+// into TypeScript modules that declare the equivalent Zod schemas.
+package zod
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/frk/tagutil"
+	"github.com/frk/valid/cmd/internal/gotype"
+	"github.com/frk/valid/cmd/internal/rules"
+)
+
+// The comment at the top of the generated files.
+const preamble = `// DO NOT EDIT. This file was generated by "github.com/frk/valid".`
+
+// The declarations of the helper functions used by the generated schemas.
+var helpers = map[string]string{
+	"utf8Length": "const utf8Length = (v: string): number => new TextEncoder().encode(v).length;",
+	"runeCount":  "const runeCount = (v: string): number => [...v].length;",
+}
+
+// Generate returns the TypeScript source of a module that declares, and
+// exports, a Zod schema, and the schema's inferred type, for each of the
+// validator types of the given infos.
+//
+// The schemas check the same rules as the generated Go code, and report
+// the same error messages. The included rules that have a counterpart
+// in the validator.js library are checked using that counterpart, the
+// rules that have no TypeScript equivalent, e.g. rules that reference
+// other fields, or custom rules, are listed in a comment instead.
+func Generate(infos []*rules.Info) ([]byte, error) {
+	g := &gen{helpers: make(map[string]bool)}
+
+	var decls bytes.Buffer
+	for _, info := range infos {
+		b := &builder{g: g, info: info}
+		name := info.Validator.Type.Name
+
+		decls.WriteString("\n")
+		decls.WriteString("export const " + name + " = " + b.schema(info.RootNode, "", "") + ";\n")
+		decls.WriteString("\n")
+		decls.WriteString("export type " + name + " = z.infer<typeof " + name + ">;\n")
+	}
+
+	var out bytes.Buffer
+	out.WriteString(preamble + "\n")
+	out.WriteString("\n")
+	out.WriteString("import { z } from \"zod\";\n")
+	if g.validatorjs {
+		out.WriteString("import validator from \"validator\";\n")
+	}
+	if len(g.helpers) > 0 {
+		names := make([]string, 0, len(g.helpers))
+		for name := range g.helpers {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		out.WriteString("\n")
+		for _, name := range names {
+			out.WriteString(helpers[name] + "\n")
+		}
+	}
+	out.Write(decls.Bytes())
+	return out.Bytes(), nil
+}
+
+// gen maintains the state of the module being generated.
+type gen struct {
+	// set of the helper functions used by the schemas
+	helpers map[string]bool
+	// indicates that the schemas use the validator.js library
+	validatorjs bool
+}
+
+// builder builds the schema of a single validator type.
+type builder struct {
+	g    *gen
+	info *rules.Info
+	// the rules, of the field being built, that have
+	// no equivalent in TypeScript; they are listed in
+	// a comment above the field's property
+	unsupported []string
+}
+
+// schema returns the Zod schema expression of the node n. The key is
+// the field key that is used in the error messages of the node's rules,
+// and the indent is the indentation of the line on which the schema's
+// expression begins.
+func (b *builder) schema(n *rules.Node, key, indent string) string {
+	// the conditional rules depend on other fields
+	for _, r := range n.CondRules {
+		b.unsupported = append(b.unsupported, r.String())
+	}
+
+	if n.IsPtr() {
+		// The "required" and "notnil" rules are applied to
+		// every pointer in the chain, and to the base, if the
+		// base's type supports them, so the base's schema
+		// takes care of checking the pointed-to value.
+		x := b.schema(n.Base(), key, indent)
+		if !n.IsRequired() {
+			x += ".nullish()"
+		}
+		return x
+	}
+
+	x := b.typeExpr(n, key, indent)
+
+	for _, r := range n.IsRules {
+		switch r.Spec.Kind {
+		case rules.OPTIONAL, rules.NOGUARD, rules.REMOVE:
+			continue
+		case rules.REQUIRED:
+			if r.Name == "required" {
+				x += b.required(n, r, key, indent)
+			}
+			continue
+		}
+
+		cond, ok := b.cond(n, r)
+		if !ok {
+			b.unsupported = append(b.unsupported, strings.TrimRight(r.String(), ":"))
+			continue
+		}
+		x += b.refine(cond, b.message(n, r, key), indent)
+	}
+	return x
+}
+
+// typeExpr returns the Zod schema expression of the type of the node n.
+func (b *builder) typeExpr(n *rules.Node, key, indent string) string {
+	switch t := n.Type; {
+	case t.IsTime():
+		// encoding/json uses the RFC 3339 format
+		return "z.string().datetime({ offset: true })"
+	case isBytes(t):
+		// encoding/json uses base64 for byte slices
+		return "z.string()"
+	case t.Kind == gotype.K_BOOL:
+		return "z.boolean()"
+	case t.Kind.IsInteger():
+		return "z.number().int()"
+	case t.Kind.IsFloat():
+		return "z.number()"
+	case t.Kind == gotype.K_STRING:
+		return "z.string()"
+	case t.Kind == gotype.K_ARRAY:
+		x := "z.array(" + b.schema(n.Elem, key, indent) + ")"
+		return x + ".length(" + strconv.FormatInt(t.ArrayLen, 10) + ")"
+	case t.Kind == gotype.K_SLICE:
+		return "z.array(" + b.schema(n.Elem, key, indent) + ")"
+	case t.Kind == gotype.K_MAP:
+		k := "z.string()"
+		if n.Key.Type.Kind == gotype.K_STRING {
+			k = b.schema(n.Key, key, indent)
+		}
+		return "z.record(" + k + ", " + b.schema(n.Elem, key, indent) + ")"
+	case t.Kind == gotype.K_STRUCT:
+		return b.object(n, indent)
+	}
+	return "z.any()"
+}
+
+// object returns the Zod object schema expression of the struct node n.
+func (b *builder) object(n *rules.Node, indent string) string {
+	var buf strings.Builder
+	buf.WriteString("z.object({\n")
+	b.fields(n, indent+"  ", &buf)
+	buf.WriteString(indent + "})")
+	return buf.String()
+}
+
+// fields writes the properties of the struct node n to buf. Embedded
+// structs without a key of their own have their fields written directly
+// to buf, the same way encoding/json handles them.
+func (b *builder) fields(n *rules.Node, indent string, buf *strings.Builder) {
+	outer := b.unsupported
+	defer func() { b.unsupported = outer }()
+
+	for _, f := range n.Fields {
+		if h := b.info.Validator.ErrorHandlerField; h != nil && n == b.info.RootNode && h.Name == f.Field.Name {
+			continue
+		}
+
+		name, tagged := f.Field.Name, false
+		if len(b.info.KeyTag) > 0 {
+			if v := tagutil.New(f.Field.Tag).First(b.info.KeyTag); v == "-" {
+				continue
+			} else if len(v) > 0 {
+				name, tagged = v, true
+			}
+		}
+		if f.Field.IsEmbedded && !tagged && f.Type.Base().IsStruct() {
+			b.fields(f.Type.Base(), indent, buf)
+			continue
+		}
+		if !f.Field.IsExported {
+			continue
+		}
+
+		b.unsupported = nil
+		x := b.schema(f.Type, f.Key, indent)
+		if v, ok := b.defaultValue(f.Type); ok {
+			x += ".default(" + v + ")"
+		}
+		if len(b.unsupported) > 0 {
+			buf.WriteString(indent + "// unsupported: " + strings.Join(b.unsupported, ",") + "\n")
+		}
+		buf.WriteString(indent + propName(name) + ": " + x + ",\n")
+	}
+}
+
+// defaultValue returns the value that the Go decoder would produce for
+// the node n if the field were absent from the input, i.e. the value of
+// the field's "default" tag, if any, otherwise the zero value of the type.
+// Pointers and types whose zero value is not valid JSON have no default.
+func (b *builder) defaultValue(n *rules.Node) (string, bool) {
+	if n.Default != nil {
+		return b.literal(n.Base(), n.Default)
+	}
+	switch t := n.Type; {
+	case t.IsTime(), t.Kind == gotype.K_PTR, t.Kind == gotype.K_INTERFACE:
+		return "", false
+	case isBytes(t):
+		return `""`, true
+	case t.Kind == gotype.K_BOOL:
+		return "false", true
+	case t.Kind.IsNumeric():
+		return "0", true
+	case t.Kind == gotype.K_STRING:
+		return `""`, true
+	case t.Kind == gotype.K_SLICE:
+		return "[]", true
+	case t.Kind == gotype.K_MAP, t.Kind == gotype.K_STRUCT:
+		return "{}", true
+	}
+	return "", false
+}
+
+// required returns the refinement that checks that the value of the node
+// n is not the zero value of its type, like the "required" rule does.
+func (b *builder) required(n *rules.Node, r *rules.Rule, key, indent string) string {
+	var cond string
+	switch t := n.Type; {
+	case t.Kind == gotype.K_BOOL:
+		cond = "v"
+	case t.Kind.IsNumeric():
+		cond = "v !== 0"
+	case t.Kind == gotype.K_STRING, t.IsTime():
+		cond = `v !== ""`
+	case t.Is(gotype.K_ARRAY, gotype.K_SLICE):
+		cond = "v.length > 0"
+	case t.Kind == gotype.K_MAP:
+		cond = "Object.keys(v).length > 0"
+	default:
+		return ""
+	}
+	return b.refine(cond, b.message(n, r, key), indent)
+}
+
+// refine returns a refinement of a schema with the given condition, which
+// must hold for valid values, and the message reported for invalid values.
+func (b *builder) refine(cond, message, indent string) string {
+	return "\n" + indent + "  .refine((v) => " + cond + ", { message: " + jsString(message) + " })"
+}
+
+// cond returns the TypeScript expression that holds for the values of the
+// node n that pass the rule r. The result will be false if the rule has
+// no equivalent in TypeScript.
+func (b *builder) cond(n *rules.Node, r *rules.Rule) (string, bool) {
+	if r.Negated {
+		rr := *r
+		rr.Negated = false
+
+		x, ok := b.cond(n, &rr)
+		return "!(" + x + ")", ok
+	}
+
+	switch r.Spec.Kind {
+	case rules.ALTERNATION:
+		alts := make([]string, len(r.Alt))
+		for i, a := range r.Alt {
+			x, ok := b.cond(n, a)
+			if !ok {
+				return "", false
+			}
+			alts[i] = "(" + x + ")"
+		}
+		return strings.Join(alts, " || "), true
+	case rules.COMPARABLE:
+		op, sep := " === ", " || "
+		if r.Name == "ne" {
+			op, sep = " !== ", " && "
+		}
+
+		list := make([]string, len(r.Args))
+		for i, a := range r.Args {
+			lit, ok := b.literal(n, a)
+			if !ok {
+				return "", false
+			}
+			list[i] = "v" + op + lit
+		}
+		return strings.Join(list, sep), true
+	case rules.ORDERED:
+		lit, ok := b.literal(n, r.Args[0])
+		if !ok || n.Type.Kind == gotype.K_BOOL {
+			return "", false
+		}
+		return "v " + orderedOps[r.Name] + " " + lit, true
+	case rules.RANGE:
+		lo, ok1 := b.literal(n, r.Args[0])
+		hi, ok2 := b.literal(n, r.Args[1])
+		if !ok1 || !ok2 {
+			return "", false
+		}
+		return "v >= " + lo + " && v <= " + hi, true
+	case rules.LENGTH:
+		return b.lengthCond(n, r)
+	case rules.ENUM:
+		consts := b.info.EnumMap[n.Type]
+		if len(consts) == 0 {
+			return "", false
+		}
+		list := make([]string, len(consts))
+		for i, c := range consts {
+			lit, ok := constLiteral(c)
+			if !ok {
+				return "", false
+			}
+			list[i] = lit
+		}
+		return "[" + strings.Join(list, ", ") + "].includes(v)", true
+	case rules.TEMPORAL:
+		return temporalCond(r)
+	case rules.UNIQUE:
+		return b.uniqueCond(n, r)
+	case rules.FUNCTION:
+		if isBytes(n.Type) {
+			// the value is base64 encoded
+			return "", false
+		}
+		return b.funcCond(r)
+	}
+	return "", false
+}
+
+// The TypeScript operators of the ORDERED rules.
+var orderedOps = map[string]string{
+	"gt":  ">",
+	"lt":  "<",
+	"gte": ">=",
+	"lte": "<=",
+	"min": ">=",
+	"max": "<=",
+}
+
+// lengthCond returns the condition of the "len" and "runecount" rules. The
+// length of a string, as counted by the "len" rule, is its length in bytes.
+func (b *builder) lengthCond(n *rules.Node, r *rules.Rule) (string, bool) {
+	var x string
+	switch t := n.Type; {
+	case t.Kind == gotype.K_STRING && r.Name == "runecount":
+		b.g.helpers["runeCount"] = true
+		x = "runeCount(v)"
+	case t.Kind == gotype.K_STRING:
+		b.g.helpers["utf8Length"] = true
+		x = "utf8Length(v)"
+	case isBytes(t):
+		// the value is base64 encoded
+		return "", false
+	case t.Is(gotype.K_ARRAY, gotype.K_SLICE):
+		x = "v.length"
+	case t.Kind == gotype.K_MAP:
+		x = "Object.keys(v).length"
+	default:
+		return "", false
+	}
+
+	if len(r.Args) == 1 {
+		return x + " === " + r.Args[0].Value, true
+	}
+
+	var list []string
+	if a := r.Args[0]; !a.IsEmpty() {
+		list = append(list, x+" >= "+a.Value)
+	}
+	if a := r.Args[1]; !a.IsEmpty() {
+		list = append(list, x+" <= "+a.Value)
+	}
+	return strings.Join(list, " && "), true
+}
+
+// temporalCond returns the condition of the TEMPORAL rules. The
+// time values are encoded as strings in the RFC 3339 format.
+func temporalCond(r *rules.Rule) (string, bool) {
+	if r.Name == "within" {
+		d, ok := rules.ParseDurationArg(r.Args[0])
+		if !ok {
+			return "", false
+		}
+		ms := strconv.FormatInt(d.Milliseconds(), 10)
+		return "Math.abs(Date.now() - Date.parse(v)) <= " + ms, true
+	}
+
+	var t string
+	switch r.Name {
+	case "before", "after":
+		tt, ok := rules.ParseTimeArg(r.Args[0])
+		if !ok {
+			return "", false
+		}
+		t = "Date.parse(" + jsString(tt.UTC().Format("2006-01-02T15:04:05.999999999Z07:00")) + ")"
+	case "future", "past":
+		t = "Date.now()"
+	}
+
+	switch r.Name {
+	case "before", "past":
+		return "Date.parse(v) < " + t, true
+	case "after", "future":
+		return "Date.parse(v) > " + t, true
+	}
+	return "", false
+}
+
+// uniqueCond returns the condition of the "unique" rule. Elements
+// are compared by value, and so only elements of basic types, or
+// struct elements compared by a basic field, are supported.
+func (b *builder) uniqueCond(n *rules.Node, r *rules.Rule) (string, bool) {
+	t := n.Type
+	elem := t.Elem
+	for elem.Kind == gotype.K_PTR {
+		elem = elem.Elem
+	}
+
+	var vals, size string
+	switch {
+	case t.Is(gotype.K_ARRAY, gotype.K_SLICE):
+		vals, size = "v", "v.length"
+	case t.Kind == gotype.K_MAP:
+		vals, size = "Object.values(v)", "Object.keys(v).length"
+	default:
+		return "", false
+	}
+
+	if len(r.Args) > 0 {
+		f := rules.UniqueField(elem, r.Args[0].Value)
+		if f == nil || !f.Type.Kind.IsBasic() {
+			return "", false
+		}
+		name := f.Name
+		if len(b.info.KeyTag) > 0 {
+			if v := tagutil.New(f.Tag).First(b.info.KeyTag); len(v) > 0 && v != "-" {
+				name = v
+			}
+		}
+		vals += ".map((e) => e" + propAccess(name) + ")"
+	} else if !elem.Kind.IsBasic() {
+		return "", false
+	}
+	return "new Set(" + vals + ").size === " + size, true
+}
+
+// funcCond returns the condition of the function rule r. The included
+// rules that have a validator.js counterpart, the "re" rule, and the
+// "prefix", "suffix", and "contains" rules are supported.
+func (b *builder) funcCond(r *rules.Rule) (string, bool) {
+	for _, a := range r.Args {
+		if a.Type == rules.ARG_FIELD_ABS || a.Type == rules.ARG_FIELD_REL {
+			return "", false
+		}
+	}
+
+	if r.Spec.FType.Pkg.Path == "strings" {
+		var meth string
+		switch r.Spec.FName {
+		case "HasPrefix":
+			meth = "startsWith"
+		case "HasSuffix":
+			meth = "endsWith"
+		case "Contains":
+			meth = "includes"
+		default:
+			return "", false
+		}
+
+		list := make([]string, len(r.Args))
+		for i, a := range r.Args {
+			list[i] = "v." + meth + "(" + jsString(a.Value) + ")"
+		}
+		return strings.Join(list, " || "), true
+	}
+
+	if !r.Spec.FType.IsIncluded() {
+		return "", false
+	}
+	if r.Name == "re" {
+		return "new RegExp(" + jsString(r.Args[0].Value) + ").test(v)", true
+	}
+
+	call, ok := validatorjsCall(r)
+	if ok {
+		b.g.validatorjs = true
+	}
+	return call, ok
+}
+
+// message returns the error message of the rule r. The message is
+// built from the rule's ErrSpec in the same way as the messages of
+// the generated Go code, except that the arguments which reference
+// other fields are represented by the fields' keys.
+func (b *builder) message(n *rules.Node, r *rules.Rule, key string) string {
+	text := b.ruleText(n, r)
+	if len(key) > 0 {
+		return key + " " + text
+	}
+	return text
+}
+
+// ruleText returns the error message text of the rule r without the key.
+func (b *builder) ruleText(n *rules.Node, r *rules.Rule) string {
+	if r.Spec.Kind == rules.ALTERNATION {
+		texts := make([]string, len(r.Alt))
+		for i, a := range r.Alt {
+			texts[i] = b.ruleText(n, a)
+		}
+		return strings.Join(texts, " or ")
+	}
+
+	cfg := r.ErrSpec()
+	text := cfg.Text
+	if len(text) == 0 {
+		text = "is not valid"
+	}
+
+	if cfg.WithArgs || cfg.WithFieldArgs {
+		var args []string
+		for _, a := range r.Args {
+			isField := a.Type == rules.ARG_FIELD_ABS || a.Type == rules.ARG_FIELD_REL
+			if !cfg.WithArgs && !isField {
+				continue
+			}
+			if a.Type == rules.ARG_UNKNOWN && n.Type.Kind.IsNumeric() {
+				a = &rules.Arg{Type: rules.ARG_INT, Value: "0"}
+			}
+			if a.Value == "" {
+				continue
+			}
+
+			switch {
+			case isField:
+				args = append(args, a.Value)
+			case a.Type == rules.ARG_STRING && r.Spec.Kind != rules.TEMPORAL && !n.Type.IsDuration():
+				args = append(args, strconv.Quote(a.Value))
+			default:
+				args = append(args, a.Value)
+			}
+		}
+		if len(args) > 0 {
+			text += ": " + strings.Join(args, cfg.ArgSep)
+			if len(cfg.ArgSuffix) > 0 {
+				text += " " + cfg.ArgSuffix
+			}
+		}
+	}
+
+	if r.Negated {
+		text = rules.NegateErrText(text)
+	}
+	return text
+}
+
+// literal returns the TypeScript literal of the argument a that is applied
+// to the node n. Arguments that reference other fields, and time arguments,
+// have no literal. Duration arguments are converted to nanoseconds.
+func (b *builder) literal(n *rules.Node, a *rules.Arg) (string, bool) {
+	switch a.Type {
+	case rules.ARG_BOOL, rules.ARG_INT, rules.ARG_FLOAT:
+		return a.Value, true
+	case rules.ARG_STRING:
+		if n.Type.IsDuration() {
+			if d, ok := rules.ParseDurationArg(a); ok {
+				return strconv.FormatInt(int64(d), 10), true
+			}
+			return "", false
+		}
+		if n.Type.IsTime() {
+			return "", false
+		}
+		return jsString(a.Value), true
+	case rules.ARG_UNKNOWN:
+		if n.Type.Kind.IsNumeric() {
+			return "0", true
+		}
+		return jsString(a.Value), true
+	}
+	return "", false
+}
+
+// constLiteral returns the TypeScript literal of the given constant.
+func constLiteral(c gotype.Const) (string, bool) {
+	switch v := c.Value; {
+	case strings.HasPrefix(v, `"`) || strings.HasPrefix(v, "`"):
+		s, err := strconv.Unquote(v)
+		return jsString(s), err == nil
+	case len(v) > 0:
+		return v, true
+	}
+	return "", false
+}
+
+// isBytes reports whether or not t is a byte slice, which
+// encoding/json encodes as a base64 encoded string.
+func isBytes(t *gotype.Type) bool {
+	return t.Kind == gotype.K_SLICE && t.Elem.Kind == gotype.K_UINT8
+}
+
+// jsString returns the given string as a double-quoted TypeScript string.
+func jsString(s string) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.Encode(s)
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// propName returns the given name as an object literal's property name.
+func propName(name string) string {
+	if isIdent(name) {
+		return name
+	}
+	return jsString(name)
+}
+
+// propAccess returns the expression that accesses the named property.
+func propAccess(name string) string {
+	if isIdent(name) {
+		return "." + name
+	}
+	return "[" + jsString(name) + "]"
+}
+
+// isIdent reports whether or not the given name is a valid identifier.
+func isIdent(name string) bool {
+	for i, c := range name {
+		if c != '_' && c != '$' && !('a' <= c && c <= 'z') && !('A' <= c && c <= 'Z') &&
+			(i == 0 || !('0' <= c && c <= '9')) {
+			return false
+		}
+	}
+	return len(name) > 0
+}
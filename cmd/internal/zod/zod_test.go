@@ -0,0 +1,94 @@
+package zod
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/frk/compare"
+	"github.com/frk/valid/cmd/internal/config"
+	"github.com/frk/valid/cmd/internal/rules"
+	"github.com/frk/valid/cmd/internal/search"
+)
+
+func TestGenerate(t *testing.T) {
+	tests := []string{
+		"01_types",
+		"02_rules",
+	}
+
+	var AST search.AST
+	pkgs, err := search.Search(
+		"testdata/",
+		false,
+		nil,
+		nil,
+		&AST,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := rules.InitSpecs(config.Config{}, &AST); err != nil {
+		t.Fatal(err)
+	}
+
+	fkCfg := &config.FieldKeyConfig{
+		Tag:       config.String{Value: "json", IsSet: true},
+		Join:      config.Bool{Value: true, IsSet: true},
+		Separator: config.String{Value: ".", IsSet: true},
+	}
+
+	for _, filename := range tests {
+		t.Run(filename, func(t *testing.T) {
+			fileprefix := "testdata/" + filename
+			f, pkg, err := getFile(pkgs, fileprefix+"_in.go")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			infos := make([]*rules.Info, len(f.Matches))
+			for k, match := range f.Matches {
+				info := new(rules.Info)
+				checker := rules.NewChecker(&AST, pkg.Pkg(), fkCfg, info)
+				if err := checker.Check(match); err != nil {
+					t.Fatal(err)
+				}
+				infos[k] = info
+			}
+
+			got, err := Generate(infos)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want, err := os.ReadFile(fileprefix + "_out.ts")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// compare
+			if err := compare.Compare(string(got), string(want)); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+// helper method...
+func getFile(pkgs []*search.Package, filename string) (*search.File, *search.Package, error) {
+	filename, err := filepath.Abs(filename)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, p := range pkgs {
+		for _, f := range p.Files {
+			if f.Path == filename {
+				return f, p, nil
+			}
+		}
+	}
+	return nil, nil, fmt.Errorf("file not found: %q", filename)
+}
@@ -0,0 +1,112 @@
+// Package dynamic implements a reflection-based validator that shares the
+// rule language of the cmd/validgen tool. It is intended for code paths that
+// cannot run `go generate`, e.g. plugins or dynamically loaded configurations.
+//
+// The validator parses the same "is", "pre", and "default" struct tags, it
+// dispatches to the same rule functions, and it produces the same errors as
+// the code generated by the cmd/validgen tool when run with the default
+// configuration, i.e. the field keys are constructed from the "json" tag
+// joined with ".", and the first failed rule is returned as the error. The
+// migration from the dynamic validator to the generated code is therefore
+// a drop-in change:
+//
+//	err := dynamic.Struct(v) // is equivalent to
+//	err := v.Validate()
+//
+// Rules that are restricted to a validation group are ignored. Custom rules,
+// i.e. the rules declared in the cmd/validgen tool's config file, are not
+// supported.
+package dynamic
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// Struct validates the given struct, or pointer to struct, according to
+// the rules declared in the struct type's tags and returns the first
+// encountered validation error, or nil if the struct is valid.
+//
+// Like the generated Validate method, which is declared with a value
+// receiver, Struct validates a shallow copy of the struct. This means
+// that the "pre" and "default" tags update only the copy, and the
+// values that are reachable from the copy through pointers, slices,
+// and maps.
+//
+// If the struct tags cannot be compiled into a validation plan, then
+// Struct will return a *TagError.
+func Struct(v any) error {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return fmt.Errorf("dynamic: Struct(%T): not a struct or a pointer to a struct", v)
+	}
+
+	p, err := planFor(rv.Type())
+	if err != nil {
+		return err
+	}
+
+	cp := reflect.New(rv.Type()).Elem()
+	cp.Set(rv)
+	s := &state{root: cp}
+	return s.node(p.root, cp, "")
+}
+
+// RegisterEnum registers the given values as the set of valid values
+// of type T for the "enum" rule. Since the constants declared with a
+// type cannot be discovered at runtime, a type's values MUST be registered
+// before validating a struct that applies the "enum" rule to that type.
+func RegisterEnum[T comparable](values ...T) {
+	rt := reflect.TypeFor[T]()
+	list := make([]reflect.Value, len(values))
+	for i := range values {
+		list[i] = reflect.ValueOf(values[i])
+	}
+
+	enums.Store(rt, list)
+}
+
+// TagError is returned by Struct when the struct tags of a field
+// cannot be compiled into a validation plan, e.g. because the
+// tags reference an unknown rule.
+type TagError struct {
+	// The struct type that declares the field.
+	Type reflect.Type
+	// The name of the field.
+	Field string
+	// The description of the problem.
+	Err string
+}
+
+// Error implements the error interface.
+func (e *TagError) Error() string {
+	return "dynamic: " + e.Type.String() + "." + e.Field + ": " + e.Err
+}
+
+var (
+	// The compiled plans, keyed by reflect.Type.
+	plans sync.Map
+	// The registered enum values, keyed by reflect.Type.
+	enums sync.Map
+)
+
+// planFor returns the compiled plan of the given struct type. The plans
+// are cached, a failed compilation however is not, so that the plan can
+// be retried once the problem is resolved, e.g. once an enum's values
+// are registered.
+func planFor(t reflect.Type) (*plan, error) {
+	if p, ok := plans.Load(t); ok {
+		return p.(*plan), nil
+	}
+
+	p, err := compile(t)
+	if err != nil {
+		return nil, err
+	}
+	actual, _ := plans.LoadOrStore(t, p)
+	return actual.(*plan), nil
+}
@@ -0,0 +1,327 @@
+package dynamic
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+	"unsafe"
+)
+
+func init() {
+	RegisterEnum(ColorRed, ColorGreen, ColorBlue)
+	RegisterEnum(LevelLow, LevelHigh)
+}
+
+// TestStruct checks that for randomly generated values the dynamic
+// validator returns the same errors as the generated Validate methods.
+func TestStruct(t *testing.T) {
+	validators := []interface{ Validate() error }{
+		BasicValidator{},
+		CompareValidator{},
+		LengthValidator{},
+		OptionalValidator{},
+		MethodValidator{},
+		IncludedValidator{},
+		ReferenceValidator{},
+		NestedValidator{},
+		PreValidator{},
+		DefaultValidator{},
+		CondValidator{},
+		TemporalValidator{},
+		UniqueValidator{},
+		ComposeValidator{},
+		KeyValidator{},
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for _, v := range validators {
+		typ := reflect.TypeOf(v)
+		t.Run(typ.Name(), func(t *testing.T) {
+			check(t, reflect.New(typ).Elem())
+			for i := 0; i < 1000; i++ {
+				rv := reflect.New(typ).Elem()
+				fill(r, rv, 0)
+				check(t, rv)
+			}
+
+			// Since the validators return on the first error, fill in
+			// the fields one at a time, re-rolling each until it passes,
+			// so that the rules of the later fields are also exercised.
+			for i := 0; i < 200; i++ {
+				rv := reflect.New(typ).Elem()
+				for j := 0; j < rv.NumField(); j++ {
+					for k := 0; k < 50; k++ {
+						fill(r, rv.Field(j), 0)
+						err := check(t, rv)
+						if err == "" || !strings.HasPrefix(err, typ.Field(j).Name) {
+							break
+						}
+					}
+				}
+			}
+		})
+	}
+}
+
+// check fails the test if the dynamic validator's error for the struct
+// v does not match the error returned by v's generated Validate method.
+func check(t *testing.T, v reflect.Value) string {
+	t.Helper()
+	want := errString(v.Interface().(interface{ Validate() error }).Validate())
+	got := errString(Struct(v.Interface()))
+	if got != want {
+		t.Fatalf("%#v\ngot=%q\nwant=%q", v.Interface(), got, want)
+	}
+	return want
+}
+
+func TestStructCopy(t *testing.T) {
+	v := PreValidator{F1: " foo ", F2: " FOO ", F6: 3, F7: "y"}
+	if err := Struct(&v); err != nil {
+		t.Fatalf("got err=%v", err)
+	}
+	if v.F1 != " foo " || v.F2 != " FOO " {
+		t.Errorf("got F1=%q F2=%q; the struct was modified", v.F1, v.F2)
+	}
+
+	// like the generated code, the values reachable through
+	// pointers, slices, and maps are modified in place
+	s, m := []string{" x "}, map[string]string{"k": "ABC"}
+	v = PreValidator{F2: "foo", F3: ptr("foo"), F4: s, F5: m, F6: 2.9, F7: "y"}
+	if err := Struct(v); err != nil {
+		t.Fatalf("got err=%v", err)
+	}
+	if *v.F3 != "FOO" || s[0] != "x" || m["k"] != "abc" {
+		t.Errorf("got F3=%q F4=%q F5=%q", *v.F3, s, m)
+	}
+}
+
+func TestStructError(t *testing.T) {
+	type T1 struct {
+		F string `is:"foo_bar"`
+	}
+	type T2 struct {
+		F Level
+		G Color `is:"enum"`
+		H string
+	}
+	type T3 struct {
+		F int `is:"len:5"`
+	}
+	type T4 struct {
+		F int `is:"gt:&G"`
+	}
+	type T5 struct {
+		F string `is:"required" default:"foo"`
+	}
+	type T6 struct {
+		F []string `is:"!unique"`
+	}
+	type T7 struct {
+		F string `is:"re:\"[\""`
+	}
+	type t8 struct{}
+	type T9 struct {
+		F []any `is:"unique"`
+	}
+	type T10 struct {
+		F []struct{ ID any } `is:"unique:ID"`
+	}
+
+	tests := []struct {
+		v    any
+		err  string
+		terr bool
+	}{
+		{v: 123, err: "dynamic: Struct(int): not a struct or a pointer to a struct"},
+		{v: (*T1)(nil), err: "dynamic: Struct(*dynamic.T1): not a struct or a pointer to a struct"},
+		{v: T1{}, terr: true, err: `dynamic: dynamic.T1.F: unknown rule "foo_bar"`},
+		{v: &T1{}, terr: true, err: `dynamic: dynamic.T1.F: unknown rule "foo_bar"`},
+		{v: T2{G: "red"}, err: ""},
+		{v: T3{}, terr: true, err: `dynamic: dynamic.T3.F: rule "len" cannot be used with type int`},
+		{v: T4{}, terr: true, err: `dynamic: dynamic.T4.F: unknown field "G"`},
+		{v: T5{}, terr: true, err: `dynamic: dynamic.T5.F: the default value conflicts with rule "required"`},
+		{v: T6{}, terr: true, err: `dynamic: dynamic.T6.F: rule "!unique" cannot be negated`},
+		{v: T7{}, terr: true, err: "dynamic: dynamic.T7.F: rule \"re\": error parsing regexp: missing closing ]: `[`"},
+		{v: t8{}, err: ""},
+		{v: T9{}, terr: true, err: `dynamic: dynamic.T9.F: rule "unique": elements of type interface {} are not hashable`},
+		{v: T10{}, terr: true, err: `dynamic: dynamic.T10.F: rule "unique": invalid field "ID"`},
+	}
+	for _, tt := range tests {
+		err := Struct(tt.v)
+		if errString(err) != tt.err {
+			t.Errorf("Struct(%#v) got err=%q, want=%q", tt.v, errString(err), tt.err)
+		}
+
+		var terr *TagError
+		if errors.As(err, &terr) != tt.terr {
+			t.Errorf("Struct(%#v) got err of type %T", tt.v, err)
+		}
+	}
+}
+
+func TestStructGroups(t *testing.T) {
+	type T struct {
+		F string `is:"required@create,len@update:3"`
+		G string `is:"len:2,eq@create:foo"`
+	}
+
+	if err := Struct(T{G: "ab"}); err != nil {
+		t.Errorf("got err=%v", err)
+	}
+	if err := Struct(T{G: "abc"}); errString(err) != "G must be of length: 2" {
+		t.Errorf("got err=%v", err)
+	}
+}
+
+func TestRegisterEnum(t *testing.T) {
+	type E uint
+	type T struct {
+		F E `is:"enum"`
+	}
+	enums.Delete(reflect.TypeFor[E]())
+	plans.Delete(reflect.TypeFor[T]())
+
+	err := Struct(T{})
+	if want := `dynamic: dynamic.T.F: no enum values registered for type dynamic.E`; errString(err) != want {
+		t.Fatalf("got err=%q, want=%q", errString(err), want)
+	}
+
+	// failed compilations are not cached
+	RegisterEnum[E](3, 5)
+	if err := Struct(T{F: 5}); err != nil {
+		t.Errorf("got err=%v", err)
+	}
+	if err := Struct(T{F: 4}); errString(err) != "F must be one of" {
+		t.Errorf("got err=%v", err)
+	}
+}
+
+func TestPlanCache(t *testing.T) {
+	typ := reflect.TypeFor[ReferenceValidator]()
+	p1, err := planFor(typ)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p2, err := planFor(typ)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p1 != p2 {
+		t.Errorf("got different plans for the same type")
+	}
+}
+
+func errString(err error) string {
+	if err != nil {
+		return err.Error()
+	}
+	return ""
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// random values
+
+var (
+	stringPool = []string{
+		"", " ", "a", "ab", "abc", "ABC", "foo", "FOO", " foo ", " FOO ",
+		"bar", "xfoo", "axy", "x y", "m", "zz", "%", "a%", "%v", "ąćę",
+		"ff", "12", "-3", "10.0.0.1", "192.168.1.1", "example.com",
+		"john@example.com", "  John@Example.com ", "visa", "card", "paypal",
+		"en", "us", "+1 202 555 0123", "(202) 555-0123",
+		"123e4567-e89b-42d3-a456-426614174000",
+		"74738ff5-5367-5958-9aee-98fffdcd1876",
+	}
+	intPool   = []int64{-5, -1, 0, 1, 2, 3, 5, 6, 9, 10, 11, 42, 100}
+	floatPool = []float64{-1.5, -0.5, 0, 0.5, 1, 1.5, 2.9, 3, 3.14, 3.5, 10, 42.1}
+	anyPool   = []any{nil, 1, 2, "foo", "bar", 2.5, true, false}
+	durPool   = []time.Duration{0, 30 * time.Second, time.Minute, 5 * time.Minute, 2 * time.Hour}
+	timePool  = []time.Time{
+		{},
+		time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC),
+		time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC),
+		time.Now().Add(-2 * time.Hour),
+		time.Now().Add(-48 * time.Hour),
+		time.Now().Add(48 * time.Hour),
+	}
+)
+
+// fill sets v, which must be addressable, to a random value.
+func fill(r *rand.Rand, v reflect.Value, depth int) {
+	if !v.CanSet() {
+		v = reflect.NewAt(v.Type(), unsafe.Pointer(v.UnsafeAddr())).Elem()
+	}
+
+	switch t := v.Type(); {
+	case t == timeType:
+		v.Set(reflect.ValueOf(timePool[r.Intn(len(timePool))]))
+		return
+	case t == durationType:
+		v.SetInt(int64(durPool[r.Intn(len(durPool))]))
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(stringPool[r.Intn(len(stringPool))])
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(intPool[r.Intn(len(intPool))])
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if i := intPool[r.Intn(len(intPool))]; i >= 0 {
+			v.SetUint(uint64(i))
+		}
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(floatPool[r.Intn(len(floatPool))])
+	case reflect.Bool:
+		v.SetBool(r.Intn(2) == 1)
+	case reflect.Interface:
+		if x := anyPool[r.Intn(len(anyPool))]; x != nil {
+			v.Set(reflect.ValueOf(x))
+		}
+	case reflect.Pointer:
+		if r.Intn(4) > 0 && depth < 4 {
+			v.Set(reflect.New(v.Type().Elem()))
+			fill(r, v.Elem(), depth+1)
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			v.SetBytes([]byte(stringPool[r.Intn(len(stringPool))]))
+			return
+		}
+		if n := r.Intn(5) - 1; n >= 0 && depth < 4 {
+			v.Set(reflect.MakeSlice(v.Type(), n, n))
+			for i := 0; i < n; i++ {
+				fill(r, v.Index(i), depth+1)
+			}
+		}
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			fill(r, v.Index(i), depth+1)
+		}
+	case reflect.Map:
+		// at most one entry, since the iteration
+		// order of multiple entries is random
+		if n := r.Intn(3) - 1; n >= 0 && depth < 4 {
+			v.Set(reflect.MakeMap(v.Type()))
+			if n > 0 {
+				k := reflect.New(v.Type().Key()).Elem()
+				e := reflect.New(v.Type().Elem()).Elem()
+				fill(r, k, depth+1)
+				fill(r, e, depth+1)
+				v.SetMapIndex(k, e)
+			}
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			fill(r, v.Field(i), depth+1)
+		}
+	}
+}
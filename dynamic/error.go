@@ -0,0 +1,161 @@
+package dynamic
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/frk/valid/internal/tag"
+)
+
+// err returns the error for the value of node n that failed the rule r.
+// The error is identical to the one returned by the code generated by the
+// cmd/validgen tool with the default configuration, see the generator's
+// errDefault method.
+func (s *state) err(n *node, r *rule, key string) error {
+	text, refs := s.ruleErrText(n, r)
+	text = key + " " + text
+	if len(refs) > 0 {
+		return fmt.Errorf(text, refs...)
+	}
+	return errors.New(text)
+}
+
+// ruleErrText returns the error message text for the failed rule without
+// the field's key. If the text contains formatting verbs, then refs will
+// hold the values that should be used to format the text.
+func (s *state) ruleErrText(n *node, r *rule) (text string, refs []any) {
+	if r.spec.kind == kindAlternation {
+		texts := make([]string, len(r.alt))
+		hasRefs := make([]bool, len(r.alt))
+		for i, a := range r.alt {
+			t, x := s.ruleErrText(n, a)
+			texts[i], hasRefs[i] = t, len(x) > 0
+			refs = append(refs, x...)
+		}
+		if len(refs) > 0 {
+			// the texts will be used as a format string
+			for i := range texts {
+				if !hasRefs[i] {
+					texts[i] = strings.ReplaceAll(texts[i], "%", "%%")
+				}
+			}
+		}
+		return strings.Join(texts, " or "), refs
+	}
+
+	cfg := r.errSpec()
+	text = cfg.text
+	if len(text) == 0 {
+		text = "is not valid"
+	}
+
+	if r.spec.kind == kindCondRequired {
+		// The arguments of a conditional required rule reference
+		// the controlling fields, name them rather than their values.
+		switch r.spec.name {
+		case "required_if", "required_unless":
+			var args []string
+			for _, a := range r.args[1:] {
+				switch a.Type {
+				case tag.ARG_FIELD_ABS, tag.ARG_FIELD_REL:
+					args = append(args, a.Value)
+				case tag.ARG_STRING, tag.ARG_UNKNOWN:
+					args = append(args, strconv.Quote(a.Value))
+				default:
+					args = append(args, a.Value)
+				}
+			}
+			text += " " + r.args[0].Value + " is " + strings.Join(args, cfg.argSep)
+		case "required_with", "required_without":
+			var args []string
+			for _, a := range r.args {
+				args = append(args, a.Value)
+			}
+			text += " " + strings.Join(args, cfg.argSep) + " " + cfg.argSuffix
+		}
+	} else if cfg.withArgs || cfg.withFieldArgs {
+		var args []string
+		for _, a := range r.args {
+			isField := a.ref != nil
+			if !cfg.withArgs && !isField {
+				continue
+			}
+
+			// A rule argument of unknown kind for
+			// a numeric type can be treated as 0.
+			typ, val := a.Type, a.Value
+			if typ == tag.ARG_UNKNOWN && isNumeric(n.typ) {
+				typ, val = tag.ARG_INT, "0"
+			}
+
+			// skip empty
+			if val == "" {
+				continue
+			}
+
+			switch {
+			case isField:
+				args = append(args, "%v")
+				refs = append(refs, s.fieldValue(a.ref).Interface())
+			case typ == tag.ARG_STRING:
+				if isTimeArg(n, r) {
+					args = append(args, val)
+				} else {
+					args = append(args, strconv.Quote(val))
+				}
+			default:
+				args = append(args, val)
+			}
+		}
+		if len(args) > 0 {
+			text += ": " + strings.Join(args, cfg.argSep)
+			if len(cfg.argSuffix) > 0 {
+				text += " " + cfg.argSuffix
+			}
+		}
+	}
+
+	if r.spec.kind == kindUnique {
+		if len(refs) == 0 {
+			text = strings.ReplaceAll(text, "%", "%%")
+		}
+		if n.typ.Kind() == reflect.Map {
+			text += ", duplicate at key: %v"
+		} else {
+			text += ", duplicate at index: %d"
+		}
+		refs = append(refs, s.dup.Interface())
+	}
+	if r.Negated {
+		text = negateErrText(text)
+	}
+	return text, refs
+}
+
+// isTimeArg reports whether or not the rule's string arguments
+// are time, or duration, literals that should not be quoted.
+func isTimeArg(n *node, r *rule) bool {
+	return r.spec.kind == kindTemporal || (r.spec.kind == kindOrdered && n.typ == durationType)
+}
+
+// negateErrText returns the negated form of the given error message
+// text. It mirrors the cmd/validgen tool's rules.NegateErrText function.
+func negateErrText(text string) string {
+	switch {
+	case strings.HasPrefix(text, "must not "):
+		return "must " + text[len("must not "):]
+	case strings.HasPrefix(text, "must "):
+		return "must not " + text[len("must "):]
+	case strings.HasPrefix(text, "cannot "):
+		return "can " + text[len("cannot "):]
+	case strings.HasPrefix(text, "is not "):
+		return "is " + text[len("is not "):]
+	}
+	if i := strings.Index(text, " must "); i > -1 {
+		return text[:i] + " must not " + text[i+len(" must "):]
+	}
+	return "must not satisfy: " + text
+}
@@ -0,0 +1,624 @@
+package dynamic
+
+import (
+	"reflect"
+	"time"
+	"unicode/utf8"
+	"unsafe"
+)
+
+// state maintains the state of a single validation.
+type state struct {
+	// The (copy of the) validated struct.
+	root reflect.Value
+	// The stack of the array, slice, and map elements
+	// that are currently being validated.
+	elems []elemValue
+	// The index, or the key, of the first duplicate
+	// element found by the last "unique" rule.
+	dup reflect.Value
+}
+
+// elemValue is an array, slice, or map element together with its node.
+type elemValue struct {
+	v reflect.Value
+	n *node
+}
+
+// node validates the value v against the rules of node n and of n's child
+// nodes. The key is the field key that is used in the error messages. The
+// order in which the rules are checked mirrors the code generated by the
+// cmd/validgen tool, see the generator's nodesAST function.
+func (s *state) node(n *node, v reflect.Value, key string) error {
+	if !n.hasRules {
+		return nil
+	}
+	if n.def != nil {
+		s.setDefault(n, v)
+	}
+	for _, r := range n.cond {
+		if s.condRequiredFails(n, r, v) {
+			return s.err(n, r, key)
+		}
+	}
+
+	if n.typ.Kind() == reflect.Pointer {
+		switch k := n.is[0].spec.kind; {
+		case k == kindOptional:
+			for n.typ.Kind() == reflect.Pointer {
+				if v.IsNil() {
+					return nil
+				}
+				n, v = n.elem, v.Elem()
+			}
+		case k == kindRequired:
+			r0 := n.is[0]
+			for n.typ.Kind() == reflect.Pointer {
+				if v.IsNil() {
+					return s.err(n.elem, r0, key)
+				}
+				n, v = n.elem, v.Elem()
+			}
+		case k == kindNoGuard:
+			// like the generated code, a nil pointer will cause a panic
+			for n.typ.Kind() == reflect.Pointer {
+				n, v = n.elem, v.Elem()
+			}
+		}
+	}
+	return s.base(n, v, key)
+}
+
+// base validates the value v of the pointer base node n.
+func (s *state) base(n *node, v reflect.Value, key string) error {
+	if len(n.pre) > 0 {
+		s.preproc(n, v)
+	}
+
+	rr := n.is
+	if len(rr) > 0 && rr[0].spec.kind == kindOptional {
+		if !s.optionalPasses(rr[0], v) {
+			return nil
+		}
+		rr = rr[1:]
+	}
+	for _, r := range rr {
+		fails, err := s.fails(n, r, v)
+		if err != nil {
+			return err
+		} else if fails {
+			return s.err(n, r, key)
+		}
+	}
+
+	switch n.typ.Kind() {
+	case reflect.Array, reflect.Slice:
+		return s.loop(n, v, key)
+	case reflect.Map:
+		return s.mapLoop(n, v, key)
+	case reflect.Struct:
+		for _, f := range n.fields {
+			fkey := f.key
+			if f.sf.Anonymous {
+				fkey = key
+			}
+			if err := s.node(f.node, structField(v, f.sf.Index[0]), fkey); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// loop validates the elements of the array or slice v.
+func (s *state) loop(n *node, v reflect.Value, key string) error {
+	E := n.elem
+	if !E.hasRules {
+		return nil
+	}
+
+	// Like the generated code, modify the collection's elements only
+	// if the element node has preprocessor rules, otherwise a copy of
+	// the element is validated.
+	inplace := E.typ.Kind() != reflect.Pointer && len(E.pre) > 0
+	for i := 0; i < v.Len(); i++ {
+		e := v.Index(i)
+		if !inplace {
+			e = copyValue(e)
+		}
+
+		s.elems = append(s.elems, elemValue{v: e, n: E})
+		err := s.node(E, e, key)
+		s.elems = s.elems[:len(s.elems)-1]
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mapLoop validates the keys and elements of the map v.
+func (s *state) mapLoop(n *node, v reflect.Value, key string) error {
+	K, E := n.key, n.elem
+	if !K.hasRules && !E.hasRules {
+		return nil
+	}
+
+	writeback := E.typ.Kind() != reflect.Pointer && len(E.pre) > 0
+	it := v.MapRange()
+	for it.Next() {
+		k, e := copyValue(it.Key()), copyValue(it.Value())
+		if err := s.node(K, k, key); err != nil {
+			return err
+		}
+
+		if E.hasRules {
+			s.elems = append(s.elems, elemValue{v: e, n: E})
+		}
+		err := s.node(E, e, key)
+		if E.hasRules {
+			s.elems = s.elems[:len(s.elems)-1]
+		}
+		if writeback {
+			v.SetMapIndex(it.Key(), e)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setDefault assigns the node's default value to v if v is "zero".
+// Any nil pointers in v's pointer-chain are allocated first.
+func (s *state) setDefault(n *node, v reflect.Value) {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+	if isZero(v) {
+		if n.defRef != nil {
+			v.Set(s.fieldArg(n.defRef, v.Type()))
+		} else {
+			v.Set(n.defVal)
+		}
+	}
+}
+
+// preproc applies the node's preprocessor rules to v.
+func (s *state) preproc(n *node, v reflect.Value) {
+	x := v
+	for _, r := range n.pre {
+		ft := r.fn.Type()
+		in := append([]reflect.Value{convert(x, ft.In(0))}, s.args(r)...)
+		x = r.fn.Call(in)[0]
+	}
+	v.Set(convert(x, v.Type()))
+}
+
+// optionalPasses reports whether or not v is NOT empty according to
+// the optional rule r, i.e. whether the rest of the rules should be
+// checked.
+func (s *state) optionalPasses(r *rule, v reflect.Value) bool {
+	if r.spec.name == "omitnil" {
+		return !v.IsNil()
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.Len() > 0
+	case reflect.Map, reflect.Slice:
+		return v.Len() > 0
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() > 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() > 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() > 0
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.Interface, reflect.Pointer:
+		return !v.IsNil()
+	}
+	return true
+}
+
+// fails reports whether or not the value v fails the rule r. The returned
+// error is non-nil only if the rule's function itself returned an error.
+func (s *state) fails(n *node, r *rule, v reflect.Value) (fails bool, err error) {
+	if r.spec.kind == kindAlternation {
+		for _, a := range r.alt {
+			if fails, err = s.fails(n, a, v); err != nil || !fails {
+				return fails, err
+			}
+		}
+		return true, nil
+	}
+
+	fails, err = s.ruleFails(n, r, v)
+	if r.Negated && r.isBasic() {
+		fails = !fails
+	}
+	return fails, err
+}
+
+// ruleFails reports whether or not the value v fails the rule r,
+// ignoring the rule's negation.
+func (s *state) ruleFails(n *node, r *rule, v reflect.Value) (bool, error) {
+	switch r.spec.kind {
+	case kindOptional:
+		return s.optionalPasses(r, v), nil
+	case kindRequired:
+		if r.spec.name == "notnil" {
+			return v.IsNil(), nil
+		}
+		return isZero(v), nil
+	case kindComparable:
+		args := s.args(r)
+		if r.spec.name == "ne" {
+			for _, a := range args {
+				if v.Equal(a) {
+					return true, nil
+				}
+			}
+			return false, nil
+		}
+		for _, a := range args {
+			if v.Equal(a) {
+				return false, nil
+			}
+		}
+		return true, nil
+	case kindOrdered:
+		a := s.args(r)[0]
+		switch r.spec.name {
+		case "gt":
+			return compare(v, a, "<="), nil
+		case "lt":
+			return compare(v, a, ">="), nil
+		case "gte", "min":
+			return compare(v, a, "<"), nil
+		case "lte", "max":
+			return compare(v, a, ">"), nil
+		}
+	case kindLength:
+		var l int
+		switch {
+		case r.spec.name == "len":
+			l = v.Len()
+		case v.Kind() == reflect.String:
+			l = utf8.RuneCountInString(v.String())
+		default:
+			l = utf8.RuneCount(v.Bytes())
+		}
+
+		args := s.args(r)
+		switch {
+		case len(args) == 1:
+			return l != int(args[0].Int()), nil
+		case r.args[1].Value == "":
+			return l < int(args[0].Int()), nil
+		case r.args[0].Value == "":
+			return l > int(args[1].Int()), nil
+		default:
+			return l < int(args[0].Int()) || l > int(args[1].Int()), nil
+		}
+	case kindRange:
+		args := s.args(r)
+		return compare(v, args[0], "<") || compare(v, args[1], ">"), nil
+	case kindEnum:
+		for _, e := range r.enums {
+			if v.Equal(e) {
+				return false, nil
+			}
+		}
+		return true, nil
+	case kindTemporal:
+		t := v.Interface().(time.Time)
+		switch r.spec.name {
+		case "before":
+			return !t.Before(s.args(r)[0].Interface().(time.Time)), nil
+		case "after":
+			return !t.After(s.args(r)[0].Interface().(time.Time)), nil
+		case "future":
+			return !t.After(time.Now()), nil
+		case "past":
+			return !t.Before(time.Now()), nil
+		case "within":
+			return time.Since(t).Abs() > s.args(r)[0].Interface().(time.Duration), nil
+		}
+	case kindUnique:
+		return s.uniqueFails(r, v), nil
+	case kindFunction:
+		return s.functionFails(r, v)
+	case kindMethod:
+		out := v.Addr().Method(r.method).Call(nil)
+		return !out[0].Bool(), nil
+	}
+	return false, nil
+}
+
+// functionFails reports whether or not the value v fails the function rule r.
+func (s *state) functionFails(r *rule, v reflect.Value) (bool, error) {
+	ft := r.fn.Type()
+	vt := ft.In(0)
+	if ft.IsVariadic() && ft.NumIn() == 1 {
+		vt = vt.Elem()
+	}
+	x := convert(v, vt)
+	args := s.args(r)
+
+	// the function returns (bool, error), the join operator is not used
+	if !r.isBasic() {
+		out := r.fn.Call(append([]reflect.Value{x}, args...))
+		if err, _ := out[1].Interface().(error); err != nil {
+			return false, err
+		}
+		return !out[0].Bool(), nil
+	}
+
+	call := func(args ...reflect.Value) bool {
+		return r.fn.Call(append([]reflect.Value{x}, args...))[0].Bool()
+	}
+	switch r.spec.joinOp {
+	case joinNot: // x || x...
+		for _, a := range args {
+			if call(a) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case joinAnd: // !x || !x...
+		for _, a := range args {
+			if !call(a) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case joinOr: // !x && !x...
+		for _, a := range args {
+			if call(a) {
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+	return !call(args...), nil
+}
+
+// uniqueFails reports whether or not the elements of v are NOT unique. If
+// they are not, the index, or the key, of the first duplicate element is
+// stored in s.dup. The semantics mirror the valid.Unique* functions.
+func (s *state) uniqueFails(r *rule, v reflect.Value) bool {
+	elem := func(e reflect.Value) any {
+		if r.uniq != nil {
+			e = copyValue(e)
+			for _, i := range r.uniq {
+				e = structField(e, i)
+			}
+		}
+		return e.Interface()
+	}
+
+	seen := make(map[any]struct{}, v.Len())
+	if v.Kind() == reflect.Map {
+		it := v.MapRange()
+		for it.Next() {
+			e := elem(it.Value())
+			if _, ok := seen[e]; ok {
+				s.dup = copyValue(it.Key())
+				return true
+			}
+			seen[e] = struct{}{}
+		}
+		return false
+	}
+
+	for i := 0; i < v.Len(); i++ {
+		e := elem(v.Index(i))
+		if _, ok := seen[e]; ok {
+			s.dup = reflect.ValueOf(i)
+			return true
+		}
+		seen[e] = struct{}{}
+	}
+	return false
+}
+
+// condRequiredFails reports whether or not the value v fails the
+// conditional required rule r, i.e. whether v is "zero" while the
+// rule's condition is met.
+func (s *state) condRequiredFails(n *node, r *rule, v reflect.Value) bool {
+	var cond bool
+	switch r.spec.name {
+	case "required_if", "required_unless":
+		ref := r.args[0].ref
+		x := s.fieldValue(ref)
+		eq := false
+		for _, a := range r.args[1:] {
+			if x.Equal(s.arg(a)) {
+				eq = true
+				break
+			}
+		}
+		cond = eq == (r.spec.name == "required_if")
+	case "required_with", "required_without":
+		for _, a := range r.args {
+			if isZero(s.fieldValue(a.ref)) == (r.spec.name == "required_without") {
+				cond = true
+				break
+			}
+		}
+	}
+	return cond && isRequiredZero(v)
+}
+
+// args returns the values of the rule's arguments.
+func (s *state) args(r *rule) []reflect.Value {
+	vals := make([]reflect.Value, len(r.args))
+	for i, a := range r.args {
+		vals[i] = s.arg(a)
+	}
+	return vals
+}
+
+// arg returns the value of the given argument.
+func (s *state) arg(a *arg) reflect.Value {
+	if a.ref != nil {
+		return s.fieldArg(a.ref, a.typ)
+	}
+	return a.val
+}
+
+// fieldArg returns the value of the referenced field as a value of type t.
+func (s *state) fieldArg(ref *fieldRef, t reflect.Type) reflect.Value {
+	x := s.fieldValue(ref)
+	if t.Kind() == reflect.Pointer && t.Elem() == x.Type() {
+		return x.Addr()
+	}
+	return convert(x, t)
+}
+
+// fieldValue returns the value of the referenced field. Fields referenced
+// relatively from within an array, slice, or map element are resolved
+// against the innermost struct element, see the generator's
+// fieldArgSelector.
+func (s *state) fieldValue(ref *fieldRef) reflect.Value {
+	x, sel := s.root, ref.sel
+	if len(s.elems) > 0 && !ref.abs {
+		for i := len(s.elems) - 1; i >= 0; i-- {
+			if isStructOrStructPointer(s.elems[i].n.typ) {
+				x = s.elems[i].v
+				break
+			}
+		}
+
+		// resolve the elem distance
+		var distance int
+		for i := len(sel) - 1; i >= 0; i-- {
+			if isCollection(sel[i].Type) {
+				break
+			}
+			distance += 1
+		}
+		if len(sel) > distance {
+			sel = sel[len(sel)-distance:]
+		}
+	}
+
+	for _, f := range sel {
+		for x.Kind() == reflect.Pointer {
+			if x.IsNil() {
+				// the generated code would panic here, return
+				// the zero value of the referenced field instead
+				return reflect.New(ref.leaf().Type).Elem()
+			}
+			x = x.Elem()
+		}
+		x = structField(x, f.Index[0])
+	}
+	return x
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// helpers
+
+// structField returns the i'th field of the addressable struct v. Unlike
+// v.Field, the returned value can be used even if the field is unexported,
+// since the generated code, declared in the struct's package, has access
+// to the unexported fields as well.
+func structField(v reflect.Value, i int) reflect.Value {
+	f := v.Field(i)
+	if !f.CanSet() {
+		f = reflect.NewAt(f.Type(), unsafe.Pointer(f.UnsafeAddr())).Elem()
+	}
+	return f
+}
+
+// copyValue returns an addressable copy of v.
+func copyValue(v reflect.Value) reflect.Value {
+	c := reflect.New(v.Type()).Elem()
+	c.Set(v)
+	return c
+}
+
+// convert converts v to a value of type t, unless t is an interface type.
+func convert(v reflect.Value, t reflect.Type) reflect.Value {
+	if v.Type() == t || t.Kind() == reflect.Interface {
+		return v
+	}
+	return v.Convert(t)
+}
+
+// isZero reports whether or not v is "zero" using the same
+// comparisons as the generated code, see zeroCmpExpr.
+func isZero(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.String, reflect.Map, reflect.Slice:
+		return v.Len() == 0
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Interface, reflect.Pointer, reflect.Func, reflect.Chan:
+		return v.IsNil()
+	}
+	return v.Equal(reflect.Zero(v.Type()))
+}
+
+// isRequiredZero reports whether or not v is zero according to the
+// "required" rule, i.e. whether any of the pointers in v's pointer-chain
+// is nil or whether the base value is zero, see makeNodeFromPtr.
+func isRequiredZero(v reflect.Value) bool {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return true
+		}
+		v = v.Elem()
+	}
+	if t := v.Type(); isBasic(t) || isNilable(t) || (t.Name() != "" && t.Comparable()) {
+		return isZero(v)
+	}
+	return false
+}
+
+// compare reports the result of the comparison "x op y" of
+// the two ordered values x and y of the same type.
+func compare(x, y reflect.Value, op string) bool {
+	switch {
+	case isInteger(x.Type()):
+		return cmpOp(x.Int(), y.Int(), op)
+	case isUnsigned(x.Type()):
+		return cmpOp(x.Uint(), y.Uint(), op)
+	case isFloat(x.Type()):
+		return cmpOp(x.Float(), y.Float(), op)
+	}
+	return cmpOp(x.String(), y.String(), op)
+}
+
+func cmpOp[T int64 | uint64 | float64 | string](x, y T, op string) bool {
+	switch op {
+	case "<":
+		return x < y
+	case "<=":
+		return x <= y
+	case ">":
+		return x > y
+	case ">=":
+		return x >= y
+	}
+	return false
+}
+
+func isStructOrStructPointer(t reflect.Type) bool {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct
+}
@@ -0,0 +1,1178 @@
+package dynamic
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/frk/tagutil"
+	"github.com/frk/valid"
+	"github.com/frk/valid/internal/tag"
+)
+
+// plan is the compiled representation of a validator struct type.
+type plan struct {
+	root *node
+}
+
+// node is the runtime counterpart of the rules.Node type.
+type node struct {
+	// The type associated with the node.
+	typ reflect.Type
+	// The preprocessor, validation, and conditional required rules.
+	pre, is, cond []*rule
+	// The default value of the node, or nil.
+	def *tag.Arg
+	// The compiled default value, valid only if def is a literal.
+	defVal reflect.Value
+	// The field referenced by the default value, if any.
+	defRef *fieldRef
+	// Map key, and map, array, slice, or pointer elem nodes.
+	key, elem *node
+	// The struct fields.
+	fields []*field
+	// Reports whether or not the node, or
+	// any of its child nodes, have rules.
+	hasRules bool
+}
+
+// field is the runtime counterpart of the rules.FieldNode type.
+type field struct {
+	sf   reflect.StructField
+	node *node
+	key  string
+}
+
+// rule is the compiled representation of a rule parsed from a struct tag.
+type rule struct {
+	*tag.Rule
+	spec *spec
+	alt  []*rule
+	// The arguments of the rule.
+	args []*arg
+	// kindFunction & kindPreproc only, the rule's function.
+	fn reflect.Value
+	// kindMethod only, the index of the method in the
+	// method set of the pointer to the node's type.
+	method int
+	// kindUnique only, the index of the field by which
+	// the elements are compared, or nil.
+	uniq []int
+	// kindEnum only, the values of the enum type.
+	enums []reflect.Value
+}
+
+// arg is the compiled representation of a rule's argument.
+type arg struct {
+	*tag.Arg
+	// The constant value of the argument converted to the target type.
+	val reflect.Value
+	// The target type, i.e. the type of the value that the
+	// rule expects, or nil if the argument is not used.
+	typ reflect.Type
+	// The referenced field if the argument is a field reference.
+	ref *fieldRef
+}
+
+// fieldRef is a reference to a field of the validator struct.
+type fieldRef struct {
+	// The field's key.
+	key string
+	// The field's selector, from the root struct to the field.
+	sel []reflect.StructField
+	// Reports whether or not the reference is absolute.
+	abs bool
+}
+
+// leaf returns the referenced field.
+func (f *fieldRef) leaf() reflect.StructField {
+	return f.sel[len(f.sel)-1]
+}
+
+var (
+	timeType     = reflect.TypeFor[time.Time]()
+	durationType = reflect.TypeFor[time.Duration]()
+	intType      = reflect.TypeFor[int]()
+	errorType    = reflect.TypeFor[error]()
+)
+
+// compiler maintains the state of the compilation of a struct type.
+// It mirrors the cmd/validgen tool's rules.Checker.
+type compiler struct {
+	root reflect.Type
+	// keyset is used to ensure the uniqueness of the field keys.
+	keyset map[string]uint
+	// keymap maps the field keys to the fields' selectors.
+	keymap map[string][]reflect.StructField
+	// The struct types currently being compiled,
+	// used to break recursive type definitions.
+	visiting map[reflect.Type]bool
+	// The struct field currently being compiled, for error reporting.
+	cur []reflect.StructField
+}
+
+// compile compiles the given struct type into a plan.
+func compile(t reflect.Type) (*plan, error) {
+	c := &compiler{
+		root:     t,
+		keyset:   make(map[string]uint),
+		keymap:   make(map[string][]reflect.StructField),
+		visiting: make(map[reflect.Type]bool),
+	}
+
+	root, err := c.makeNode(t, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.check(root, nil); err != nil {
+		return nil, err
+	}
+	setHasRules(root)
+	return &plan{root: root}, nil
+}
+
+// makeNode creates a node for the given type and its associated "is"
+// and "pre" tags. The fs argument is the selector of the current field.
+func (c *compiler) makeNode(t reflect.Type, is, pre *tag.Tag, fs []reflect.StructField) (_ *node, err error) {
+	var root, base *node
+	if t.Kind() == reflect.Pointer {
+		if root, base, err = c.makeNodeFromPtr(t, is, pre, fs); err != nil {
+			return nil, err
+		}
+	} else {
+		root = &node{typ: t}
+		if root.is, root.pre, root.cond, err = c.makeRuleLists(is, pre, fs); err != nil {
+			return nil, err
+		}
+		base = root
+	}
+
+	// use the base type for further checks
+	t = base.typ
+
+	if getKey(is) != nil && t.Kind() != reflect.Map {
+		return nil, c.err("rule key is not allowed for type %s", t)
+	}
+	if getElem(is) != nil && !isCollection(t) {
+		return nil, c.err("rule elem is not allowed for type %s", t)
+	}
+	if getKey(pre) != nil && t.Kind() != reflect.Map {
+		return nil, c.err("rule key is not allowed for type %s", t)
+	}
+	if getElem(pre) != nil && !isCollection(t) {
+		return nil, c.err("rule elem is not allowed for type %s", t)
+	}
+
+	// descend the type hierarchy
+	switch t.Kind() {
+	case reflect.Array, reflect.Slice:
+		if base.elem, err = c.makeNode(t.Elem(), getElem(is), getElem(pre), fs); err != nil {
+			return nil, err
+		}
+	case reflect.Map:
+		if base.key, err = c.makeNode(t.Key(), getKey(is), getKey(pre), fs); err != nil {
+			return nil, err
+		}
+		if base.elem, err = c.makeNode(t.Elem(), getElem(is), getElem(pre), fs); err != nil {
+			return nil, err
+		}
+	case reflect.Struct:
+		if c.visiting[t] {
+			break
+		}
+		c.visiting[t] = true
+		defer delete(c.visiting, t)
+
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			if !c.canAccess(sf) {
+				continue
+			}
+
+			f, err := c.makeField(sf, fs)
+			if err != nil {
+				return nil, err
+			}
+			base.fields = append(base.fields, f)
+		}
+	}
+	return root, nil
+}
+
+// makeNodeFromPtr creates a node for the given pointer type. The rules that
+// apply to pointers are applied to each pointer in the pointer-chain, the
+// rest of the rules are applied to the pointer's base.
+func (c *compiler) makeNodeFromPtr(t reflect.Type, is, pre *tag.Tag, fs []reflect.StructField) (root, base *node, err error) {
+	root = &node{typ: t}
+	base = root
+
+	var (
+		required *rule // applies to pointers and base
+		notnil   *rule // applies to pointers and nilable base
+		optional *rule // applies to pointers and base
+		omitnil  *rule // applies to pointers only
+		noguard  *rule // applies to pointers only
+		cond     []*rule
+		rr       []*rule
+	)
+	for _, tr := range is.GetRules() {
+		if tr.Name == "omitkey" { // non-rule
+			continue
+		}
+
+		r, err := c.loadRule(tr, "")
+		if err != nil {
+			return nil, nil, err
+		}
+		switch {
+		case r.spec.kind == kindRequired && r.spec.name == "required":
+			required = r
+		case r.spec.kind == kindRequired && r.spec.name == "notnil":
+			notnil = r
+		case r.spec.kind == kindOptional && r.spec.name == "optional":
+			optional = r
+		case r.spec.kind == kindOptional && r.spec.name == "omitnil":
+			omitnil = r
+		case r.spec.kind == kindNoGuard:
+			noguard = r
+		case r.spec.kind == kindCondRequired:
+			cond = append(cond, r)
+		default:
+			rr = append(rr, r)
+		}
+		c.normalizeRelFieldArgs(r, fs)
+	}
+
+	// for pointer types the "omitnil" is applied by default
+	// if no other pointer-specific rule was explicitly provided
+	if required == nil && notnil == nil && optional == nil && noguard == nil {
+		omitnil = &rule{Rule: &tag.Rule{Name: "omitnil"}, spec: getSpec("omitnil")}
+	}
+
+	root.cond = cond
+
+	// apply the pointer specific rules to
+	// every pointer in the pointer-chain
+	for t.Kind() == reflect.Pointer {
+		for _, r := range []*rule{required, notnil, optional, omitnil, noguard} {
+			if r != nil {
+				base.is = append(base.is, r)
+			}
+		}
+
+		base.elem = &node{typ: t.Elem()}
+		base = base.elem
+		t = t.Elem()
+	}
+
+	// apply base specific rules
+	if required != nil && (isBasic(t) || isNilable(t) || (t.Name() != "" && t.Comparable())) {
+		base.is = append(base.is, required)
+	}
+	if notnil != nil && isNilable(t) {
+		base.is = append(base.is, notnil)
+	}
+	if optional != nil {
+		base.is = append(base.is, optional)
+	}
+	base.is = append(base.is, rr...)
+
+	// the preprocessor rules apply to base only
+	for _, tr := range pre.GetRules() {
+		r, err := c.loadRule(tr, "pre:")
+		if err != nil {
+			return nil, nil, err
+		}
+		base.pre = append(base.pre, r)
+		c.normalizeRelFieldArgs(r, fs)
+	}
+	return root, base, nil
+}
+
+// makeRuleLists creates the rule lists of a non-pointer node.
+func (c *compiler) makeRuleLists(is, pre *tag.Tag, fs []reflect.StructField) (isList, preList, condList []*rule, err error) {
+	var r0, rr []*rule
+	for _, tr := range is.GetRules() {
+		if tr.Name == "omitkey" { // non-rule
+			continue
+		}
+
+		r, err := c.loadRule(tr, "")
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		switch r.spec.kind {
+		case kindRequired, kindOptional, kindNoGuard:
+			r0 = append(r0, r)
+		case kindCondRequired:
+			condList = append(condList, r)
+		default:
+			rr = append(rr, r)
+		}
+		c.normalizeRelFieldArgs(r, fs)
+	}
+	isList = append(r0, rr...)
+
+	for _, tr := range pre.GetRules() {
+		r, err := c.loadRule(tr, "pre:")
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		preList = append(preList, r)
+		c.normalizeRelFieldArgs(r, fs)
+	}
+	return isList, preList, condList, nil
+}
+
+// makeField creates the field for the given struct field.
+func (c *compiler) makeField(sf reflect.StructField, fs []reflect.StructField) (f *field, err error) {
+	f = &field{sf: sf}
+	sel := append(fs[:len(fs):len(fs)], sf)
+	f.key = c.fieldKey(sel, false)
+
+	c.cur = sel
+	is := parseTag(sf.Tag, "is")
+	pre := parseTag(sf.Tag, "pre")
+	if f.node, err = c.makeNode(sf.Type, is, pre, sel); err != nil {
+		return nil, err
+	}
+	c.cur = sel
+	if str := sf.Tag.Get("default"); len(str) > 0 {
+		f.node.def = tag.ParseArg(str)
+		if f.node.def.Type == tag.ARG_FIELD_REL {
+			c.normalizeRelFieldValue(f.node.def, sel)
+		}
+	}
+
+	c.keymap[f.key] = sel
+	return f, nil
+}
+
+// parseTag parses the value of the given key in the struct tag. The rules
+// that are restricted to a validation group are omitted from the result.
+func parseTag(st reflect.StructTag, key string) *tag.Tag {
+	str, ok := st.Lookup(key)
+	if !ok || str == "-" || len(str) == 0 {
+		return nil
+	}
+	return withoutGroups(tag.Parse(str))
+}
+
+// withoutGroups removes the rules that are restricted to
+// a validation group from the given tag and its sub-tags.
+func withoutGroups(t *tag.Tag) *tag.Tag {
+	if t == nil {
+		return nil
+	}
+
+	out := &tag.Tag{}
+	for _, r := range t.Rules {
+		if r.Group == "" {
+			out.AddRule(r)
+		}
+	}
+	out.Key = withoutGroups(t.Key)
+	out.Elem = withoutGroups(t.Elem)
+	return out
+}
+
+// loadRule creates a rule from the given parsed rule and loads its spec.
+// The prefix is prepended to the rule's name when looking up the spec.
+func (c *compiler) loadRule(tr *tag.Rule, prefix string) (*rule, error) {
+	// copy the parsed rule, its args may be updated during compilation
+	r := &rule{Rule: &tag.Rule{Name: tr.Name, Group: tr.Group, Negated: tr.Negated}}
+	for _, a := range tr.Args {
+		r.args = append(r.args, &arg{Arg: &tag.Arg{Type: a.Type, Value: a.Value}})
+	}
+
+	if len(tr.Alt) == 0 {
+		if r.spec = getSpec(prefix + tr.Name); r.spec == nil {
+			return nil, c.err("unknown rule %q", prefix+tr.Name)
+		}
+		return r, nil
+	}
+
+	for _, a := range tr.Alt {
+		alt, err := c.loadRule(a, prefix)
+		if err != nil {
+			return nil, err
+		}
+		r.alt = append(r.alt, alt)
+	}
+	r.spec = _alternationSpec
+	return r, nil
+}
+
+// normalizeRelFieldArgs normalizes the values of the rule's
+// arguments that are references relative to the current field.
+func (c *compiler) normalizeRelFieldArgs(r *rule, fs []reflect.StructField) {
+	for _, a := range r.argList() {
+		if a.Type == tag.ARG_FIELD_REL {
+			c.normalizeRelFieldValue(a.Arg, fs)
+		}
+	}
+}
+
+// normalizeRelFieldValue turns the value of the relative field
+// reference into the key of the referenced field.
+func (c *compiler) normalizeRelFieldValue(a *tag.Arg, fs []reflect.StructField) {
+	sep := "."
+	if len(fs) > 1 {
+		a.Value = c.fieldKey(fs[:len(fs)-1], true) + sep + a.Value
+	}
+	a.Value = strings.TrimPrefix(a.Value, sep)
+	a.Value = strings.TrimSuffix(a.Value, sep)
+}
+
+// fieldKey returns the key of the field represented by the given selector.
+// The key is constructed by joining the "json" tag values, or the names,
+// of the fields in the selector. If optuniq is false, the key is made unique.
+func (c *compiler) fieldKey(fs []reflect.StructField, optuniq bool) (key string) {
+	const sep = "."
+	for _, f := range fs {
+		t := tagutil.New(string(f.Tag))
+		if t.Contains("is", "omitkey") || f.Anonymous {
+			continue
+		}
+
+		v := t.First("json")
+		if len(v) == 0 {
+			v = f.Name
+		}
+		key += v + sep
+	}
+	if len(key) > len(sep) {
+		key = key[:len(key)-len(sep)]
+	}
+	if optuniq {
+		return key
+	}
+
+	if num, ok := c.keyset[key]; ok {
+		c.keyset[key] = num + 1
+		key += "-" + strconv.FormatUint(uint64(num), 10)
+	} else {
+		c.keyset[key] = 1
+	}
+	return key
+}
+
+// canAccess reports whether or not the generated code, which is declared
+// in the validator's package, would have access to the given field.
+func (c *compiler) canAccess(sf reflect.StructField) bool {
+	if sf.Name == "_" {
+		return false
+	}
+	if tagutil.New(string(sf.Tag)).First("is") == "-" {
+		return false
+	}
+	if !sf.IsExported() && !sf.Anonymous && sf.PkgPath != c.root.PkgPath() {
+		return false
+	}
+	return true
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// rule-checking
+
+// check checks the rules of the given node, and of its child nodes,
+// and it compiles the rules' arguments.
+func (c *compiler) check(n *node, fs []reflect.StructField) error {
+	c.cur = fs
+	c.fixImplicitRules(n)
+
+	for _, r := range n.is {
+		if err := c.checkRule(n, r); err != nil {
+			return err
+		}
+	}
+	for _, r := range n.pre {
+		if err := c.checkPreproc(n, r); err != nil {
+			return err
+		}
+	}
+	for _, r := range n.cond {
+		if err := c.checkCondRule(n, r); err != nil {
+			return err
+		}
+	}
+	if n.def != nil {
+		if err := c.checkDefault(n); err != nil {
+			return err
+		}
+	}
+
+	switch n.typ.Kind() {
+	case reflect.Pointer, reflect.Array, reflect.Slice:
+		return c.check(n.elem, fs)
+	case reflect.Map:
+		if err := c.check(n.key, fs); err != nil {
+			return err
+		}
+		return c.check(n.elem, fs)
+	case reflect.Struct:
+		for _, f := range n.fields {
+			sel := append(fs[:len(fs):len(fs)], f.sf)
+			if err := c.check(f.node, sel); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// fixImplicitRules adds the implicit "isvalid" rule, unless it was
+// explicitly omitted with the "-isvalid" rule.
+func (c *compiler) fixImplicitRules(n *node) {
+	if hasIsValid(n.typ) && !containsRule(n.is, "isvalid") {
+		n.is = append(n.is, &rule{Rule: &tag.Rule{Name: "isvalid"}, spec: getSpec("isvalid")})
+	}
+	if containsRule(n.is, "-isvalid") {
+		n.is = removeRule(n.is, "isvalid")
+		n.is = removeRule(n.is, "-isvalid")
+	}
+}
+
+// checkRule checks the given validation rule of node n.
+func (c *compiler) checkRule(n *node, r *rule) error {
+	if err := c.checkArgs(r); err != nil {
+		return err
+	}
+
+	t := n.typ
+	switch r.spec.kind {
+	case kindRequired:
+		if r.spec.name == "notnil" && !isNilable(t) {
+			return c.err("rule %q cannot be used with type %s", r.TagName(), t)
+		}
+		if r.spec.name == "required" && !isNilable(t) && !t.Comparable() {
+			return c.err("rule %q cannot be used with type %s", r.TagName(), t)
+		}
+	case kindOptional:
+		for _, r2 := range n.is {
+			if r2.Name == "notnil" || (r2.Name == "required" && r.Name == "optional") {
+				return c.err("rule %q conflicts with rule %q", r.TagName(), r2.TagName())
+			}
+		}
+	case kindComparable, kindOrdered, kindRange:
+		if r.spec.kind != kindComparable && !isOrdered(t) {
+			return c.err("rule %q cannot be used with type %s", r.TagName(), t)
+		}
+		for _, a := range r.args {
+			if err := c.compileArg(a, t); err != nil {
+				return err
+			}
+		}
+	case kindLength:
+		if r.spec.name == "runecount" && t.Kind() != reflect.String && !isBytes(t) {
+			return c.err("rule %q cannot be used with type %s", r.TagName(), t)
+		}
+		if r.spec.name == "len" && !hasLength(t) {
+			return c.err("rule %q cannot be used with type %s", r.TagName(), t)
+		}
+		for _, a := range r.args {
+			if a.Value != "" {
+				if err := c.compileArg(a, intType); err != nil {
+					return err
+				}
+			}
+		}
+	case kindTemporal:
+		if t != timeType {
+			return c.err("rule %q cannot be used with type %s", r.TagName(), t)
+		}
+		tt := timeType
+		if r.spec.name == "within" {
+			tt = durationType
+		}
+		for _, a := range r.args {
+			if err := c.compileArg(a, tt); err != nil {
+				return err
+			}
+		}
+	case kindEnum:
+		list, ok := enums.Load(t)
+		if !ok || len(list.([]reflect.Value)) == 0 {
+			return c.err("no enum values registered for type %s", t)
+		}
+		r.enums = list.([]reflect.Value)
+	case kindUnique:
+		if !isCollection(t) {
+			return c.err("rule %q cannot be used with type %s", r.TagName(), t)
+		}
+		if len(r.args) > 0 {
+			sf, ok := reflect.StructField{}, false
+			if t.Elem().Kind() == reflect.Struct {
+				sf, ok = t.Elem().FieldByName(r.args[0].Value)
+			}
+			if !ok || !isHashable(sf.Type) {
+				return c.err("rule %q: invalid field %q", r.TagName(), r.args[0].Value)
+			}
+			r.uniq = sf.Index
+		} else if !isHashable(t.Elem()) {
+			return c.err("rule %q: elements of type %s are not hashable", r.TagName(), t.Elem())
+		}
+	case kindFunction:
+		if err := c.compileFunc(n, r); err != nil {
+			return err
+		}
+	case kindMethod:
+		m, ok := reflect.PointerTo(t).MethodByName("IsValid")
+		if !ok {
+			return c.err("rule %q: type %s has no IsValid method", r.TagName(), t)
+		}
+		r.method = m.Index
+	case kindAlternation:
+		for _, a := range r.alt {
+			if err := c.checkRule(n, a); err != nil {
+				return err
+			}
+			if !canCompose(a) {
+				return c.err("rule %q cannot be used in an alternation group", a.TagName())
+			}
+		}
+	}
+
+	if r.Negated && !canCompose(r) {
+		return c.err("rule %q cannot be negated", r.TagName())
+	}
+	return nil
+}
+
+// canCompose reports whether or not the rule can be negated,
+// or used in an alternation group, see rules.canCompose.
+func canCompose(r *rule) bool {
+	switch r.spec.kind {
+	case kindComparable, kindOrdered, kindLength, kindRange, kindEnum, kindTemporal, kindMethod:
+		return true
+	case kindFunction:
+		return r.isBasic()
+	}
+	return false
+}
+
+// checkPreproc checks the given preprocessor rule of node n.
+func (c *compiler) checkPreproc(n *node, r *rule) error {
+	if err := c.checkArgs(r); err != nil {
+		return err
+	}
+	return c.compileFunc(n, r)
+}
+
+// checkCondRule checks the given conditional required rule of node n.
+func (c *compiler) checkCondRule(n *node, r *rule) error {
+	if r.Negated {
+		return c.err("rule %q cannot be negated", r.TagName())
+	}
+	if err := c.checkArgs(r); err != nil {
+		return err
+	}
+
+	switch r.spec.name {
+	case "required_if", "required_unless":
+		ref := r.args[0].ref
+		if ref == nil {
+			return c.err("rule %q: the first argument must be a field reference", r.TagName())
+		}
+		for _, a := range r.args[1:] {
+			if err := c.compileArg(a, ref.leaf().Type); err != nil {
+				return err
+			}
+		}
+	case "required_with", "required_without":
+		for _, a := range r.args {
+			if a.ref == nil {
+				return c.err("rule %q: the arguments must be field references", r.TagName())
+			}
+		}
+	}
+	return nil
+}
+
+// checkDefault checks and compiles the default value of node n.
+func (c *compiler) checkDefault(n *node) error {
+	// a field with a default value is never zero, and therefore
+	// the default value is in conflict with any required rule
+	for _, r := range n.is {
+		if r.spec.kind == kindRequired {
+			return c.err("the default value conflicts with rule %q", r.TagName())
+		}
+	}
+
+	t := n.typ
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if !isBasic(t) || n.def.Type == tag.ARG_UNKNOWN {
+		return c.err("cannot use default value %q with type %s", n.def.Value, t)
+	}
+
+	a := &arg{Arg: n.def}
+	if err := c.resolveRef(a); err != nil {
+		return err
+	}
+	if err := c.compileArg(a, t); err != nil {
+		return err
+	}
+	n.defVal, n.defRef = a.val, a.ref
+	return nil
+}
+
+// checkArgs resolves the rule's field arguments, applies the spec's
+// argument options, and checks the number of the rule's arguments.
+func (c *compiler) checkArgs(r *rule) error {
+	for _, a := range r.args {
+		if err := c.resolveRef(a); err != nil {
+			return err
+		}
+	}
+
+	// update the args based on the spec's options, see rules.Checker.fixRuleArgs
+	for i, opts := range r.spec.argOpts {
+		if len(r.args) <= i {
+			a := &arg{Arg: &tag.Arg{Type: tag.ARG_UNKNOWN}}
+			if opt, ok := opts[""]; ok {
+				*a.Arg = opt
+			}
+			r.args = append(r.args, a)
+			continue
+		}
+
+		a := r.args[i]
+		if a.Value == "" && a.Type == tag.ARG_UNKNOWN {
+			if opt, ok := opts[""]; ok {
+				*a.Arg = opt
+			}
+			continue
+		}
+		if a.ref == nil {
+			if opt, ok := opts[a.Value]; ok {
+				*a.Arg = opt
+			}
+		}
+	}
+
+	if r.spec.argMin > -1 && r.spec.argMin > len(r.args) {
+		return c.err("rule %q: not enough arguments", r.TagName())
+	}
+	if r.spec.argMax > -1 && r.spec.argMax < len(r.args) {
+		return c.err("rule %q: too many arguments", r.TagName())
+	}
+	return nil
+}
+
+// resolveRef resolves the field referenced by the argument, if any.
+func (c *compiler) resolveRef(a *arg) error {
+	if a.Type != tag.ARG_FIELD_ABS && a.Type != tag.ARG_FIELD_REL {
+		return nil
+	}
+
+	sel, ok := c.keymap[a.Value]
+	if !ok {
+		return c.err("unknown field %q", a.Value)
+	}
+	a.ref = &fieldRef{key: a.Value, sel: sel, abs: a.Type == tag.ARG_FIELD_ABS}
+	return nil
+}
+
+// compileFunc compiles the function of a function or preprocessor rule.
+func (c *compiler) compileFunc(n *node, r *rule) error {
+	r.fn = reflect.ValueOf(r.spec.fn)
+	ft := r.fn.Type()
+
+	// the value's target type
+	vt := ft.In(0)
+	if ft.IsVariadic() && ft.NumIn() == 1 {
+		vt = vt.Elem()
+	}
+	if !canPass(n.typ, vt) {
+		return c.err("rule %q cannot be used with type %s", r.TagName(), n.typ)
+	}
+	if r.spec.kind == kindPreproc && !ft.Out(0).ConvertibleTo(n.typ) {
+		return c.err("rule %q cannot be used with type %s", r.TagName(), n.typ)
+	}
+
+	// the arguments' target types, see generator's ruleArg
+	for i, a := range r.args {
+		var tt reflect.Type
+		if ft.NumIn()-1 <= i {
+			tt = ft.In(ft.NumIn() - 1)
+		} else {
+			tt = ft.In(i + 1)
+		}
+		if ft.IsVariadic() {
+			tt = tt.Elem()
+		}
+		if err := c.compileArg(a, tt); err != nil {
+			return err
+		}
+	}
+
+	// the regular expressions of the "re" rule must be registered
+	if r.spec.fn != nil && r.spec.name == "re" {
+		for _, a := range r.args {
+			if a.ref != nil {
+				continue
+			}
+			if _, err := regexp.Compile(a.Value); err != nil {
+				return c.err("rule %q: %v", r.TagName(), err)
+			}
+			valid.RegisterRegexp(a.Value)
+		}
+	}
+	return nil
+}
+
+// compileArg converts the argument's literal value to a value of type t.
+// If the argument is a field reference, compileArg checks only that the
+// referenced field's value can be passed as a value of type t.
+func (c *compiler) compileArg(a *arg, t reflect.Type) error {
+	a.typ = t
+	if a.ref != nil {
+		ft := a.ref.leaf().Type
+		if t.Kind() == reflect.Pointer && t.Elem() == ft {
+			return nil
+		}
+		if !canPass(ft, t) {
+			return c.err("cannot use field %q of type %s as %s", a.ref.key, ft, t)
+		}
+		return nil
+	}
+
+	v, err := constValue(a.Arg, t)
+	if err != nil {
+		return c.err("%v", err)
+	}
+	a.val = v
+	return nil
+}
+
+// constValue returns the value of the given literal argument as a value of
+// type t. The conversion follows the semantics of the generated code, see
+// the generator's constArg.
+func constValue(a *tag.Arg, t reflect.Type) (reflect.Value, error) {
+	// t is interface{}, use the untyped constant's default type
+	if t.Kind() == reflect.Interface && t.NumMethod() == 0 && a.Type != tag.ARG_STRING && a.Value != "" {
+		var x any
+		switch a.Type {
+		case tag.ARG_BOOL:
+			x = a.Value == "true"
+		case tag.ARG_INT:
+			i, err := strconv.ParseInt(a.Value, 10, 64)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			x = int(i)
+		case tag.ARG_FLOAT:
+			f, err := strconv.ParseFloat(a.Value, 64)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			x = f
+		default:
+			x = a.Value
+		}
+		v := reflect.New(t).Elem()
+		v.Set(reflect.ValueOf(x))
+		return v, nil
+	}
+
+	if a.Type == tag.ARG_STRING && (t == timeType || t == durationType) {
+		if v, ok := timeValue(a, t); ok {
+			return v, nil
+		}
+	}
+
+	v := reflect.New(t).Elem()
+	switch a.Type {
+	case tag.ARG_UNKNOWN:
+		return v, nil
+	case tag.ARG_STRING:
+		sv := reflect.ValueOf(a.Value)
+		if t.Kind() == reflect.Interface && sv.Type().Implements(t) {
+			v.Set(sv)
+			return v, nil
+		}
+		if t.Kind() != reflect.String && !isBytes(t) && !isRunes(t) {
+			break
+		}
+		return sv.Convert(t), nil
+	case tag.ARG_BOOL:
+		if t.Kind() != reflect.Bool {
+			break
+		}
+		v.SetBool(a.Value == "true")
+		return v, nil
+	case tag.ARG_INT, tag.ARG_FLOAT:
+		switch {
+		case isInteger(t) && a.Type == tag.ARG_INT:
+			i, err := strconv.ParseInt(strings.TrimPrefix(a.Value, "+"), 10, 64)
+			if err != nil || v.OverflowInt(i) {
+				break
+			}
+			v.SetInt(i)
+			return v, nil
+		case isUnsigned(t) && a.Type == tag.ARG_INT:
+			u, err := strconv.ParseUint(strings.TrimPrefix(a.Value, "+"), 10, 64)
+			if err != nil || v.OverflowUint(u) {
+				break
+			}
+			v.SetUint(u)
+			return v, nil
+		case isFloat(t):
+			f, err := strconv.ParseFloat(a.Value, 64)
+			if err != nil {
+				break
+			}
+			v.SetFloat(f)
+			return v, nil
+		}
+	}
+	return reflect.Value{}, fmt.Errorf("cannot use %q as a value of type %s", a.Value, t)
+}
+
+// The layouts, in order of preference, that can be used to parse a time
+// literal argument. Mirrors the layouts of the cmd/validgen tool.
+var timeArgLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+}
+
+// timeValue parses the given argument as a time.Time, or a time.Duration, literal.
+func timeValue(a *tag.Arg, t reflect.Type) (reflect.Value, bool) {
+	if t == durationType {
+		if d, err := time.ParseDuration(a.Value); err == nil {
+			return reflect.ValueOf(d), true
+		}
+		return reflect.Value{}, false
+	}
+	for _, layout := range timeArgLayouts {
+		if tt, err := time.Parse(layout, a.Value); err == nil {
+			return reflect.ValueOf(tt), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// err returns a *TagError for the field currently being compiled.
+func (c *compiler) err(format string, args ...any) error {
+	e := &TagError{Type: c.root, Err: fmt.Sprintf(format, args...)}
+	for i, f := range c.cur {
+		if i > 0 {
+			e.Field += "."
+		}
+		e.Field += f.Name
+	}
+	return e
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// helpers
+
+// argList returns the rule's arguments or, if the rule is an
+// alternation group, the arguments of all of the group's rules.
+func (r *rule) argList() []*arg {
+	if len(r.alt) == 0 {
+		return r.args
+	}
+
+	var args []*arg
+	for _, a := range r.alt {
+		args = append(args, a.argList()...)
+	}
+	return args
+}
+
+// errSpec returns the spec of the rule's error message,
+// see the cmd/validgen tool's rules.Rule.ErrSpec method.
+func (r *rule) errSpec() errSpec {
+	if len(r.spec.errOpts) > 0 && len(r.args) > 0 {
+		var key string
+		for _, a := range r.args {
+			key += ":"
+			if len(a.Value) > 0 {
+				key += "x"
+			}
+		}
+
+		key = key[1:]
+		if e, ok := r.spec.errOpts[key]; ok {
+			return e
+		}
+	}
+	return r.spec.err
+}
+
+// isBasic reports whether or not the rule is a basic rule, i.e. a rule
+// that is NOT a function returning an error as its second result.
+func (r *rule) isBasic() bool {
+	if r.spec.kind != kindFunction {
+		return true
+	}
+	ft := r.fn.Type()
+	return ft.NumOut() < 2 || ft.Out(ft.NumOut()-1) != errorType
+}
+
+// setHasRules sets the hasRules field of the node and its child nodes.
+// A node has rules if it, or any of its child nodes, have rules other
+// than the optional and noguard rules, see rules.Node.HasRules.
+func setHasRules(n *node) bool {
+	if n == nil {
+		return false
+	}
+
+	if len(n.pre) > 0 || len(n.cond) > 0 || n.def != nil {
+		n.hasRules = true
+	}
+	for _, r := range n.is {
+		if r.spec.kind != kindOptional && r.spec.kind != kindNoGuard {
+			n.hasRules = true
+		}
+	}
+	if setHasRules(n.key) {
+		n.hasRules = true
+	}
+	if setHasRules(n.elem) {
+		n.hasRules = true
+	}
+	for _, f := range n.fields {
+		if setHasRules(f.node) {
+			n.hasRules = true
+		}
+	}
+	return n.hasRules
+}
+
+func getKey(t *tag.Tag) *tag.Tag {
+	if t != nil {
+		return t.Key
+	}
+	return nil
+}
+
+func getElem(t *tag.Tag) *tag.Tag {
+	if t != nil {
+		return t.Elem
+	}
+	return nil
+}
+
+func containsRule(rr []*rule, name string) bool {
+	for _, r := range rr {
+		if r.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func removeRule(rr []*rule, name string) []*rule {
+	for i, r := range rr {
+		if r.Name == name {
+			return append(append([]*rule{}, rr[:i]...), rr[i+1:]...)
+		}
+	}
+	return rr
+}
+
+// hasIsValid reports whether or not t is a named type
+// that declares the "IsValid() bool" method.
+func hasIsValid(t reflect.Type) bool {
+	if t.Name() == "" || t.Kind() == reflect.Interface || t.Kind() == reflect.Pointer {
+		return false
+	}
+	m, ok := reflect.PointerTo(t).MethodByName("IsValid")
+	return ok && m.Type.NumIn() == 1 && m.Type.NumOut() == 1 && m.Type.Out(0).Kind() == reflect.Bool
+}
+
+// canPass reports whether or not a value of type t can be passed
+// as a value of type u, either directly or after a conversion.
+func canPass(t, u reflect.Type) bool {
+	if u.Kind() == reflect.Interface {
+		return t.Implements(u)
+	}
+	return t.ConvertibleTo(u)
+}
+
+func isCollection(t reflect.Type) bool {
+	k := t.Kind()
+	return k == reflect.Array || k == reflect.Slice || k == reflect.Map
+}
+
+// isHashable reports whether or not values of type t can be used as map
+// keys without causing a run-time panic, i.e. t is comparable and it is
+// not, and it does not contain, an interface type.
+func isHashable(t reflect.Type) bool {
+	if !t.Comparable() {
+		return false
+	}
+	switch t.Kind() {
+	case reflect.Interface:
+		return false
+	case reflect.Array:
+		return isHashable(t.Elem())
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if !isHashable(t.Field(i).Type) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func isNilable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Interface, reflect.Func, reflect.Chan:
+		return true
+	}
+	return false
+}
+
+func isBasic(t reflect.Type) bool {
+	return isNumeric(t) || t.Kind() == reflect.String || t.Kind() == reflect.Bool
+}
+
+func isNumeric(t reflect.Type) bool {
+	return isInteger(t) || isUnsigned(t) || isFloat(t)
+}
+
+func isOrdered(t reflect.Type) bool {
+	return isNumeric(t) || t.Kind() == reflect.String
+}
+
+func hasLength(t reflect.Type) bool {
+	return isCollection(t) || t.Kind() == reflect.String || t.Kind() == reflect.Chan
+}
+
+func isInteger(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	}
+	return false
+}
+
+func isUnsigned(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+	return false
+}
+
+func isFloat(t reflect.Type) bool {
+	return t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64
+}
+
+func isBytes(t reflect.Type) bool {
+	return t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8
+}
+
+func isRunes(t reflect.Type) bool {
+	return t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Int32
+}
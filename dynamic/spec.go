@@ -0,0 +1,93 @@
+package dynamic
+
+//go:generate go run ../cmd/internal/specgen -o spectab.go
+
+import (
+	"github.com/frk/valid/internal/tag"
+)
+
+// specKind mirrors the rules.SpecKind type of the cmd/validgen tool.
+type specKind uint
+
+const (
+	_ specKind = iota
+
+	kindRequired     // required, notnil
+	kindCondRequired // required_if, required_unless, required_with, required_without
+	kindComparable   // eq, ne
+	kindOrdered      // gt, gte, lt, lte, min, max
+	kindLength       // len, runecount
+	kindRange        // rng, between
+	kindEnum         // enum
+	kindTemporal     // before, after, future, past, within
+	kindUnique       // unique
+	kindFunction     // <builtin/included func rules>
+	kindMethod       // isvalid (implicit)
+	kindAlternation  // (rule|rule...)
+	kindOptional     // optional, omitnil
+	kindNoGuard      // noguard
+	kindRemove       // -isvalid
+	kindPreproc      // <builtin/included preprocessor rules>
+)
+
+// joinOp mirrors the rules.JoinOp type of the cmd/validgen tool.
+type joinOp uint
+
+const (
+	_       joinOp = iota
+	joinNot        // x || x || x....
+	joinAnd        // !x || !x || !x....
+	joinOr         // !x && !x && !x....
+)
+
+// spec is the runtime counterpart of the rules.Spec type.
+type spec struct {
+	// The unique name of the rule.
+	name string
+	// The kind of the rule.
+	kind specKind
+	// kindFunction & kindPreproc only, the rule's function.
+	fn any
+	// The bounds of the allowed number of arguments.
+	argMin, argMax int
+	// kindFunction & kindPreproc only, the rule's pre-declared argument options.
+	argOpts []map[string]tag.Arg
+	// The join operator used for joining multiple
+	// instances of the rule into a single one.
+	joinOp joinOp
+	// The spec for the rule's error message.
+	err errSpec
+	// The error specs for specific argument combinations.
+	errOpts map[string]errSpec
+}
+
+// errSpec is the runtime counterpart of the rules.ErrSpec type.
+type errSpec struct {
+	// The text of the error message.
+	text string
+	// If true the error message will include the rule's arguments.
+	withArgs bool
+	// If true the error message will include the values of those
+	// of the rule's arguments that reference other fields.
+	withFieldArgs bool
+	// The separator used to join the rule's arguments.
+	argSep string
+	// The text to be appended after the list of arguments.
+	argSuffix string
+}
+
+// getSpec returns the spec of the named rule, or nil if there's no such
+// rule. The names of preprocessor rules are expected to have the "pre:"
+// prefix.
+func getSpec(name string) *spec {
+	if s, ok := _included[name]; ok {
+		return s
+	}
+	if s, ok := _builtin[name]; ok {
+		return s
+	}
+	return nil
+}
+
+// The spec of alternation groups, i.e. rules of the form "(rule|rule)".
+var _alternationSpec = &spec{name: "()", kind: kindAlternation}
@@ -0,0 +1,276 @@
+package dynamic
+
+import (
+	"time"
+)
+
+// The validator types used by the tests to compare the results of the
+// dynamic validator with the results of the code generated by validgen.
+//
+// The validators_valid_test.go file holds the generated code. To regenerate
+// it, copy this file into a temporary directory as validators.go, run the
+// validgen tool in that directory, and copy the resulting validators_valid.go
+// file back as validators_valid_test.go.
+
+type Color string
+
+const (
+	ColorRed   Color = "red"
+	ColorGreen Color = "green"
+	ColorBlue  Color = "blue"
+)
+
+type Level int
+
+const (
+	LevelLow  Level = 1
+	LevelHigh Level = 2
+)
+
+type Code string
+
+func (c Code) IsValid() bool { return len(c) == 3 }
+
+type Size int
+
+func (s *Size) IsValid() bool { return *s < 10 }
+
+type BasicValidator struct {
+	F1  string            `is:"required"`
+	F2  []string          `is:"required"`
+	F3  map[string]string `is:"required"`
+	F4  int               `is:"required"`
+	F5  uint              `is:"required"`
+	F6  float64           `is:"required"`
+	F7  bool              `is:"required"`
+	F8  any               `is:"notnil"`
+	F9  []int             `is:"notnil"`
+	F10 *string           `is:"required"`
+	F11 **int             `is:"required"`
+	F12 *[]string         `is:"notnil"`
+	F13 *struct{ A int }  `is:"required"`
+}
+
+type CompareValidator struct {
+	F1  string   `is:"eq:foo:bar"`
+	F2  int      `is:"ne:1:2:3"`
+	F3  float64  `is:"gt:3.14"`
+	F4  int8     `is:"lt:42"`
+	F5  uint     `is:"gte:5"`
+	F6  float32  `is:"lte:-1.5"`
+	F7  int      `is:"min:2,max:10"`
+	F8  int      `is:"rng:-5:5"`
+	F9  float64  `is:"between:0.5:10"`
+	F10 *int     `is:"gt:0"`
+	F11 **string `is:"eq:abc"`
+	F12 string   `is:"gt:m"`
+	F13 any      `is:"eq:1:foo"`
+	F14 Level    `is:"ne:2"`
+	F15 int      `is:"eq:"`
+}
+
+type LengthValidator struct {
+	F1 string         `is:"len:3"`
+	F2 []int          `is:"len:1:"`
+	F3 map[int]string `is:"len::1"`
+	F4 string         `is:"len:2:4"`
+	F5 []byte         `is:"runecount:2"`
+	F6 string         `is:"runecount:1:"`
+	F7 string         `is:"runecount::2"`
+	F8 *string        `is:"runecount:1:3"`
+	F9 [2]int         `is:"len:2"`
+}
+
+type OptionalValidator struct {
+	F1 string   `is:"optional,len:2"`
+	F2 int      `is:"optional,gt:5"`
+	F3 *string  `is:"optional,email"`
+	F4 []string `is:"optional,len:2"`
+	F5 *string  `is:"omitnil,len:1"`
+	F6 *int     `is:"lt:5"`
+	F7 float64  `is:"optional,rng:1:2"`
+	F8 bool     `is:"optional"`
+	F9 *[]int   `is:"omitnil,[]gt:0"`
+}
+
+type MethodValidator struct {
+	F1 Code
+	F2 *Code
+	F3 Code `is:"-isvalid"`
+	F4 Size
+	F5 []Code
+	F6 Code   `is:"len:3"`
+	F7 Color  `is:"enum"`
+	F8 *Level `is:"enum"`
+}
+
+type IncludedValidator struct {
+	F1  string  `is:"email"`
+	F2  string  `is:"uuid"`
+	F3  string  `is:"uuid:v5"`
+	F4  string  `is:"alnum"`
+	F5  string  `is:"ip:v4"`
+	F6  string  `is:"re:\"^[a-z]+$\""`
+	F7  string  `is:"prefix:foo:bar"`
+	F8  string  `is:"contains:x,suffix:y"`
+	F9  *string `is:"int"`
+	F10 string  `is:"hex,len:2:"`
+	F11 any     `is:"in:1:foo:2.5:true"`
+	F12 string  `is:"phone:&F13"`
+	F13 string
+	F14 string `is:"ascii,lower"`
+}
+
+type ReferenceValidator struct {
+	Min  int
+	Max  int
+	Name string
+	Lang string
+	When time.Time
+
+	F1 int    `is:"gt:&Min"`
+	F2 int    `is:"lt:&Max"`
+	F3 string `is:"eq:&Name"`
+	F4 int    `is:"rng:&Min:&Max"`
+	F5 string `is:"len:&Min:"`
+	F6 string `is:"alnum:&Lang"`
+	F7 string `is:"ne:foo:&Name"`
+
+	Sub struct {
+		A int
+		B int `is:"gt:.A"`
+		C int `is:"lt:&Min"`
+	}
+	Items []struct {
+		A int
+		B int   `is:"gte:.A"`
+		C []int `is:"[]lte:.A"`
+	}
+	PItems []*struct {
+		A string
+		B string `is:"ne:.A"`
+	}
+}
+
+type NestedValidator struct {
+	S1 struct {
+		F1 string `is:"required"`
+		F2 *struct {
+			F1 int `is:"gt:1"`
+		}
+	}
+	S2 *struct {
+		F1 string `is:"len:1:"`
+	} `is:"required"`
+	S3 []struct {
+		F1 string `is:"required"`
+	} `is:"len::3"`
+	S4 map[string]*struct {
+		F1 int `is:"gt:0"`
+	} `is:"[len:2]notnil"`
+	S5 [][]string       `is:"[][]email"`
+	S6 map[string][]int `is:"[alpha][]gt:0"`
+	S7 []*string        `is:"[]required"`
+	S8 *[]*int          `is:"required,[]lt:10"`
+}
+
+type PreValidator struct {
+	F1 string            `pre:"trim"`
+	F2 string            `pre:"trim,lower" is:"eq:foo"`
+	F3 *string           `pre:"upper" is:"eq:FOO"`
+	F4 []string          `pre:"[]trim" is:"[]len:1:"`
+	F5 map[string]string `pre:"[]lower" is:"[]eq:abc"`
+	F6 float64           `pre:"round" is:"eq:3"`
+	F7 string            `pre:"replace:a:b,trimprefix:x" is:"required"`
+	F8 string            `pre:"trim" is:"optional,len:2"`
+	F9 *string           `pre:"trim" is:"optional,email"`
+}
+
+type DefaultValidator struct {
+	F1 string   `default:"foo" is:"eq:foo"`
+	F2 int      `default:"42" is:"eq:42"`
+	F3 *float64 `default:"1.5" is:"lt:1"`
+	F4 bool     `default:"true"`
+	F5 string   `default:"&F6" is:"len:3"`
+	F6 string
+}
+
+type CondValidator struct {
+	Method string
+	Amount int
+	Other  *string
+	Card   struct {
+		Type   string
+		Number string `is:"required_if:.Type:visa:mastercard"`
+	}
+
+	F1 string  `is:"required_if:&Method:card"`
+	F2 *string `is:"required_if:&Method:card:paypal"`
+	F3 []byte  `is:"required_unless:&Amount:0,len:4"`
+	F4 string  `is:"required_with:&Other"`
+	F5 string  `is:"required_without:&Other:&Method"`
+	F6 *int    `is:"required_with:&Method,gt:1"`
+}
+
+type TemporalValidator struct {
+	Start time.Time
+
+	F1 time.Time     `is:"before:2020-01-01"`
+	F2 time.Time     `is:"after:\"2020-01-01T12:00:00Z\""`
+	F3 time.Time     `is:"future"`
+	F4 *time.Time    `is:"past"`
+	F5 time.Time     `is:"within:24h"`
+	F6 time.Time     `is:"after:&Start"`
+	F7 time.Duration `is:"gt:1m,lt:1h"`
+}
+
+type UniqueValidator struct {
+	F1 []string       `is:"unique"`
+	F2 [3]int         `is:"unique"`
+	F3 map[string]int `is:"unique"`
+	F4 []struct {
+		ID   int
+		Name string
+	} `is:"unique:ID"`
+	F5 *[]int `is:"unique"`
+}
+
+type ComposeValidator struct {
+	Name string
+
+	F1 string  `is:"!contains:foo"`
+	F2 int     `is:"!eq:1:2"`
+	F3 string  `is:"(email|uuid)"`
+	F4 string  `is:"(len:2|eq:&Name)"`
+	F5 *string `is:"(ip|fqdn),!prefix:\"10.\""`
+	F6 string  `is:"!len:3"`
+	F7 string  `is:"!re:\"^a%\""`
+	F8 string  `is:"(email|prefix:%)"`
+	F9 Code    `is:"!isvalid"`
+}
+
+type Embedded struct {
+	E1 string `is:"required"`
+}
+
+type embedded struct {
+	E2 int `is:"gt:0"`
+}
+
+type KeyValidator struct {
+	Embedded
+	embedded
+
+	F1 string `json:"f_one" is:"required"`
+	F2 string `json:"-" is:"required"`
+	F3 struct {
+		G1 string `json:"g1" is:"required"`
+	} `json:"f3" is:"omitkey"`
+	F4 struct {
+		F1 string `is:"required"`
+	} `json:"F5"`
+	F5 string `is:"len:2"`
+	f6 string `is:"required"`
+	F7 string `is:"-"`
+	_  string `is:"required"`
+}
@@ -0,0 +1,598 @@
+// DO NOT EDIT. This file was generated by "github.com/frk/valid".
+
+package dynamic
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/frk/valid"
+)
+
+func init() {
+	valid.RegisterRegexp(`^[a-z]+$`)
+	valid.RegisterRegexp(`^a%`)
+}
+
+func (v BasicValidator) Validate() error {
+	if v.F1 == "" {
+		return errors.New("F1 is required")
+	}
+	if len(v.F2) == 0 {
+		return errors.New("F2 is required")
+	}
+	if len(v.F3) == 0 {
+		return errors.New("F3 is required")
+	}
+	if v.F4 == 0 {
+		return errors.New("F4 is required")
+	}
+	if v.F5 == 0 {
+		return errors.New("F5 is required")
+	}
+	if v.F6 == 0.0 {
+		return errors.New("F6 is required")
+	}
+	if v.F7 == false {
+		return errors.New("F7 is required")
+	}
+	if v.F8 == nil {
+		return errors.New("F8 cannot be nil")
+	}
+	if v.F9 == nil {
+		return errors.New("F9 cannot be nil")
+	}
+	if v.F10 == nil || *v.F10 == "" {
+		return errors.New("F10 is required")
+	}
+	if v.F11 == nil || *v.F11 == nil || **v.F11 == 0 {
+		return errors.New("F11 is required")
+	}
+	if v.F12 == nil || *v.F12 == nil {
+		return errors.New("F12 cannot be nil")
+	}
+	if v.F13 == nil {
+		return errors.New("F13 is required")
+	}
+	return nil
+}
+
+func (v CompareValidator) Validate() error {
+	if v.F1 != "foo" && v.F1 != "bar" {
+		return errors.New("F1 must be equal to: \"foo\" or \"bar\"")
+	}
+	if v.F2 == 1 || v.F2 == 2 || v.F2 == 3 {
+		return errors.New("F2 must not be equal to: 1 or 2 or 3")
+	}
+	if v.F3 <= 3.14 {
+		return errors.New("F3 must be greater than: 3.14")
+	}
+	if v.F4 >= 42 {
+		return errors.New("F4 must be less than: 42")
+	}
+	if v.F5 < 5 {
+		return errors.New("F5 must be greater than or equal to: 5")
+	}
+	if v.F6 > -1.5 {
+		return errors.New("F6 must be less than or equal to: -1.5")
+	}
+	if v.F7 < 2 {
+		return errors.New("F7 must be greater than or equal to: 2")
+	} else if v.F7 > 10 {
+		return errors.New("F7 must be less than or equal to: 10")
+	}
+	if v.F8 < -5 || v.F8 > 5 {
+		return errors.New("F8 must be between: -5 and 5")
+	}
+	if v.F9 < 0.5 || v.F9 > 10 {
+		return errors.New("F9 must be between: 0.5 and 10")
+	}
+	if v.F10 != nil && *v.F10 <= 0 {
+		return errors.New("F10 must be greater than: 0")
+	}
+	if v.F11 != nil && *v.F11 != nil && **v.F11 != "abc" {
+		return errors.New("F11 must be equal to: \"abc\"")
+	}
+	if v.F12 <= "m" {
+		return errors.New("F12 must be greater than: \"m\"")
+	}
+	if v.F13 != 1 && v.F13 != "foo" {
+		return errors.New("F13 must be equal to: 1 or \"foo\"")
+	}
+	if v.F14 == 2 {
+		return errors.New("F14 must not be equal to: 2")
+	}
+	if v.F15 != 0 {
+		return errors.New("F15 must be equal to: 0")
+	}
+	return nil
+}
+
+func (v LengthValidator) Validate() error {
+	if len(v.F1) != 3 {
+		return errors.New("F1 must be of length: 3")
+	}
+	if len(v.F2) < 1 {
+		return errors.New("F2 must be of length at least: 1")
+	}
+	if len(v.F3) > 1 {
+		return errors.New("F3 must be of length at most: 1")
+	}
+	if len(v.F4) < 2 || len(v.F4) > 4 {
+		return errors.New("F4 must be of length between: 2 and 4 (inclusive)")
+	}
+	if utf8.RuneCount(v.F5) != 2 {
+		return errors.New("F5 must have rune count: 2")
+	}
+	if utf8.RuneCountInString(v.F6) < 1 {
+		return errors.New("F6 must have rune count at least: 1")
+	}
+	if utf8.RuneCountInString(v.F7) > 2 {
+		return errors.New("F7 must have rune count at most: 2")
+	}
+	if v.F8 != nil && (utf8.RuneCountInString(*v.F8) < 1 || utf8.RuneCountInString(*v.F8) > 3) {
+		return errors.New("F8 must have rune count between: 1 and 3 (inclusive)")
+	}
+	if len(v.F9) != 2 {
+		return errors.New("F9 must be of length: 2")
+	}
+	return nil
+}
+
+func (v OptionalValidator) Validate() error {
+	if v.F1 != "" && len(v.F1) != 2 {
+		return errors.New("F1 must be of length: 2")
+	}
+	if v.F2 > 0 && v.F2 <= 5 {
+		return errors.New("F2 must be greater than: 5")
+	}
+	if v.F3 != nil && *v.F3 != "" && !valid.Email(*v.F3) {
+		return errors.New("F3 must be a valid email address")
+	}
+	if len(v.F4) > 0 && len(v.F4) != 2 {
+		return errors.New("F4 must be of length: 2")
+	}
+	if v.F5 != nil && len(*v.F5) != 1 {
+		return errors.New("F5 must be of length: 1")
+	}
+	if v.F6 != nil && *v.F6 >= 5 {
+		return errors.New("F6 must be less than: 5")
+	}
+	if v.F7 > 0.0 && (v.F7 < 1 || v.F7 > 2) {
+		return errors.New("F7 must be between: 1 and 2")
+	}
+	if v.F9 != nil {
+		for _, e1 := range *v.F9 {
+			if e1 <= 0 {
+				return errors.New("F9 must be greater than: 0")
+			}
+		}
+	}
+	return nil
+}
+
+func (v MethodValidator) Validate() error {
+	if !v.F1.IsValid() {
+		return errors.New("F1 is not valid")
+	}
+	if v.F2 != nil && !v.F2.IsValid() {
+		return errors.New("F2 is not valid")
+	}
+	if !v.F4.IsValid() {
+		return errors.New("F4 is not valid")
+	}
+	for _, e1 := range v.F5 {
+		if !e1.IsValid() {
+			return errors.New("F5 is not valid")
+		}
+	}
+	if len(v.F6) != 3 {
+		return errors.New("F6 must be of length: 3")
+	} else if !v.F6.IsValid() {
+		return errors.New("F6 is not valid")
+	}
+	if v.F7 != ColorRed && v.F7 != ColorGreen && v.F7 != ColorBlue {
+		return errors.New("F7 must be one of")
+	}
+	if v.F8 != nil && (*v.F8 != LevelLow && *v.F8 != LevelHigh) {
+		return errors.New("F8 must be one of")
+	}
+	return nil
+}
+
+func (v IncludedValidator) Validate() error {
+	if !valid.Email(v.F1) {
+		return errors.New("F1 must be a valid email address")
+	}
+	if !valid.UUID(v.F2, 4) {
+		return errors.New("F2 must be a valid UUID")
+	}
+	if !valid.UUID(v.F3, 5) {
+		return errors.New("F3 must be a valid UUID")
+	}
+	if !valid.Alnum(v.F4, "en") {
+		return errors.New("F4 must be an alphanumeric string")
+	}
+	if !valid.IP(v.F5, 4) {
+		return errors.New("F5 must be a valid IP")
+	}
+	if !valid.Match(v.F6, `^[a-z]+$`) {
+		return errors.New("F6 must match the regular expression: \"^[a-z]+$\"")
+	}
+	if !strings.HasPrefix(v.F7, "foo") && !strings.HasPrefix(v.F7, "bar") {
+		return errors.New("F7 must be prefixed with: \"foo\" or \"bar\"")
+	}
+	if !strings.Contains(v.F8, "x") {
+		return errors.New("F8 must contain substring: \"x\"")
+	} else if !strings.HasSuffix(v.F8, "y") {
+		return errors.New("F8 must be suffixed with: \"y\"")
+	}
+	if v.F9 != nil && !valid.Int(*v.F9) {
+		return errors.New("F9 string content must match an integer")
+	}
+	if !valid.Hex(v.F10) {
+		return errors.New("F10 must be a valid hexadecimal string")
+	} else if len(v.F10) < 2 {
+		return errors.New("F10 must be of length at least: 2")
+	}
+	if !valid.In(v.F11, 1, "foo", 2.5, true) {
+		return errors.New("F11 must be in the list")
+	}
+	if !valid.Phone(v.F12, v.F13) {
+		return fmt.Errorf("F12 must be a valid phone number: %v", v.F13)
+	}
+	if !valid.ASCII(v.F14) {
+		return errors.New("F14 must contain only ASCII characters")
+	} else if !valid.LowerCase(v.F14) {
+		return errors.New("F14 must contain only lower-case characters")
+	}
+	return nil
+}
+
+func (v ReferenceValidator) Validate() error {
+	if v.F1 <= v.Min {
+		return fmt.Errorf("F1 must be greater than: %v", v.Min)
+	}
+	if v.F2 >= v.Max {
+		return fmt.Errorf("F2 must be less than: %v", v.Max)
+	}
+	if v.F3 != v.Name {
+		return fmt.Errorf("F3 must be equal to: %v", v.Name)
+	}
+	if v.F4 < v.Min || v.F4 > v.Max {
+		return fmt.Errorf("F4 must be between: %v and %v", v.Min, v.Max)
+	}
+	if len(v.F5) < v.Min {
+		return fmt.Errorf("F5 must be of length at least: %v", v.Min)
+	}
+	if !valid.Alnum(v.F6, v.Lang) {
+		return errors.New("F6 must be an alphanumeric string")
+	}
+	if v.F7 == "foo" || v.F7 == v.Name {
+		return fmt.Errorf("F7 must not be equal to: \"foo\" or %v", v.Name)
+	}
+	if v.Sub.B <= v.Sub.A {
+		return fmt.Errorf("Sub.B must be greater than: %v", v.Sub.A)
+	}
+	if v.Sub.C >= v.Min {
+		return fmt.Errorf("Sub.C must be less than: %v", v.Min)
+	}
+	for _, e1 := range v.Items {
+		if e1.B < e1.A {
+			return fmt.Errorf("Items.B must be greater than or equal to: %v", e1.A)
+		}
+		for _, e2 := range e1.C {
+			if e2 > e1.A {
+				return fmt.Errorf("Items.C must be less than or equal to: %v", e1.A)
+			}
+		}
+	}
+	for _, e1 := range v.PItems {
+		if e1 != nil {
+			if e1.B == e1.A {
+				return fmt.Errorf("PItems.B must not be equal to: %v", e1.A)
+			}
+		}
+	}
+	return nil
+}
+
+func (v NestedValidator) Validate() error {
+	if v.S1.F1 == "" {
+		return errors.New("S1.F1 is required")
+	}
+	if v.S1.F2 != nil {
+		if v.S1.F2.F1 <= 1 {
+			return errors.New("S1.F2.F1 must be greater than: 1")
+		}
+	}
+	if v.S2 == nil {
+		return errors.New("S2 is required")
+	} else {
+		if len(v.S2.F1) < 1 {
+			return errors.New("S2.F1 must be of length at least: 1")
+		}
+	}
+	if len(v.S3) > 3 {
+		return errors.New("S3 must be of length at most: 3")
+	} else {
+		for _, e1 := range v.S3 {
+			if e1.F1 == "" {
+				return errors.New("S3.F1 is required")
+			}
+		}
+	}
+	for k1, e1 := range v.S4 {
+		if len(k1) != 2 {
+			return errors.New("S4 must be of length: 2")
+		}
+		if e1 == nil {
+			return errors.New("S4 cannot be nil")
+		} else {
+			if e1.F1 <= 0 {
+				return errors.New("S4.F1 must be greater than: 0")
+			}
+		}
+	}
+	for _, e1 := range v.S5 {
+		for _, e2 := range e1 {
+			if !valid.Email(e2) {
+				return errors.New("S5 must be a valid email address")
+			}
+		}
+	}
+	for k1, e1 := range v.S6 {
+		if !valid.Alpha(k1, "en") {
+			return errors.New("S6 must be an alphabetic string")
+		}
+		for _, e2 := range e1 {
+			if e2 <= 0 {
+				return errors.New("S6 must be greater than: 0")
+			}
+		}
+	}
+	for _, e1 := range v.S7 {
+		if e1 == nil || *e1 == "" {
+			return errors.New("S7 is required")
+		}
+	}
+	if v.S8 == nil || len(*v.S8) == 0 {
+		return errors.New("S8 is required")
+	} else {
+		for _, e1 := range *v.S8 {
+			if e1 != nil && *e1 >= 10 {
+				return errors.New("S8 must be less than: 10")
+			}
+		}
+	}
+	return nil
+}
+
+func (v PreValidator) Validate() error {
+	v.F1 = strings.TrimSpace(v.F1)
+	v.F2 = strings.ToLower(strings.TrimSpace(v.F2))
+	if v.F2 != "foo" {
+		return errors.New("F2 must be equal to: \"foo\"")
+	}
+	if v.F3 != nil {
+		*v.F3 = strings.ToUpper(*v.F3)
+		if *v.F3 != "FOO" {
+			return errors.New("F3 must be equal to: \"FOO\"")
+		}
+	}
+	for i, e1 := range v.F4 {
+		v.F4[i] = strings.TrimSpace(e1)
+		e1 = v.F4[i]
+		if len(e1) < 1 {
+			return errors.New("F4 must be of length at least: 1")
+		}
+	}
+	for k1, e1 := range v.F5 {
+		v.F5[k1] = strings.ToLower(e1)
+		e1 = v.F5[k1]
+		if e1 != "abc" {
+			return errors.New("F5 must be equal to: \"abc\"")
+		}
+	}
+	v.F6 = math.Round(v.F6)
+	if v.F6 != 3 {
+		return errors.New("F6 must be equal to: 3")
+	}
+	v.F7 = strings.TrimPrefix(strings.Replace(v.F7, "a", "b", -1), "x")
+	if v.F7 == "" {
+		return errors.New("F7 is required")
+	}
+	v.F8 = strings.TrimSpace(v.F8)
+	if v.F8 != "" && len(v.F8) != 2 {
+		return errors.New("F8 must be of length: 2")
+	}
+	if v.F9 != nil {
+		*v.F9 = strings.TrimSpace(*v.F9)
+		if *v.F9 != "" && !valid.Email(*v.F9) {
+			return errors.New("F9 must be a valid email address")
+		}
+	}
+	return nil
+}
+
+func (v DefaultValidator) Validate() error {
+	if v.F1 == "" {
+		v.F1 = "foo"
+	}
+	if v.F1 != "foo" {
+		return errors.New("F1 must be equal to: \"foo\"")
+	}
+	if v.F2 == 0 {
+		v.F2 = 42
+	}
+	if v.F2 != 42 {
+		return errors.New("F2 must be equal to: 42")
+	}
+	if v.F3 == nil {
+		v.F3 = new(float64)
+	}
+	if *v.F3 == 0.0 {
+		*v.F3 = 1.5
+	}
+	if v.F3 != nil && *v.F3 >= 1 {
+		return errors.New("F3 must be less than: 1")
+	}
+	if v.F4 == false {
+		v.F4 = true
+	}
+	if v.F5 == "" {
+		v.F5 = v.F6
+	}
+	if len(v.F5) != 3 {
+		return errors.New("F5 must be of length: 3")
+	}
+	return nil
+}
+
+func (v CondValidator) Validate() error {
+	if (v.Card.Type == "visa" || v.Card.Type == "mastercard") && v.Card.Number == "" {
+		return errors.New("Card.Number is required if Card.Type is \"visa\" or \"mastercard\"")
+	}
+	if v.Method == "card" && v.F1 == "" {
+		return errors.New("F1 is required if Method is \"card\"")
+	}
+	if (v.Method == "card" || v.Method == "paypal") && (v.F2 == nil || *v.F2 == "") {
+		return errors.New("F2 is required if Method is \"card\" or \"paypal\"")
+	}
+	if v.Amount != 0 && len(v.F3) == 0 {
+		return errors.New("F3 is required unless Amount is 0")
+	}
+	if len(v.F3) != 4 {
+		return errors.New("F3 must be of length: 4")
+	}
+	if v.Other != nil && v.F4 == "" {
+		return errors.New("F4 is required if Other is present")
+	}
+	if (v.Other == nil || v.Method == "") && v.F5 == "" {
+		return errors.New("F5 is required if Other or Method is not present")
+	}
+	if v.Method != "" && (v.F6 == nil || *v.F6 == 0) {
+		return errors.New("F6 is required if Method is present")
+	}
+	if v.F6 != nil && *v.F6 <= 1 {
+		return errors.New("F6 must be greater than: 1")
+	}
+	return nil
+}
+
+func (v TemporalValidator) Validate() error {
+	if !v.F1.Before(time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)) {
+		return errors.New("F1 must be before: 2020-01-01")
+	}
+	if !v.F2.After(time.Date(2020, time.January, 1, 12, 0, 0, 0, time.UTC)) {
+		return errors.New("F2 must be after: 2020-01-01T12:00:00Z")
+	}
+	if !v.F3.After(time.Now()) {
+		return errors.New("F3 must be in the future")
+	}
+	if v.F4 != nil && !(*v.F4).Before(time.Now()) {
+		return errors.New("F4 must be in the past")
+	}
+	if time.Since(v.F5).Abs() > 24*time.Hour {
+		return errors.New("F5 must be within: 24h of the current time")
+	}
+	if !v.F6.After(v.Start) {
+		return fmt.Errorf("F6 must be after: %v", v.Start)
+	}
+	if v.F7 <= time.Minute {
+		return errors.New("F7 must be greater than: 1m")
+	} else if v.F7 >= time.Hour {
+		return errors.New("F7 must be less than: 1h")
+	}
+	return nil
+}
+
+func (v UniqueValidator) Validate() error {
+	if idx, ok := valid.Unique(v.F1); !ok {
+		return fmt.Errorf("F1 must contain unique elements, duplicate at index: %d", idx)
+	}
+	if idx, ok := valid.Unique(v.F2[:]); !ok {
+		return fmt.Errorf("F2 must contain unique elements, duplicate at index: %d", idx)
+	}
+	if key, ok := valid.UniqueMap(v.F3); !ok {
+		return fmt.Errorf("F3 must contain unique elements, duplicate at key: %v", key)
+	}
+	if idx, ok := valid.UniqueFunc(len(v.F4), func(j int) int {
+		return v.F4[j].ID
+	}); !ok {
+		return fmt.Errorf("F4 must contain elements unique by field: \"ID\", duplicate at index: %d", idx)
+	}
+	if v.F5 != nil {
+		if idx, ok := valid.Unique(*v.F5); !ok {
+			return fmt.Errorf("F5 must contain unique elements, duplicate at index: %d", idx)
+		}
+	}
+	return nil
+}
+
+func (v ComposeValidator) Validate() error {
+	if strings.Contains(v.F1, "foo") {
+		return errors.New("F1 must not contain substring: \"foo\"")
+	}
+	if !(v.F2 != 1 && v.F2 != 2) {
+		return errors.New("F2 must not be equal to: 1 or 2")
+	}
+	if !valid.Email(v.F3) && !valid.UUID(v.F3, 4) {
+		return errors.New("F3 must be a valid email address or must be a valid UUID")
+	}
+	if len(v.F4) != 2 && v.F4 != v.Name {
+		return fmt.Errorf("F4 must be of length: 2 or must be equal to: %v", v.Name)
+	}
+	if v.F5 != nil {
+		if !valid.IP(*v.F5, 0) && !valid.FQDN(*v.F5) {
+			return errors.New("F5 must be a valid IP or must be a valid FQDN")
+		} else if strings.HasPrefix(*v.F5, "10.") {
+			return errors.New("F5 must not be prefixed with: \"10.\"")
+		}
+	}
+	if len(v.F6) == 3 {
+		return errors.New("F6 must not be of length: 3")
+	}
+	if valid.Match(v.F7, `^a%`) {
+		return errors.New("F7 must not match the regular expression: \"^a%\"")
+	}
+	if !valid.Email(v.F8) && !strings.HasPrefix(v.F8, "%") {
+		return errors.New("F8 must be a valid email address or must be prefixed with: \"%\"")
+	}
+	if v.F9.IsValid() {
+		return errors.New("F9 is valid")
+	}
+	return nil
+}
+
+func (v KeyValidator) Validate() error {
+	if v.E1 == "" {
+		return errors.New("E1 is required")
+	}
+	if v.E2 <= 0 {
+		return errors.New("E2 must be greater than: 0")
+	}
+	if v.F1 == "" {
+		return errors.New("f_one is required")
+	}
+	if v.F2 == "" {
+		return errors.New("- is required")
+	}
+	if v.F3.G1 == "" {
+		return errors.New("g1 is required")
+	}
+	if v.F4.F1 == "" {
+		return errors.New("F5.F1 is required")
+	}
+	if len(v.F5) != 2 {
+		return errors.New("F5-1 must be of length: 2")
+	}
+	if v.f6 == "" {
+		return errors.New("f6 is required")
+	}
+	return nil
+}
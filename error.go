@@ -0,0 +1,70 @@
+package valid
+
+import (
+	"strings"
+)
+
+// FieldError describes the failure of a single field to pass validation.
+// FieldError values are produced by the generated code when the tool's
+// "error_handling.field_errors" option is enabled.
+type FieldError struct {
+	// The key of the field that failed validation.
+	Key string `json:"key"`
+	// The value of the field that failed validation.
+	Value any `json:"value"`
+	// The name of the rule that the field failed.
+	Rule string `json:"rule"`
+	// The arguments of the rule, if any.
+	Args []any `json:"args,omitempty"`
+	// The human-readable error message.
+	Message string `json:"message"`
+}
+
+// Error implements the error interface.
+func (e *FieldError) Error() string {
+	return e.Message
+}
+
+// FieldErrors is a list of FieldError values. It is used by the generated
+// code to aggregate all of the failures that occur during a validation.
+type FieldErrors []*FieldError
+
+// Add appends a new FieldError, constructed from the given
+// arguments, to the end of the list.
+func (list *FieldErrors) Add(key string, val any, rule string, msg string, args ...any) {
+	*list = append(*list, &FieldError{
+		Key:     key,
+		Value:   val,
+		Rule:    rule,
+		Args:    args,
+		Message: msg,
+	})
+}
+
+// Error implements the error interface. The returned string
+// is the list's error messages separated by newlines.
+func (list FieldErrors) Error() string {
+	msgs := make([]string, len(list))
+	for i, e := range list {
+		msgs[i] = e.Message
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Unwrap returns the list's errors, it allows the use
+// of errors.Is and errors.As with the individual errors.
+func (list FieldErrors) Unwrap() []error {
+	errs := make([]error, len(list))
+	for i, e := range list {
+		errs[i] = e
+	}
+	return errs
+}
+
+// Err returns the list as an error, or nil if the list is empty.
+func (list FieldErrors) Err() error {
+	if len(list) == 0 {
+		return nil
+	}
+	return list
+}
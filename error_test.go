@@ -0,0 +1,46 @@
+package valid
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestFieldErrors(t *testing.T) {
+	var list FieldErrors
+	if err := list.Err(); err != nil {
+		t.Errorf("Err() got=%v; want=<nil>", err)
+	}
+
+	list.Add("name", "", "required", "name is required")
+	list.Add("age", 12, "min", "age must be greater than or equal to: 18", 18)
+
+	err := list.Err()
+	if err == nil {
+		t.Fatal("Err() got=<nil>; want=FieldErrors")
+	}
+	if got, want := err.Error(), "name is required\nage must be greater than or equal to: 18"; got != want {
+		t.Errorf("Error() got=%q; want=%q", got, want)
+	}
+
+	var fe *FieldError
+	if !errors.As(err, &fe) {
+		t.Fatal("errors.As(*FieldError) got=false; want=true")
+	}
+	if fe.Key != "name" || fe.Rule != "required" {
+		t.Errorf("errors.As(*FieldError) got=%+v", fe)
+	}
+	if !errors.Is(err, list[1]) {
+		t.Error("errors.Is(list[1]) got=false; want=true")
+	}
+
+	data, err := json.Marshal(list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `[{"key":"name","value":"","rule":"required","message":"name is required"},` +
+		`{"key":"age","value":12,"rule":"min","args":[18],"message":"age must be greater than or equal to: 18"}]`
+	if string(data) != want {
+		t.Errorf("json got=%s; want=%s", data, want)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/frk/valid/internal/algo"
 )
@@ -14,6 +15,17 @@ func btoi(v byte) int {
 	return i
 }
 
+// convenience func that returns v with all non-digit bytes removed
+func rmnondigit(v string) string {
+	b := make([]byte, 0, len(v))
+	for i := 0; i < len(v); i++ {
+		if v[i] >= '0' && v[i] <= '9' {
+			b = append(b, v[i])
+		}
+	}
+	return string(b)
+}
+
 // ISO 7064 (MOD 11, 10)
 func ISO7064_MOD11_10(v string) bool {
 	check := 10
@@ -31,6 +43,33 @@ func ISO7064_MOD11_10(v string) bool {
 	return btoi(v[10]) == 0
 }
 
+// CUIT (Clave Única de Identificación Tributaria); 11 digits, 2 digits
+// type, 8 digits document number, and a check digit (MOD 11), usually
+// formatted as XX-XXXXXXXX-X.
+// - https://es.wikipedia.org/wiki/Clave_%C3%9Anica_de_Identificaci%C3%B3n_Tributaria
+var vatAR = regexp.MustCompile(`^(?:20|23|24|27|30|33|34)-?[0-9]{8}-?[0-9]$`)
+var weightsAR = []int{5, 4, 3, 2, 7, 6, 5, 4, 3, 2}
+
+func arVAT(v string) bool {
+	if !vatAR.MatchString(v) {
+		return false
+	}
+	v = rmnondigit(v)
+
+	sum := 0
+	for i := 0; i < 10; i++ {
+		sum += btoi(v[i]) * weightsAR[i]
+	}
+
+	check := 11 - (sum % 11)
+	if check == 10 {
+		return false
+	} else if check == 11 {
+		check = 0
+	}
+	return check == btoi(v[10])
+}
+
 // 11 digit number formed from a 9 digit unique identifier and two prefix
 // check digits. The two leading digits (the check digits) will be derived
 // from the subsequent 9 digits using a modulus 89 check digit calculation.
@@ -73,18 +112,125 @@ func beVAT(v string) bool {
 	return num%97 == 97-chk
 }
 
+// CNPJ (Cadastro Nacional da Pessoa Jurídica); 14 characters, 8 characters
+// base number, 4 characters branch number, and 2 check digits (MOD 11),
+// usually formatted as XX.XXX.XXX/XXXX-XX. Since July 2026 the first 12
+// characters may also be uppercase letters, for the checksum calculation
+// every character's value is its ASCII code minus 48.
+// - https://en.wikipedia.org/wiki/CNPJ
+var vatBR = regexp.MustCompile(`^[0-9A-Z]{2}\.?[0-9A-Z]{3}\.?[0-9A-Z]{3}/?[0-9A-Z]{4}-?[0-9]{2}$`)
+var weightsBR = []int{6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+
+func brVAT(v string) bool {
+	if !vatBR.MatchString(v) {
+		return false
+	}
+	v = strings.NewReplacer(".", "", "/", "", "-", "").Replace(v)
+	if strings.Count(v, v[:1]) == len(v) {
+		return false
+	}
+
+	for n := 12; n <= 13; n++ {
+		sum := 0
+		for i := 0; i < n; i++ {
+			sum += int(v[i]-'0') * weightsBR[13-n+i]
+		}
+
+		check := 0
+		if mod := sum % 11; mod >= 2 {
+			check = 11 - mod
+		}
+		if check != btoi(v[n]) {
+			return false
+		}
+	}
+	return true
+}
+
+// BN (Business Number); 9 digits of which the last one is a check digit
+// (Luhn), optionally followed by a program identifier and a reference
+// number, e.g. 123456782RT0001 for the GST/HST account.
+// - https://www.canada.ca/en/revenue-agency/services/tax/businesses/topics/registering-your-business/you-need-a-business-number-a-program-account.html
+var vatCA = regexp.MustCompile(`^[0-9]{9}(?:RT[0-9]{4})?$`)
+
+func caVAT(v string) bool {
+	if !vatCA.MatchString(v) {
+		return false
+	}
+	return algo.Luhn(v[:9])
+}
+
 // 6 digits (up to 31 December 2013). CHE 9 numeric digits plus TVA/MWST/IVA
 // e.g. CHE-123.456.788 TVA[20] The last digit is a MOD11 checksum digit build
 // with weighting pattern: 5,4,3,2,7,6,5,4
 // - https://en.wikipedia.org/wiki/VAT_identification_number
-var vatCH = regexp.MustCompile(``) // TODO
+// - https://www.uid.admin.ch
+var vatCH = regexp.MustCompile(`^CHE[- ]?[0-9]{3}\.?[0-9]{3}\.?[0-9]{3}(?: ?(?:MWST|TVA|IVA|TPV))?$`)
+var weightsCH = []int{5, 4, 3, 2, 7, 6, 5, 4}
 
 func chVAT(v string) bool {
 	if !vatCH.MatchString(v) {
 		return false
 	}
-	// TODO
-	return false
+	v = rmnondigit(v)
+
+	sum := 0
+	for i := 0; i < 8; i++ {
+		sum += btoi(v[i]) * weightsCH[i]
+	}
+
+	check := 11 - (sum % 11)
+	if check == 10 {
+		return false
+	} else if check == 11 {
+		check = 0
+	}
+	return check == btoi(v[8])
+}
+
+// Resident Identity Card number; 18 characters, 6 digits address code,
+// 8 digits date of birth (YYYYMMDD), 3 digits sequence code, and a check
+// character (ISO 7064 MOD 11-2). The first-generation cards used 15 digits
+// where the date of birth is 6 digits (YYMMDD) and there's no check character.
+// - https://en.wikipedia.org/wiki/Resident_Identity_Card
+var ic15CN = regexp.MustCompile(`^[1-9][0-9]{7}(?:0[1-9]|1[0-2])(?:0[1-9]|[12][0-9]|3[01])[0-9]{3}$`)
+var ic18CN = regexp.MustCompile(`^[1-9][0-9]{5}[1-9][0-9]{3}(?:0[1-9]|1[0-2])(?:0[1-9]|[12][0-9]|3[01])[0-9]{3}[0-9Xx]$`)
+var weightsCN = []int{7, 9, 10, 5, 8, 4, 2, 1, 6, 3, 7, 9, 10, 5, 8, 4, 2}
+var provincesCN = map[string]bool{
+	"11": true, "12": true, "13": true, "14": true, "15": true,
+	"21": true, "22": true, "23": true,
+	"31": true, "32": true, "33": true, "34": true, "35": true, "36": true, "37": true,
+	"41": true, "42": true, "43": true, "44": true, "45": true, "46": true,
+	"50": true, "51": true, "52": true, "53": true, "54": true,
+	"61": true, "62": true, "63": true, "64": true, "65": true,
+	"71": true, "81": true, "82": true, "91": true,
+}
+
+func cnIC(v string) bool {
+	var dob string
+	if ic15CN.MatchString(v) {
+		dob = "19" + v[6:12]
+	} else if ic18CN.MatchString(v) {
+		dob = v[6:14]
+	} else {
+		return false
+	}
+
+	if !provincesCN[v[:2]] {
+		return false
+	}
+	if t, err := time.Parse("20060102", dob); err != nil || t.After(time.Now()) {
+		return false
+	}
+	if len(v) == 15 {
+		return true
+	}
+
+	sum := 0
+	for i := 0; i < 17; i++ {
+		sum += btoi(v[i]) * weightsCN[i]
+	}
+	return "10X98765432"[sum%11] == strings.ToUpper(v[17:])[0]
 }
 
 // 8 digits – e.g. DK99999999, last digit is check digit
@@ -117,6 +263,22 @@ func dkVAT(v string) bool {
 	return check == (11 - mod)
 }
 
+// Documento Nacional de Identidad (DNI) or, for foreigners, the Número de
+// Identidad de Extranjero (NIE); 8 digits, or X/Y/Z + 7 digits, followed by
+// a check letter computed from the number modulo 23.
+// - https://en.wikipedia.org/wiki/Documento_Nacional_de_Identidad_(Spain)
+var icES = regexp.MustCompile(`^[0-9X-Z][0-9]{7}[TRWAGMYFPDXBNJZSQVHLCKE]$`)
+
+func esIC(v string) bool {
+	v = strings.ToUpper(v)
+	if !icES.MatchString(v) {
+		return false
+	}
+
+	num, _ := strconv.Atoi(strings.NewReplacer("X", "0", "Y", "1", "Z", "2").Replace(v[:8]))
+	return "TRWAGMYFPDXBNJZSQVHLCKE"[num%23] == v[8]
+}
+
 // FI + 7 digits + check digit, e.g. FI99999999
 var vatFI = regexp.MustCompile(`^FI[0-9]{8}$`)
 var weigthsFI = []int{7, 9, 10, 5, 8, 4, 2}
@@ -147,6 +309,21 @@ func fiVAT(v string) bool {
 	return check == (11 - mod)
 }
 
+// Personal identity code (henkilötunnus); 6 digits date of birth (DDMMYY),
+// a century sign, 3 digits individual number, and a check character
+// computed from the 9 digit number modulo 31.
+// - https://dvv.fi/en/personal-identity-code
+var icFI = regexp.MustCompile(`^[0-9]{6}[-+A-FU-Y][0-9]{3}[0-9A-FHJ-NPR-Y]$`)
+
+func fiIC(v string) bool {
+	if !icFI.MatchString(v) {
+		return false
+	}
+
+	num, _ := strconv.Atoi(v[:6] + v[7:10])
+	return "0123456789ABCDEFHJKLMNPRSTUVWXY"[num%31] == v[10]
+}
+
 // - 'FR'+ 2 digits (as validation key ) + 9 digits (as SIREN), the first and/or the
 //   second value can also be a character (any except O or I) - e.g. FRXX999999999
 // References:
@@ -205,6 +382,71 @@ func grVAT(v string) bool {
 	return chk == (sum%11)%10
 }
 
+// Hong Kong Identity Card number; 1 or 2 letters, 6 digits, and a check
+// character (0-9 or A) which is usually written in brackets.
+// - https://en.wikipedia.org/wiki/Hong_Kong_identity_card
+var icHK = regexp.MustCompile(`^[A-Z]{1,2}[0-9]{6}(?:\([0-9A]\)|\[[0-9A]\]|[0-9A])$`)
+
+func hkIC(v string) bool {
+	v = strings.ToUpper(v)
+	if !icHK.MatchString(v) {
+		return false
+	}
+
+	v = strings.NewReplacer("(", "", ")", "", "[", "", "]", "").Replace(v)
+	if len(v) == 8 {
+		v = " " + v
+	}
+
+	sum := 0
+	for i := 0; i < 8; i++ {
+		var num int
+		switch c := v[i]; {
+		case c == ' ':
+			num = 36
+		case c >= 'A' && c <= 'Z':
+			num = int(c-'A') + 10
+		default:
+			num = btoi(c)
+		}
+		sum += num * (9 - i)
+	}
+
+	if check := (11 - sum%11) % 11; check == 10 {
+		return v[8] == 'A'
+	} else if v[8] == 'A' {
+		return false
+	} else {
+		return btoi(v[8]) == check
+	}
+}
+
+// 'GB'+ 9 digits (standard), or 12 digits (branch traders), the check
+// digits are calculated using the MOD 97 or the MOD 9755 algorithm.
+// Alternatively 'GB'+'GD'+ 3 digits (government departments, 000-499),
+// or 'GB'+'HA'+ 3 digits (health authorities, 500-999).
+// - https://en.wikipedia.org/wiki/VAT_identification_number
+// - https://www.gov.uk/guidance/vat-eu-country-codes-vat-numbers-and-vat-in-other-languages
+var vatGB = regexp.MustCompile(`^GB(?:[0-9]{9}|[0-9]{12}|GD[0-4][0-9]{2}|HA[5-9][0-9]{2})$`)
+var weightsGB = []int{8, 7, 6, 5, 4, 3, 2}
+
+func gbVAT(v string) bool {
+	if !vatGB.MatchString(v) {
+		return false
+	}
+	v = v[2:]
+	if v[:2] == "GD" || v[:2] == "HA" {
+		return true
+	}
+
+	sum := 0
+	for i := 0; i < 7; i++ {
+		sum += btoi(v[i]) * weightsGB[i]
+	}
+	sum += btoi(v[7])*10 + btoi(v[8])
+	return sum%97 == 0 || (sum+55)%97 == 0
+}
+
 // 'HR'+ 11 digit number, e.g. HR12345678901
 var vatHR = regexp.MustCompile(`^HR[0-9]{11}$`)
 
@@ -228,6 +470,18 @@ func ilVAT(v string) bool {
 	return algo.Luhn(v)
 }
 
+// Aadhaar number; 12 digits, the first digit is never 0 or 1, and the
+// last digit is a check digit computed using the Verhoeff algorithm.
+// - https://en.wikipedia.org/wiki/Aadhaar
+var icIN = regexp.MustCompile(`^[2-9][0-9]{3}[ ]?[0-9]{4}[ ]?[0-9]{4}$`)
+
+func inIC(v string) bool {
+	if !icIN.MatchString(v) {
+		return false
+	}
+	return algo.Verhoeff(strings.ReplaceAll(v, " ", ""))
+}
+
 var zipIN = regexp.MustCompile(`^[1-9][0-9]{2}[ ]?[0-9]{3}$`)
 var zipNegIN = regexp.MustCompile(`^(?:10|29|35|54|55|65|66|86|87|88|89)`)
 
@@ -235,6 +489,62 @@ func inZIP(v string) bool {
 	return zipIN.MatchString(v) && !zipNegIN.MatchString(v)
 }
 
+// National ID number (Kart-e Melli); 10 digits, the last one is a check
+// digit computed from the weighted sum of the first 9 digits modulo 11.
+var icIR = regexp.MustCompile(`^[0-9]{10}$`)
+
+func irIC(v string) bool {
+	if !icIR.MatchString(v) {
+		return false
+	}
+	if n, _ := strconv.Atoi(v[3:9]); n == 0 {
+		return false
+	}
+
+	sum := 0
+	for i := 0; i < 9; i++ {
+		sum += btoi(v[i]) * (10 - i)
+	}
+
+	chk := btoi(v[9])
+	if sum %= 11; sum < 2 {
+		return chk == sum
+	}
+	return chk == 11-sum
+}
+
+// VSK number; 5 or 6 digits depending on age of the company, or the
+// kennitala (national identification number); 10 digits, 6 digits date
+// of birth or registration, 2 digits, a check digit (MOD 11), and a
+// century digit, usually formatted as XXXXXX-XXXX.
+// - https://en.wikipedia.org/wiki/Icelandic_identification_number
+var vat1IS = regexp.MustCompile(`^[0-9]{5,6}$`)
+var vat2IS = regexp.MustCompile(`^[0-9]{6}-?[0-9]{3}[089]$`)
+var weightsIS = []int{3, 2, 7, 6, 5, 4, 3, 2}
+
+func isVAT(v string) bool {
+	if vat1IS.MatchString(v) {
+		return true
+	}
+	if !vat2IS.MatchString(v) {
+		return false
+	}
+	v = rmnondigit(v)
+
+	sum := 0
+	for i := 0; i < 8; i++ {
+		sum += btoi(v[i]) * weightsIS[i]
+	}
+
+	check := 11 - (sum % 11)
+	if check == 10 {
+		return false
+	} else if check == 11 {
+		check = 0
+	}
+	return check == btoi(v[8])
+}
+
 // 11 digits (the first 7 digits is a progressive number, the following 3
 // means the province of residence, the last digit is a check number
 // - The check digit is calculated using Luhn's Algorithm.)
@@ -247,6 +557,179 @@ func itVAT(v string) bool {
 	return algo.Luhn(v[2:])
 }
 
+// Either the number of the electronic identity card (Carta d'identità
+// elettronica); "C" + 1 letter + 5 digits + 2 letters, e.g. CA00000AA, or
+// the tax code (Codice Fiscale); 16 characters encoding the holder's name,
+// date & place of birth, and a check letter.
+// - https://it.wikipedia.org/wiki/Carta_d%27identit%C3%A0_elettronica_italiana
+// - https://en.wikipedia.org/wiki/Italian_fiscal_code
+var icCIEIT = regexp.MustCompile(`^C[A-Z][0-9]{5}[A-Z]{2}$`)
+var icCFIT = regexp.MustCompile(`^[A-Z]{6}[0-9LMNPQRSTUV]{2}[ABCDEHLMPRST][0-9LMNPQRSTUV]{2}[A-Z][0-9LMNPQRSTUV]{3}[A-Z]$`)
+
+// the values of the characters at odd positions, indexed by
+// their value at even positions (0-9 and A-Z map to 0-25)
+var oddCFIT = []int{1, 0, 5, 7, 9, 13, 15, 17, 19, 21, 2, 4, 18, 20, 11, 3, 6, 8, 12, 14, 16, 10, 22, 25, 24, 23}
+
+func itIC(v string) bool {
+	v = strings.ToUpper(v)
+	if icCIEIT.MatchString(v) {
+		return v != "CA00000AA"
+	}
+	if !icCFIT.MatchString(v) {
+		return false
+	}
+
+	sum := 0
+	for i := 0; i < 15; i++ {
+		num := int(v[i] - 'A')
+		if v[i] >= '0' && v[i] <= '9' {
+			num = btoi(v[i])
+		}
+		if i%2 == 0 {
+			num = oddCFIT[num]
+		}
+		sum += num
+	}
+	return byte('A'+sum%26) == v[15]
+}
+
+// Qualified invoice issuer registration number; 'T'+ 13 digits, the
+// 13 digits being the corporate number whose first digit is a check digit.
+// - https://www.invoice-kohyo.nta.go.jp
+// - https://www.houjin-bangou.nta.go.jp/en/setsumei
+var vatJP = regexp.MustCompile(`^T[1-9][0-9]{12}$`)
+
+func jpVAT(v string) bool {
+	if !vatJP.MatchString(v) {
+		return false
+	}
+	v = v[1:]
+
+	sum := 0
+	for i := 1; i <= 12; i++ {
+		num := btoi(v[13-i])
+		if i%2 == 0 {
+			num *= 2
+		}
+		sum += num
+	}
+	return 9-(sum%9) == btoi(v[0])
+}
+
+// Business registration number; 10 digits, 3 digits tax office code,
+// 2 digits business type, 4 digits serial number, and a check digit,
+// usually formatted as XXX-XX-XXXXX.
+var vatKR = regexp.MustCompile(`^[0-9]{3}-?[0-9]{2}-?[0-9]{5}$`)
+var weightsKR = []int{1, 3, 7, 1, 3, 7, 1, 3, 5}
+
+func krVAT(v string) bool {
+	if !vatKR.MatchString(v) {
+		return false
+	}
+	v = rmnondigit(v)
+
+	sum := 0
+	for i := 0; i < 9; i++ {
+		sum += btoi(v[i]) * weightsKR[i]
+	}
+	sum += (btoi(v[8]) * 5) / 10
+	return (10-(sum%10))%10 == btoi(v[9])
+}
+
+// RFC (Registro Federal de Contribuyentes); 12 characters for companies,
+// 3 letters, 6 digits date of registration (YYMMDD), and a 3 characters
+// homoclave of which the last one is a check character; or 13 characters
+// for individuals where the name part is 4 letters.
+// - https://es.wikipedia.org/wiki/Registro_Federal_de_Contribuyentes
+var vatMX = regexp.MustCompile(`^[A-ZÑ&]{3,4}[0-9]{2}(?:0[1-9]|1[0-2])(?:0[1-9]|[12][0-9]|3[01])[A-Z0-9]{2}[0-9A]$`)
+
+// the values of the characters used to calculate the check character
+var valuesMX = func() map[rune]int {
+	m := make(map[rune]int)
+	for i, r := range []rune("0123456789ABCDEFGHIJKLMN&OPQRSTUVWXYZ Ñ") {
+		m[r] = i
+	}
+	return m
+}()
+
+func mxVAT(v string) bool {
+	if !vatMX.MatchString(v) {
+		return false
+	}
+
+	rs := []rune(v)
+	if len(rs) == 12 {
+		rs = append([]rune{' '}, rs...)
+	}
+
+	sum := 0
+	for i := 0; i < 12; i++ {
+		sum += valuesMX[rs[i]] * (13 - i)
+	}
+	return rune("0123456789A"[(11-(sum%11))%11]) == rs[12]
+}
+
+// 'NO'+ 9 digits organisation number + 'MVA', the last digit of the
+// organisation number is a check digit (MOD 11).
+// - https://www.brreg.no/om-oss/registrene-vare/om-enhetsregisteret/organisasjonsnummeret/
+var vatNO = regexp.MustCompile(`^NO[0-9]{9}(?:MVA)?$`)
+var weightsNO = []int{3, 2, 7, 6, 5, 4, 3, 2}
+
+func noVAT(v string) bool {
+	if !vatNO.MatchString(v) {
+		return false
+	}
+	v = v[2:]
+
+	sum := 0
+	for i := 0; i < 8; i++ {
+		sum += btoi(v[i]) * weightsNO[i]
+	}
+
+	check := 11 - (sum % 11)
+	if check == 10 {
+		return false
+	} else if check == 11 {
+		check = 0
+	}
+	return check == btoi(v[8])
+}
+
+// IRD number; 8 or 9 digits in the range 10-000-000 to 150-000-000 of
+// which the last one is a check digit (MOD 11 with two sets of weights).
+var vatNZ = regexp.MustCompile(`^[0-9]{2,3}-?[0-9]{3}-?[0-9]{3}$`)
+var weights1NZ = []int{3, 2, 7, 6, 5, 4, 3, 2}
+var weights2NZ = []int{7, 4, 3, 2, 5, 2, 7, 6}
+
+func nzVAT(v string) bool {
+	if !vatNZ.MatchString(v) {
+		return false
+	}
+	v = rmnondigit(v)
+	if len(v) == 8 {
+		v = "0" + v
+	}
+	if n, _ := strconv.Atoi(v); n < 10000000 || n > 150000000 {
+		return false
+	}
+
+	for _, weights := range [][]int{weights1NZ, weights2NZ} {
+		sum := 0
+		for i := 0; i < 8; i++ {
+			sum += btoi(v[i]) * weights[i]
+		}
+
+		check := 0
+		if mod := sum % 11; mod != 0 {
+			check = 11 - mod
+		}
+		if check != 10 {
+			return check == btoi(v[8])
+		}
+	}
+	return false
+}
+
 // 10 digits, the last one is a check digit; for convenience the
 // digits are separated by hyphens (xxx-xxx-xx-xx or xxx-xx-xx-xxx
 // for legal people), but formally the number consists only of digits
@@ -277,6 +760,46 @@ func plVAT(v string) bool {
 	return check == (sum % 11)
 }
 
+// National identity number (fødselsnummer); 11 digits, 6 digits date of
+// birth, 3 digits individual number, and 2 check digits (MOD 11).
+// - https://en.wikipedia.org/wiki/National_identity_number_(Norway)
+var icNO = regexp.MustCompile(`^[0-9]{11}$`)
+var weights1NO = []int{3, 7, 6, 1, 8, 9, 4, 5, 2}
+var weights2NO = []int{5, 4, 3, 2, 7, 6, 5, 4, 3, 2}
+
+func noIC(v string) bool {
+	if !icNO.MatchString(v) || v == "00000000000" {
+		return false
+	}
+
+	sum1, sum2 := 0, 0
+	for i := 0; i < 10; i++ {
+		if i < 9 {
+			sum1 += btoi(v[i]) * weights1NO[i]
+		}
+		sum2 += btoi(v[i]) * weights2NO[i]
+	}
+	return (11-sum1%11)%11 == btoi(v[9]) && (11-sum2%11)%11 == btoi(v[10])
+}
+
+// PESEL number; 11 digits, 6 digits date of birth, 4 digits serial number
+// (the last of which indicates the sex), and a check digit.
+// - https://en.wikipedia.org/wiki/PESEL
+var icPL = regexp.MustCompile(`^[0-9]{11}$`)
+var weightsICPL = []int{1, 3, 7, 9, 1, 3, 7, 9, 1, 3}
+
+func plIC(v string) bool {
+	if !icPL.MatchString(v) {
+		return false
+	}
+
+	sum := 0
+	for i := 0; i < 10; i++ {
+		sum += btoi(v[i]) * weightsICPL[i]
+	}
+	return (10-sum%10)%10 == btoi(v[10])
+}
+
 // 9 digits; the last digit is the check digit. The first digit depends on
 // what the number refers to, e.g.: 1-3 are regular people, 5 are companies.
 var vat1PT = regexp.MustCompile(`^PT[0-9]{9}$`)
@@ -398,6 +921,33 @@ func siVAT(v string) bool {
 	return chk == res
 }
 
+// INN (Taxpayer Identification Number); 10 digits for organizations with
+// the last digit being a check digit, or 12 digits for individuals with
+// the last two digits being check digits.
+// - https://ru.wikipedia.org/wiki/Идентификационный_номер_налогоплательщика
+var vatRU = regexp.MustCompile(`^(?:[0-9]{10}|[0-9]{12})$`)
+var weightsRU = []int{3, 7, 2, 4, 10, 3, 5, 9, 4, 6, 8}
+
+func ruVAT(v string) bool {
+	if !vatRU.MatchString(v) {
+		return false
+	}
+
+	// n is the number of digits used to calculate a check digit
+	check := func(n int) bool {
+		sum := 0
+		for i := 0; i < n; i++ {
+			sum += btoi(v[i]) * weightsRU[len(weightsRU)-n+i]
+		}
+		return (sum%11)%10 == btoi(v[n])
+	}
+
+	if len(v) == 10 {
+		return check(9)
+	}
+	return check(10) && check(11)
+}
+
 // 'SK'+10 digits (number must be divisible by 11)
 var vatSK = regexp.MustCompile(`^SK[0-9]{10}$`)
 
@@ -410,6 +960,66 @@ func skVAT(v string) bool {
 	return (n % 11) == 0
 }
 
+// Thai national ID number; 13 digits, the first digit is the person's
+// category (1-8), the last digit is a check digit (MOD 11).
+var icTH = regexp.MustCompile(`^[1-8][0-9]{12}$`)
+
+func thIC(v string) bool {
+	if !icTH.MatchString(v) {
+		return false
+	}
+
+	sum := 0
+	for i := 0; i < 12; i++ {
+		sum += btoi(v[i]) * (13 - i)
+	}
+	return (11-sum%11)%10 == btoi(v[12])
+}
+
+// National Identification Card number; a letter followed by 9 digits, the
+// letter encodes the place of first registration and the last digit is a
+// check digit.
+// - https://en.wikipedia.org/wiki/National_identification_card_(Taiwan)
+var icTW = regexp.MustCompile(`^[A-Z][0-9]{9}$`)
+
+// the two digit codes of the first letter, indexed by the letter
+var lettersTW = []int{10, 11, 12, 13, 14, 15, 16, 17, 34, 18, 19, 20, 21, 22, 35, 23, 24, 25, 26, 27, 28, 29, 32, 30, 31, 33}
+
+func twIC(v string) bool {
+	v = strings.ToUpper(v)
+	if !icTW.MatchString(v) {
+		return false
+	}
+
+	code := lettersTW[v[0]-'A']
+	sum := code/10 + (code%10)*9
+	for i := 1; i < 9; i++ {
+		sum += btoi(v[i]) * (9 - i)
+	}
+	return (10-sum%10)%10 == btoi(v[9])
+}
+
+// VKN (Vergi Kimlik Numarası); 10 digits of which the last one is a
+// check digit.
+var vatTR = regexp.MustCompile(`^[0-9]{10}$`)
+
+func trVAT(v string) bool {
+	if !vatTR.MatchString(v) {
+		return false
+	}
+
+	sum := 0
+	for i := 1; i <= 9; i++ {
+		if n := (btoi(v[9-i]) + i) % 10; n != 0 {
+			if n = (n << i) % 9; n == 0 {
+				n = 9
+			}
+			sum += n
+		}
+	}
+	return (10-(sum%10))%10 == btoi(v[9])
+}
+
 // 9 digits
 // Companies: 20000000X-29999999X
 // People: 40000000X-79999999X
@@ -431,3 +1041,13 @@ func uzVAT(v string) bool {
 
 	return true
 }
+
+// 10 digits starting with 4, the last one is a check digit (Luhn).
+var vatZA = regexp.MustCompile(`^4[0-9]{9}$`)
+
+func zaVAT(v string) bool {
+	if !vatZA.MatchString(v) {
+		return false
+	}
+	return algo.Luhn(v)
+}
@@ -16,8 +16,15 @@ type Country struct {
 	Zip StringMatcher
 	// The validator for the country's phone numbers, may be nil.
 	Phone StringMatcher
+	// The metadata used for parsing & normalizing the country's
+	// phone numbers, may be nil.
+	PhoneMeta *PhoneMeta
 	//
 	VAT StringMatcher
+	// The validator for the country's identity card numbers, may be nil.
+	IdentityCard StringMatcher
+	// The validator for the country's passport numbers, may be nil.
+	Passport StringMatcher
 }
 
 func Get(cc string) (c Country, ok bool) {
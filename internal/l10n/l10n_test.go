@@ -187,6 +187,15 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"AF0549358",
+		},
+		Fail: []string{
+			"A1054935",
+			"",
+		},
 	}})
 	Run(t, []string{"AO", "AGO"}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -254,6 +263,31 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"AAC811035",
+		},
+		Fail: []string{
+			"A11811035",
+			"",
+		},
+	}, {
+		Name: "VAT", Func: valid.VAT,
+		Pass: []string{
+			"20267565393",
+			"20-26756539-3",
+			"30500010912",
+			"30-50001091-2",
+		},
+		Fail: []string{
+			"",
+			"20267565394",
+			"10267565393",
+			"2026756539",
+			"202675653931",
+			"20-2675653-93",
+		},
 	}})
 	Run(t, []string{"AS", "ASM"}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -296,6 +330,16 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"P 1630837",
+			"P 4366918",
+		},
+		Fail: []string{
+			"0 1630837",
+			"",
+		},
 	}})
 	Run(t, []string{"AU", "AUS"}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -333,6 +377,17 @@ func Test(t *testing.T) {
 			"41824753556",
 			"61824753556",
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"N0995852",
+			"L4819236",
+		},
+		Fail: []string{
+			"1A012345",
+			"A012345",
+			"",
+		},
 	}})
 	Run(t, []string{"AW", "ABW"}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -403,6 +458,19 @@ func Test(t *testing.T) {
 			"EN2020",
 			"AY3030",
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"C12345678",
+			"A12345678",
+		},
+		Fail: []string{
+			"AB1234567",
+			"123456789",
+			"1234567890",
+			"AB12345678",
+			"",
+		},
 	}})
 	Run(t, []string{"BA", "BIH"}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -534,6 +602,16 @@ func Test(t *testing.T) {
 			"BE0102239951",
 			"BE0431150351",
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"EM000000",
+			"LA080402",
+		},
+		Fail: []string{
+			"00123456",
+			"",
+		},
 	}})
 	Run(t, []string{"BF", "BFA"}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -584,6 +662,16 @@ func Test(t *testing.T) {
 			"BG12345678",
 			"BG12345678901",
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"346395366",
+			"039903356",
+		},
+		Fail: []string{
+			"ABC123456",
+			"",
+		},
 	}})
 	Run(t, []string{"BH", "BHR"}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -806,6 +894,37 @@ func Test(t *testing.T) {
 			"78908",
 			"13010|111",
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"FZ973689",
+			"GH231233",
+		},
+		Fail: []string{
+			"ABX29332",
+			"AB 92331",
+			"1234AB12",
+			"",
+		},
+	}, {
+		Name: "VAT", Func: valid.VAT,
+		Pass: []string{
+			"11222333000181",
+			"11.222.333/0001-81",
+			"00000000000191",
+			"12ABC34501DE35",
+			"12.ABC.345/01DE-35",
+		},
+		Fail: []string{
+			"",
+			"11222333000182",
+			"11.222.333/0001-80",
+			"00000000000000",
+			"11111111111111",
+			"1122233300018",
+			"12ABC34501DE36",
+			"12abc34501de35",
+		},
 	}})
 	Run(t, []string{"BS", ""}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -911,6 +1030,16 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"MP3899901",
+		},
+		Fail: []string{
+			"345333454",
+			"FG53334542",
+			"",
+		},
 	}})
 	Run(t, []string{"BZ", ""}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -955,6 +1084,33 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"GA302922",
+			"ZE000509",
+			"A123456AB",
+			"Z556378HG",
+		},
+		Fail: []string{
+			"AB0123456",
+			"123456AB",
+			"123456GH",
+			"",
+		},
+	}, {
+		Name: "VAT", Func: valid.VAT,
+		Pass: []string{
+			"123456782",
+			"123456782RT0001",
+		},
+		Fail: []string{
+			"",
+			"123456783",
+			"12345678",
+			"123456782RT001",
+			"123456782RC0001",
+		},
 	}})
 	Run(t, []string{"CC", ""}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -1024,7 +1180,7 @@ func Test(t *testing.T) {
 			//
 		},
 	}})
-	Run(t, []string{"CH", ""}, List{{
+	Run(t, []string{"CH", "CHE"}, List{{
 		Name: "Phone", Func: valid.Phone,
 		Pass: []string{
 			//
@@ -1040,6 +1196,34 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"S1100409",
+			"S5200073",
+			"X4028791",
+		},
+		Fail: []string{
+			"AB123456",
+			"",
+		},
+	}, {
+		Name: "VAT", Func: valid.VAT,
+		Pass: []string{
+			"CHE-116.281.710",
+			"CHE-116.281.710 MWST",
+			"CHE116281710",
+			"CHE-109.080.218 TVA",
+			"CHE-107.767.899 IVA",
+		},
+		Fail: []string{
+			"",
+			"CHE-116.281.711",
+			"CHE-116.281.71",
+			"116.281.710",
+			"CHE-116.281.710 VAT",
+			"CHE-109.080.219",
+		},
 	}})
 	Run(t, []string{"CI", ""}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -1167,6 +1351,41 @@ func Test(t *testing.T) {
 			"386789",
 			"ab1234",
 		},
+	}, {
+		Name: "IdentityCard", Func: valid.IdentityCard,
+		Pass: []string{
+			"235407195106112745",
+			"210203197503102721",
+			"520323197806058856",
+			"110101491001001",
+			"11010519491231002X",
+			"11010519491231002x",
+		},
+		Fail: []string{
+			"",
+			"110101491301001",
+			"235407195106112746",
+			"995407195106112745",
+			"21020319750310272",
+			"210203197513102721",
+			"2102031975031027211",
+		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"G25352389",
+			"E00160027",
+			"EA1234567",
+		},
+		Fail: []string{
+			"K0123456",
+			"E-1234567",
+			"G.1234567",
+			"GA1234567",
+			"EI0123456",
+			"GO0123456",
+			"",
+		},
 	}})
 	Run(t, []string{"CO", "COL"}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -1305,7 +1524,7 @@ func Test(t *testing.T) {
 			//
 		},
 	}})
-	Run(t, []string{"CY", ""}, List{{
+	Run(t, []string{"CY", "CYP"}, List{{
 		Name: "Phone", Func: valid.Phone,
 		Pass: []string{
 			//
@@ -1321,6 +1540,17 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"K00000413",
+		},
+		Fail: []string{
+			"K10100",
+			"P1234567",
+			"AB123456",
+			"",
+		},
 	}})
 	Run(t, []string{"CZ", "CZE"}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -1350,6 +1580,17 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"99003853",
+			"42747260",
+		},
+		Fail: []string{
+			"012345678",
+			"AB123456",
+			"",
+		},
 	}})
 	Run(t, []string{"DE", "DEU"}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -1387,6 +1628,17 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"C01X00T47",
+			"C26VMVVC3",
+		},
+		Fail: []string{
+			"AS0123456",
+			"A012345678",
+			"",
+		},
 	}})
 	Run(t, []string{"DJ", ""}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -1445,6 +1697,16 @@ func Test(t *testing.T) {
 			"DK38484641",
 			"DK31329567",
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"900010172",
+		},
+		Fail: []string{
+			"01234567",
+			"K01234567",
+			"",
+		},
 	}})
 	Run(t, []string{"DM", ""}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -1495,7 +1757,7 @@ func Test(t *testing.T) {
 			"123456",
 		},
 	}})
-	Run(t, []string{"DZ", ""}, List{{
+	Run(t, []string{"DZ", "DZA"}, List{{
 		Name: "Phone", Func: valid.Phone,
 		Pass: []string{
 			//
@@ -1511,6 +1773,21 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"855609385",
+			"154472412",
+			"197025599",
+		},
+		Fail: []string{
+			"AS0123456",
+			"A012345678",
+			"0123456789",
+			"12345678",
+			"98KK54321",
+			"",
+		},
 	}})
 	Run(t, []string{"EC", "ECU"}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -1571,6 +1848,19 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"K4218285",
+			"K3295867",
+			"KB0167630",
+			"VD0023777",
+		},
+		Fail: []string{
+			"K01234567",
+			"KB00112233",
+			"",
+		},
 	}})
 	Run(t, []string{"EG", "EGY"}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -1698,6 +1988,44 @@ func Test(t *testing.T) {
 			"ESXR9999999",
 			"ES9999999XR",
 		},
+	}, {
+		Name: "IdentityCard", Func: valid.IdentityCard,
+		Pass: []string{
+			"99999999R",
+			"12345678Z",
+			"01234567L",
+			"01234567l",
+			"X1234567l",
+			"x1234567l",
+			"X1234567L",
+			"Y1234567X",
+			"Z1234567R",
+		},
+		Fail: []string{
+			"",
+			"123456789",
+			"12345678A",
+			"12345 678Z",
+			"12345678-Z",
+			"1234*6789",
+			"1234*678Z",
+			"12345678!",
+			"1234567L",
+			"A1234567L",
+			"X1234567A",
+			"Y1234567B",
+			"Z1234567C",
+		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"AF238143",
+			"ZAB000254",
+		},
+		Fail: []string{
+			"AF01234567",
+			"",
+		},
 	}})
 	Run(t, []string{"ET", ""}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -1751,6 +2079,35 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "IdentityCard", Func: valid.IdentityCard,
+		Pass: []string{
+			"131052-308T",
+			"131052A308T",
+			"131052+308T",
+			"131052Y308T",
+			"010101-123N",
+		},
+		Fail: []string{
+			"",
+			"131052-308U",
+			"131052-308t",
+			"131052G308T",
+			"131052308T",
+			"1310523-308T",
+			"13105-2308T",
+		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"XP8271602",
+			"XD8500003",
+		},
+		Fail: []string{
+			"A01234567",
+			"ABC012345",
+			"",
+		},
 	}})
 	Run(t, []string{"FJ", "FJI"}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -1893,6 +2250,19 @@ func Test(t *testing.T) {
 			"FR428134547171",
 			"FR84323140391",
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"10CV28144",
+			"60RF19342",
+			"05RP34083",
+		},
+		Fail: []string{
+			"012345678",
+			"AB0123456",
+			"01C234567",
+			"",
+		},
 	}})
 	Run(t, []string{"GA", ""}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -1949,6 +2319,38 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"925076473",
+			"107182890",
+			"104121156",
+		},
+		Fail: []string{
+			"A012345678",
+			"K000000000",
+			"0123456789",
+			"",
+		},
+	}, {
+		Name: "VAT", Func: valid.VAT,
+		Pass: []string{
+			"GB999999973",
+			"GB980780684",
+			"GB434031494",
+			"GB999999973001",
+			"GBGD001",
+			"GBHA500",
+		},
+		Fail: []string{
+			"",
+			"GB999999974",
+			"GB99999997",
+			"GB9999999730",
+			"GBGD500",
+			"GBHA499",
+			"999999973",
+		},
 	}})
 	Run(t, []string{"GD", ""}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -2249,6 +2651,17 @@ func Test(t *testing.T) {
 		Name: "VAT", Func: valid.VAT,
 		Pass: []string{},
 		Fail: []string{},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"AE0000005",
+			"AK0219304",
+		},
+		Fail: []string{
+			"A01234567",
+			"012345678",
+			"",
+		},
 	}})
 	Run(t, []string{"GS", ""}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -2363,6 +2776,26 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "IdentityCard", Func: valid.IdentityCard,
+		Pass: []string{
+			"A123456(3)",
+			"A1234563",
+			"A123456[3]",
+			"a123456(3)",
+			"Q946295(A)",
+			"Q946295A",
+		},
+		Fail: []string{
+			"",
+			"A123456(4)",
+			"A123456(A)",
+			"A12345(3)",
+			"A1234567(3)",
+			"ABC123456(3)",
+			"A123456(3",
+			"1234567(3)",
+		},
 	}})
 	Run(t, []string{"HM", ""}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -2409,7 +2842,7 @@ func Test(t *testing.T) {
 			//
 		},
 	}})
-	Run(t, []string{"HR", ""}, List{{
+	Run(t, []string{"HR", "HRV"}, List{{
 		Name: "Phone", Func: valid.Phone,
 		Pass: []string{
 			//
@@ -2425,6 +2858,17 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"007007007",
+			"138463188",
+		},
+		Fail: []string{
+			"A01234567",
+			"00112233",
+			"",
+		},
 	}})
 	Run(t, []string{"HT", "HTI"}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -2445,7 +2889,7 @@ func Test(t *testing.T) {
 			"AA1234",
 		},
 	}})
-	Run(t, []string{"HU", ""}, List{{
+	Run(t, []string{"HU", "HUN"}, List{{
 		Name: "Phone", Func: valid.Phone,
 		Pass: []string{
 			//
@@ -2461,6 +2905,18 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"ZA084505",
+			"BA0006902",
+		},
+		Fail: []string{
+			"0123456789",
+			"00AA00112",
+			"0424",
+			"",
+		},
 	}})
 	Run(t, []string{"ID", "IDN"}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -2557,6 +3013,19 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"C1253473",
+			"B5948378",
+			"A4859472",
+		},
+		Fail: []string{
+			"D39481728",
+			"A-3847362",
+			"324132132",
+			"",
+		},
 	}})
 	Run(t, []string{"IE", "IRL"}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -2613,6 +3082,18 @@ func Test(t *testing.T) {
 			"IE1A234567",
 			"IE1-23456B",
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"D23145890",
+			"X65097105",
+			"XN0019390",
+		},
+		Fail: []string{
+			"XND012345",
+			"0123456789",
+			"",
+		},
 	}})
 	Run(t, []string{"IL", "ISR"}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -2650,6 +3131,32 @@ func Test(t *testing.T) {
 			"881123",
 			"891123",
 		},
+	}, {
+		Name: "IdentityCard", Func: valid.IdentityCard,
+		Pass: []string{
+			"219472156",
+			"219486610",
+			"219488962",
+			"219566726",
+			"219640216",
+			"219645041",
+			"334795465",
+			"335211686",
+			"335240479",
+			"335472171",
+			"336999842",
+			"337090443",
+			"039643259",
+			"123456782",
+		},
+		Fail: []string{
+			"",
+			"123456789",
+			"12345678",
+			"1234567890",
+			"12345678A",
+			"039643258",
+		},
 	}})
 	Run(t, []string{"IM", ""}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -2698,6 +3205,37 @@ func Test(t *testing.T) {
 			"881123",
 			"891123",
 		},
+	}, {
+		Name: "IdentityCard", Func: valid.IdentityCard,
+		Pass: []string{
+			"298448863364",
+			"2984 4886 3364",
+		},
+		Fail: []string{
+			"",
+			"99999999R",
+			"12345678Z",
+			"01234567L",
+			"123456789",
+			"0123456789012",
+			"298448863365",
+			"2984 4886 3365",
+			"1234 5678 9012",
+			"29844886336",
+			"2984-4886-3364",
+		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"A-1234567",
+			"A1234567",
+			"X0019390",
+		},
+		Fail: []string{
+			"AB-1234567",
+			"0123456789",
+			"",
+		},
 	}})
 	Run(t, []string{"IO", ""}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -2768,8 +3306,41 @@ func Test(t *testing.T) {
 			"123443516 6456",
 			"891123",
 		},
+	}, {
+		Name: "IdentityCard", Func: valid.IdentityCard,
+		Pass: []string{
+			"0499370899",
+			"0790419904",
+			"0084575948",
+			"0963695398",
+			"0012345679",
+			"1234567891",
+			"9870001238",
+		},
+		Fail: []string{
+			"",
+			"0499370898",
+			"0012345678",
+			"1230000001",
+			"123456789",
+			"12345678901",
+			"123456789A",
+		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"J97634522",
+			"A01234567",
+			"Z11977831",
+		},
+		Fail: []string{
+			"A0123456",
+			"A0123456Z",
+			"012345678",
+			"",
+		},
 	}})
-	Run(t, []string{"IS", ""}, List{{
+	Run(t, []string{"IS", "ISL"}, List{{
 		Name: "Phone", Func: valid.Phone,
 		Pass: []string{
 			//
@@ -2785,6 +3356,32 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"A2040611",
+			"A1197783",
+		},
+		Fail: []string{
+			"K0000000",
+			"01234567",
+			"",
+		},
+	}, {
+		Name: "VAT", Func: valid.VAT,
+		Pass: []string{
+			"12345",
+			"123456",
+			"1201743399",
+			"120174-3399",
+		},
+		Fail: []string{
+			"",
+			"1234",
+			"1201743389",
+			"1201743391",
+			"120174 3399",
+		},
 	}})
 	Run(t, []string{"IT", "ITA"}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -2815,6 +3412,36 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "IdentityCard", Func: valid.IdentityCard,
+		Pass: []string{
+			"CR43675TM",
+			"CA79382RA",
+			"ca79382ra",
+			"RSSMRA85T10A562S",
+			"MRTMTT91D08F205J",
+		},
+		Fail: []string{
+			"",
+			"CA00000AA",
+			"CB2342TG",
+			"CS12345A",
+			"C123456AS",
+			"RSSMRA85T10A562T",
+			"RSSMRA85Z10A562S",
+			"RSSMRA85T10A562",
+		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"YA8335453",
+			"KK0000000",
+		},
+		Fail: []string{
+			"01234567",
+			"KAK001122",
+			"",
+		},
 	}})
 	Run(t, []string{"JE", ""}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -2833,7 +3460,7 @@ func Test(t *testing.T) {
 			//
 		},
 	}})
-	Run(t, []string{"JM", ""}, List{{
+	Run(t, []string{"JM", "JAM"}, List{{
 		Name: "Phone", Func: valid.Phone,
 		Pass: []string{
 			//
@@ -2849,6 +3476,17 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"A0123456",
+			"a0123456",
+		},
+		Fail: []string{
+			"s0123456",
+			"a01234567",
+			"",
+		},
 	}})
 	Run(t, []string{"JO", "JOR"}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -2931,6 +3569,32 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"NH1106002",
+			"TE3180251",
+			"XS1234567",
+		},
+		Fail: []string{
+			"X12345678",
+			"012345678",
+			"",
+		},
+	}, {
+		Name: "VAT", Func: valid.VAT,
+		Pass: []string{
+			"T5835678256246",
+			"T1180301018771",
+			"T9234567890123",
+		},
+		Fail: []string{
+			"",
+			"5835678256246",
+			"T5835678256247",
+			"T0835678256246",
+			"T583567825624",
+		},
 	}})
 	Run(t, []string{"KE", "KEN"}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -3092,10 +3756,35 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: valid.Zip,
 		Pass: []string{
-			//
+			//
+		},
+		Fail: []string{
+			//
+		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"M35772699",
+			"M70689098",
+		},
+		Fail: []string{
+			"X12345678",
+			"012345678",
+			"00123456",
+			"",
+		},
+	}, {
+		Name: "VAT", Func: valid.VAT,
+		Pass: []string{
+			"1208147521",
+			"120-81-47521",
+			"2208162517",
 		},
 		Fail: []string{
-			//
+			"",
+			"1208147522",
+			"120-81-4752",
+			"12081475211",
 		},
 	}})
 	Run(t, []string{"KW", "KWT"}, List{{
@@ -3170,6 +3859,17 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"a0123456",
+			"N0123456",
+		},
+		Fail: []string{
+			"08012345",
+			"00123456",
+			"",
+		},
 	}})
 	Run(t, []string{"LA", ""}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -3257,8 +3957,18 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"a01234",
+			"f01234",
+		},
+		Fail: []string{
+			"012345",
+			"",
+		},
 	}})
-	Run(t, []string{"LK", ""}, List{{
+	Run(t, []string{"LK", "LKA"}, List{{
 		Name: "Phone", Func: valid.Phone,
 		Pass: []string{
 			//
@@ -3274,6 +3984,24 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "IdentityCard", Func: valid.IdentityCard,
+		Pass: []string{
+			"722222222v",
+			"722222222V",
+			"993151225x",
+			"993151225X",
+			"200193104975",
+		},
+		Fail: []string{
+			"",
+			"023151225X",
+			"993151225",
+			"99315122569",
+			"2001931049755",
+			"20019310497A",
+			"072222222v",
+		},
 	}})
 	Run(t, []string{"LR", ""}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -3332,6 +4060,16 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"20200997",
+			"LB311756",
+		},
+		Fail: []string{
+			"LB01234567",
+			"",
+		},
 	}})
 	Run(t, []string{"LU", "LUX"}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -3357,8 +4095,19 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"JCU9J4T2",
+			"JC4E7L2H",
+		},
+		Fail: []string{
+			"JCU9J4T",
+			"JC4E7L2H0",
+			"",
+		},
 	}})
-	Run(t, []string{"LV", ""}, List{{
+	Run(t, []string{"LV", "LVA"}, List{{
 		Name: "Phone", Func: valid.Phone,
 		Pass: []string{
 			//
@@ -3374,6 +4123,17 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"LV9000339",
+			"LV4017173",
+		},
+		Fail: []string{
+			"LV01234567",
+			"4017173LV",
+			"",
+		},
 	}})
 	Run(t, []string{"LY", "LBY"}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -3407,6 +4167,31 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "IdentityCard", Func: valid.IdentityCard,
+		Pass: []string{
+			"119803455876",
+			"219803455876",
+		},
+		Fail: []string{
+			"",
+			"319803455876",
+			"11980345587",
+			"1198034558765",
+			"11980345587A",
+		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"P79JF34X",
+			"RJ45H4V2",
+		},
+		Fail: []string{
+			"P79JF34",
+			"RJ45H4V2C",
+			"RJ4-H4V2",
+			"",
+		},
 	}})
 	Run(t, []string{"MA", "MAR"}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -3755,6 +4540,16 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"1026564",
+		},
+		Fail: []string{
+			"01234567",
+			"MT01234",
+			"",
+		},
 	}})
 	Run(t, []string{"MU", "MUS"}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -3856,6 +4651,32 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"43986369222",
+			"01234567890",
+		},
+		Fail: []string{
+			"ABC34567890",
+			"34567890",
+			"",
+		},
+	}, {
+		Name: "VAT", Func: valid.VAT,
+		Pass: []string{
+			"GODE561231GR8",
+			"MAB9307148T4",
+			"VECJ880326XX1",
+		},
+		Fail: []string{
+			"",
+			"GODE561231GR9",
+			"MAB9307148T5",
+			"GODE561331GR8",
+			"GO561231GR8",
+			"gode561231gr8",
+		},
 	}})
 	Run(t, []string{"MY", "MYS"}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -3894,8 +4715,20 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"A00000000",
+			"H12345678",
+			"K43143233",
+		},
+		Fail: []string{
+			"A1234567",
+			"C12345678",
+			"",
+		},
 	}})
-	Run(t, []string{"MZ", ""}, List{{
+	Run(t, []string{"MZ", "MOZ"}, List{{
 		Name: "Phone", Func: valid.Phone,
 		Pass: []string{
 			//
@@ -3911,6 +4744,18 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"AB0808212",
+			"08AB12123",
+		},
+		Fail: []string{
+			"1AB011241",
+			"1AB01121",
+			"ABAB01121",
+			"",
+		},
 	}})
 	Run(t, []string{"NA", ""}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -4062,6 +4907,17 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"XTR110131",
+			"XR1001R58",
+		},
+		Fail: []string{
+			"XTR11013R",
+			"XR1001R58A",
+			"",
+		},
 	}})
 	Run(t, []string{"NO", "NOR"}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -4091,6 +4947,38 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "IdentityCard", Func: valid.IdentityCard,
+		Pass: []string{
+			"01010750160",
+			"15076500565",
+			"31129912319",
+			"01018045679",
+			"29020098749",
+		},
+		Fail: []string{
+			"",
+			"00000000000",
+			"15076500566",
+			"15076500575",
+			"1507650056",
+			"150765005651",
+			"1507650056A",
+		},
+	}, {
+		Name: "VAT", Func: valid.VAT,
+		Pass: []string{
+			"NO923609016",
+			"NO923609016MVA",
+			"NO984768540MVA",
+		},
+		Fail: []string{
+			"",
+			"923609016",
+			"NO923609017MVA",
+			"NO92360901MVA",
+			"NO923609016VAT",
+		},
 	}})
 	Run(t, []string{"NP", "NPL"}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -4191,6 +5079,42 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"Lf012345",
+			"La012345",
+			"Ld012345",
+			"Lh012345",
+			"ea012345",
+			"ep012345",
+			"n012345",
+		},
+		Fail: []string{
+			"Lp012345",
+			"nd012345",
+			"ed012345",
+			"eh012345",
+			"ef012345",
+			"",
+		},
+	}, {
+		Name: "VAT", Func: valid.VAT,
+		Pass: []string{
+			"49091850",
+			"49-091-850",
+			"35901981",
+			"49098576",
+			"136410132",
+			"136-410-132",
+		},
+		Fail: []string{
+			"",
+			"136410133",
+			"9125568",
+			"150000001",
+			"4909185",
+		},
 	}})
 	Run(t, []string{"OM", "OMN"}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -4328,8 +5252,21 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"X123456",
+			"XY123456",
+			"XY1234567",
+			"X1234567Y",
+		},
+		Fail: []string{
+			"XY123456789",
+			"X12345678",
+			"",
+		},
 	}})
-	Run(t, []string{"PK", ""}, List{{
+	Run(t, []string{"PK", "PAK"}, List{{
 		Name: "Phone", Func: valid.Phone,
 		Pass: []string{
 			//
@@ -4345,6 +5282,17 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"QZ1791293",
+			"XS8192409",
+		},
+		Fail: []string{
+			"QZ179129",
+			"XS81924091",
+			"",
+		},
 	}})
 	Run(t, []string{"PL", "POL"}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -4394,6 +5342,40 @@ func Test(t *testing.T) {
 		Fail: []string{
 			"PL123-456-78-90",
 		},
+	}, {
+		Name: "IdentityCard", Func: valid.IdentityCard,
+		Pass: []string{
+			"99012229019",
+			"09210215408",
+			"20313034701",
+			"86051575214",
+			"77334586883",
+			"54007481320",
+			"06566860643",
+			"77552478861",
+			"44051401359",
+		},
+		Fail: []string{
+			"",
+			"aaa",
+			"5",
+			"195",
+			"44051401358",
+			"4405140135",
+			"440514013590",
+			"4405140135A",
+		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"ZS 0000177",
+			"AN 3000011",
+		},
+		Fail: []string{
+			"A1 0000177",
+			"012345678",
+			"",
+		},
 	}})
 	Run(t, []string{"PM", ""}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -4493,6 +5475,17 @@ func Test(t *testing.T) {
 		Fail: []string{
 			"PT999999999",
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"I700044",
+			"K453286",
+		},
+		Fail: []string{
+			"0700044",
+			"K4532861",
+			"",
+		},
 	}})
 	Run(t, []string{"PW", ""}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -4639,6 +5632,16 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"05485968",
+			"040005646",
+		},
+		Fail: []string{
+			"R05485968",
+			"",
+		},
 	}})
 	Run(t, []string{"RS", "SRB"}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -4695,6 +5698,35 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"2 32 636829",
+			"012 345321",
+			"439863692",
+		},
+		Fail: []string{
+			"A 2R YU46J0",
+			"01A 3D5321",
+			"SF233D53T",
+			"12345678",
+			"1234567890",
+			"",
+		},
+	}, {
+		Name: "VAT", Func: valid.VAT,
+		Pass: []string{
+			"7707083893",
+			"500100732259",
+		},
+		Fail: []string{
+			"",
+			"7707083894",
+			"500100732258",
+			"500100732269",
+			"77070838931",
+			"770708389",
+		},
 	}})
 	Run(t, []string{"RW", "RWA"}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -4837,6 +5869,17 @@ func Test(t *testing.T) {
 			"SE556293998301",
 			"SE556293998101",
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"59000001",
+			"56702690",
+		},
+		Fail: []string{
+			"SE012345",
+			"012345678",
+			"",
+		},
 	}})
 	Run(t, []string{"SG", "SGP"}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -4912,6 +5955,17 @@ func Test(t *testing.T) {
 			"SI99662982",
 			"SI19136235",
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"PB0036440",
+			"PB1390281",
+		},
+		Fail: []string{
+			"SB0036440",
+			"PB0036440A",
+			"",
+		},
 	}})
 	Run(t, []string{"SJ", ""}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -4954,6 +6008,16 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"P0000000",
+		},
+		Fail: []string{
+			"SK012345",
+			"012345678",
+			"",
+		},
 	}})
 	Run(t, []string{"SL", "SLE"}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -5260,6 +6324,37 @@ func Test(t *testing.T) {
 			"T72170",
 			"12140TH",
 		},
+	}, {
+		Name: "IdentityCard", Func: valid.IdentityCard,
+		Pass: []string{
+			"1101230000019",
+			"3101700000016",
+			"5600401234563",
+			"8000000000006",
+		},
+		Fail: []string{
+			"",
+			"1101230000011",
+			"0101230000019",
+			"9101230000019",
+			"110123000001",
+			"11012300000190",
+			"110123000001A",
+		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"A123456",
+			"B1234567",
+			"CD123456",
+			"EF1234567",
+		},
+		Fail: []string{
+			"123456789",
+			"AB12345678",
+			"ABC123456",
+			"",
+		},
 	}})
 	Run(t, []string{"TJ", ""}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -5351,6 +6446,18 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "IdentityCard", Func: valid.IdentityCard,
+		Pass: []string{
+			"09958092",
+			"12345678",
+		},
+		Fail: []string{
+			"",
+			"0995809",
+			"099580921",
+			"0995809A",
+		},
 	}})
 	Run(t, []string{"TO", ""}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -5369,7 +6476,7 @@ func Test(t *testing.T) {
 			//
 		},
 	}})
-	Run(t, []string{"TR", ""}, List{{
+	Run(t, []string{"TR", "TUR"}, List{{
 		Name: "Phone", Func: valid.Phone,
 		Pass: []string{
 			//
@@ -5385,6 +6492,29 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"U 06764100",
+			"U 01048537",
+		},
+		Fail: []string{
+			"06764100U",
+			"010485371",
+			"",
+		},
+	}, {
+		Name: "VAT", Func: valid.VAT,
+		Pass: []string{
+			"4540536920",
+			"0010151231",
+		},
+		Fail: []string{
+			"",
+			"4540536921",
+			"454053692",
+			"45405369201",
+		},
 	}})
 	Run(t, []string{"TT", ""}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -5443,6 +6573,23 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "IdentityCard", Func: valid.IdentityCard,
+		Pass: []string{
+			"A123456789",
+			"B212345670",
+			"F131502204",
+			"Z187654324",
+			"a123456789",
+		},
+		Fail: []string{
+			"",
+			"A123456788",
+			"123456789",
+			"AB23456789",
+			"A12345678",
+			"A1234567890",
+		},
 	}})
 	Run(t, []string{"TZ", "TZA"}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -5497,6 +6644,19 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"EH345655",
+			"EK000001",
+			"AP841503",
+		},
+		Fail: []string{
+			"01234567",
+			"012345EH",
+			"A012345P",
+			"",
+		},
 	}})
 	Run(t, []string{"UG", "UGA"}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -5564,6 +6724,18 @@ func Test(t *testing.T) {
 			"+2(267)362-8910",
 			"+3365520145",
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"790369937",
+			"340007237",
+		},
+		Fail: []string{
+			"US0123456",
+			"0123456US",
+			"7903699371",
+			"",
+		},
 	}})
 	Run(t, []string{"UY", "URY"}, List{{
 		Name: "Phone", Func: valid.Phone,
@@ -5855,6 +7027,33 @@ func Test(t *testing.T) {
 		Fail: []string{
 			//
 		},
+	}, {
+		Name: "Passport", Func: valid.PassportNumber,
+		Pass: []string{
+			"T12345678",
+			"A12345678",
+			"M12345678",
+			"D12345678",
+		},
+		Fail: []string{
+			"123456789",
+			"Z12345678",
+			"T1234567",
+			"",
+		},
+	}, {
+		Name: "VAT", Func: valid.VAT,
+		Pass: []string{
+			"4000000002",
+			"4123456784",
+			"4801234560",
+		},
+		Fail: []string{
+			"",
+			"4000000001",
+			"5000000008",
+			"400000000",
+		},
 	}})
 	Run(t, []string{"ZM", "ZMB"}, List{{
 		Name: "Phone", Func: valid.Phone,
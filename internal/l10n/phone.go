@@ -0,0 +1,228 @@
+package l10n
+
+import (
+	"regexp"
+)
+
+// PhoneMeta holds the metadata needed to parse, classify, and normalize
+// the phone numbers of a single country.
+//
+// The Mobile, Fixed, and TollFree matchers are applied to the national
+// significant number, i.e. the number's digits without the country calling
+// code, without the national prefix, and without any formatting.
+type PhoneMeta struct {
+	// ISO 3166-1 Alpha-2 of the country to which the metadata belongs.
+	A2 string
+	// The country calling code, without the leading "+".
+	CallingCode string
+	// The prefix used to dial an international number from
+	// within the country, e.g. "00" or "011".
+	IntlPrefix string
+	// The national (trunk) prefix, e.g. "0", may be empty if
+	// the country does not use a national prefix.
+	NationalPrefix string
+	// Main is set for the country that should be tried first
+	// when the calling code is shared by multiple countries.
+	Main bool
+	// The matchers for the country's mobile, fixed-line, and toll-free
+	// numbers. Mobile & Fixed may both match the same number in countries
+	// where the two cannot be told apart. Each of these may be nil.
+	Mobile   StringMatcher
+	Fixed    StringMatcher
+	TollFree StringMatcher
+}
+
+// PhoneCallingCode returns the metadata of the countries that share the
+// given calling code. The returned slice is nil if the calling code
+// is not known.
+func PhoneCallingCode(code string) []*PhoneMeta {
+	return phoneccidx[code]
+}
+
+// phonemeta & phoneccidx index the phonetab by ISO 3166-1 Alpha-2 and by
+// calling code respectively. These are initialized as package-level variables
+// rather than in an init func so that they are ready by the time the cctab
+// is being indexed.
+var phonemeta, phoneccidx = indexphonetab(phonetab)
+
+func indexphonetab(tab []*PhoneMeta) (map[string]*PhoneMeta, map[string][]*PhoneMeta) {
+	a2 := make(map[string]*PhoneMeta)
+	cc := make(map[string][]*PhoneMeta)
+	for _, m := range tab {
+		a2[m.A2] = m
+		if m.Main {
+			cc[m.CallingCode] = append([]*PhoneMeta{m}, cc[m.CallingCode]...)
+		} else {
+			cc[m.CallingCode] = append(cc[m.CallingCode], m)
+		}
+	}
+	return a2, cc
+}
+
+var (
+	// North American Numbering Plan
+	rxNANP         = regexp.MustCompile(`^[2-9][0-9]{2}[2-9][0-9]{6}$`)
+	rxNANPTollFree = regexp.MustCompile(`^8(?:00|33|44|55|66|77|88)[2-9][0-9]{6}$`)
+	rxNANPCA       = regexp.MustCompile(`^(?:204|226|236|249|250|263|289|306|343|354|` +
+		`365|367|368|382|387|403|416|418|428|431|437|438|450|460|468|474|506|` +
+		`514|519|548|579|581|584|587|604|613|639|647|672|683|705|709|742|753|` +
+		`778|780|782|807|819|825|867|873|879|902|905)[2-9][0-9]{6}$`)
+)
+
+// usPhone matches NANP numbers that are not assigned to a Canadian area code.
+func usPhone(v string) bool {
+	return rxNANP.MatchString(v) && !rxNANPCA.MatchString(v)
+}
+
+// phonetab holds the metadata of the supported countries. The patterns are
+// simplified versions of those found in Google's libphonenumber metadata,
+// they are good enough to tell the number types apart but they are not
+// meant to be exhaustive.
+var phonetab = []*PhoneMeta{{
+	A2: "AT", CallingCode: "43", IntlPrefix: "00", NationalPrefix: "0",
+	Mobile:   regexp.MustCompile(`^6(?:5[0-3579]|6[013-9]|[7-9][0-9])[0-9]{4,10}$`),
+	Fixed:    regexp.MustCompile(`^(?:1[0-9]{3,12}|2[0-9]{6,12}|[3-57][0-9]{4,12})$`),
+	TollFree: regexp.MustCompile(`^800[0-9]{6,10}$`),
+}, {
+	A2: "AU", CallingCode: "61", IntlPrefix: "0011", NationalPrefix: "0",
+	Mobile:   regexp.MustCompile(`^4[0-9]{8}$`),
+	Fixed:    regexp.MustCompile(`^[2378][0-9]{8}$`),
+	TollFree: regexp.MustCompile(`^1800[0-9]{6}$`),
+}, {
+	A2: "BE", CallingCode: "32", IntlPrefix: "00", NationalPrefix: "0",
+	Mobile:   regexp.MustCompile(`^4[5-9][0-9]{7}$`),
+	Fixed:    regexp.MustCompile(`^[1-9][0-9]{7}$`),
+	TollFree: regexp.MustCompile(`^800[1-9][0-9]{4}$`),
+}, {
+	A2: "BR", CallingCode: "55", IntlPrefix: "00", NationalPrefix: "0",
+	Mobile:   regexp.MustCompile(`^[1-9][1-9]9[0-9]{8}$`),
+	Fixed:    regexp.MustCompile(`^[1-9][1-9][2-5][0-9]{7}$`),
+	TollFree: regexp.MustCompile(`^800[0-9]{6,7}$`),
+}, {
+	A2: "CA", CallingCode: "1", IntlPrefix: "011", NationalPrefix: "1",
+	Mobile:   rxNANPCA,
+	Fixed:    rxNANPCA,
+	TollFree: rxNANPTollFree,
+}, {
+	A2: "CH", CallingCode: "41", IntlPrefix: "00", NationalPrefix: "0",
+	Mobile:   regexp.MustCompile(`^7[5-9][0-9]{7}$`),
+	Fixed:    regexp.MustCompile(`^(?:2[12467]|3[1-4]|4[134]|5[256]|6[12]|[7-9]1)[0-9]{7}$`),
+	TollFree: regexp.MustCompile(`^800[0-9]{6}$`),
+}, {
+	A2: "CN", CallingCode: "86", IntlPrefix: "00", NationalPrefix: "0",
+	Mobile:   regexp.MustCompile(`^1[3-9][0-9]{9}$`),
+	Fixed:    regexp.MustCompile(`^(?:10|2[0-9]|[3-9][0-9]{2})[0-9]{7,8}$`),
+	TollFree: regexp.MustCompile(`^(?:400|800)[0-9]{7}$`),
+}, {
+	A2: "DE", CallingCode: "49", IntlPrefix: "00", NationalPrefix: "0",
+	Mobile:   regexp.MustCompile(`^1(?:5[0-9]{9}|[67][0-9]{8,9})$`),
+	Fixed:    regexp.MustCompile(`^[2-9][0-9]{5,10}$`),
+	TollFree: regexp.MustCompile(`^800[0-9]{7,12}$`),
+}, {
+	A2: "DK", CallingCode: "45", IntlPrefix: "00",
+	Mobile:   regexp.MustCompile(`^[2-9][0-9]{7}$`),
+	Fixed:    regexp.MustCompile(`^[2-9][0-9]{7}$`),
+	TollFree: regexp.MustCompile(`^80[0-9]{6}$`),
+}, {
+	A2: "ES", CallingCode: "34", IntlPrefix: "00",
+	Mobile:   regexp.MustCompile(`^(?:6[0-9]|7[1-9])[0-9]{7}$`),
+	Fixed:    regexp.MustCompile(`^[89][1-9][0-9]{7}$`),
+	TollFree: regexp.MustCompile(`^[89]00[0-9]{6}$`),
+}, {
+	A2: "FI", CallingCode: "358", IntlPrefix: "00", NationalPrefix: "0",
+	Mobile:   regexp.MustCompile(`^(?:4[0-9]|50)[0-9]{4,8}$`),
+	Fixed:    regexp.MustCompile(`^(?:[1-3][0-9]|[5-9][1-8])[0-9]{3,9}$`),
+	TollFree: regexp.MustCompile(`^800[0-9]{4,6}$`),
+}, {
+	A2: "FR", CallingCode: "33", IntlPrefix: "00", NationalPrefix: "0",
+	Mobile:   regexp.MustCompile(`^[67][0-9]{8}$`),
+	Fixed:    regexp.MustCompile(`^[1-5][0-9]{8}$`),
+	TollFree: regexp.MustCompile(`^80[0-5][0-9]{6}$`),
+}, {
+	A2: "GB", CallingCode: "44", IntlPrefix: "00", NationalPrefix: "0",
+	Mobile:   regexp.MustCompile(`^7[1-57-9][0-9]{8}$`),
+	Fixed:    regexp.MustCompile(`^(?:1[0-9]{8,9}|[23][0-9]{9})$`),
+	TollFree: regexp.MustCompile(`^80(?:0[0-9]{6,7}|8[0-9]{7})$`),
+}, {
+	A2: "HR", CallingCode: "385", IntlPrefix: "00", NationalPrefix: "0",
+	Mobile:   regexp.MustCompile(`^9[1-9][0-9]{6,7}$`),
+	Fixed:    regexp.MustCompile(`^(?:1[0-9]{6,7}|[2-5][0-9]{7})$`),
+	TollFree: regexp.MustCompile(`^80[01][0-9]{4,6}$`),
+}, {
+	A2: "IE", CallingCode: "353", IntlPrefix: "00", NationalPrefix: "0",
+	Mobile:   regexp.MustCompile(`^8[35-9][0-9]{7}$`),
+	Fixed:    regexp.MustCompile(`^(?:1[0-9]{7,8}|[2-79][0-9]{6,8})$`),
+	TollFree: regexp.MustCompile(`^1800[0-9]{6}$`),
+}, {
+	A2: "IN", CallingCode: "91", IntlPrefix: "00", NationalPrefix: "0",
+	Mobile:   regexp.MustCompile(`^[6-9][0-9]{9}$`),
+	Fixed:    regexp.MustCompile(`^[1-5][0-9]{9}$`),
+	TollFree: regexp.MustCompile(`^1800[0-9]{6,7}$`),
+}, {
+	// Italian fixed-line numbers keep their leading
+	// zero also in international format, hence no national prefix.
+	A2: "IT", CallingCode: "39", IntlPrefix: "00",
+	Mobile:   regexp.MustCompile(`^3[0-9]{8,9}$`),
+	Fixed:    regexp.MustCompile(`^0[0-9]{5,10}$`),
+	TollFree: regexp.MustCompile(`^80(?:0[0-9]{6}|3[0-9]{3})$`),
+}, {
+	A2: "JP", CallingCode: "81", IntlPrefix: "010", NationalPrefix: "0",
+	Mobile:   regexp.MustCompile(`^[7-9]0[0-9]{8}$`),
+	Fixed:    regexp.MustCompile(`^[1-9][1-9][0-9]{7}$`),
+	TollFree: regexp.MustCompile(`^(?:120[0-9]{6}|800[0-9]{7})$`),
+}, {
+	A2: "KR", CallingCode: "82", IntlPrefix: "00", NationalPrefix: "0",
+	Mobile:   regexp.MustCompile(`^1[0-9]{8,9}$`),
+	Fixed:    regexp.MustCompile(`^(?:2[0-9]{7,8}|[3-6][1-5][0-9]{7})$`),
+	TollFree: regexp.MustCompile(`^80[0-9]{7}$`),
+}, {
+	A2: "MX", CallingCode: "52", IntlPrefix: "00",
+	Mobile:   regexp.MustCompile(`^[1-9][0-9]{9}$`),
+	Fixed:    regexp.MustCompile(`^[1-9][0-9]{9}$`),
+	TollFree: regexp.MustCompile(`^800[0-9]{7}$`),
+}, {
+	A2: "NL", CallingCode: "31", IntlPrefix: "00", NationalPrefix: "0",
+	Mobile:   regexp.MustCompile(`^6[1-58][0-9]{7}$`),
+	Fixed:    regexp.MustCompile(`^[1-57][0-9]{8}$`),
+	TollFree: regexp.MustCompile(`^800[0-9]{4,7}$`),
+}, {
+	A2: "NO", CallingCode: "47", IntlPrefix: "00",
+	Mobile:   regexp.MustCompile(`^[49][0-9]{7}$`),
+	Fixed:    regexp.MustCompile(`^[235-7][0-9]{7}$`),
+	TollFree: regexp.MustCompile(`^80[01][0-9]{5}$`),
+}, {
+	A2: "NZ", CallingCode: "64", IntlPrefix: "00", NationalPrefix: "0",
+	Mobile:   regexp.MustCompile(`^2[0-9]{7,9}$`),
+	Fixed:    regexp.MustCompile(`^[34679][0-9]{7}$`),
+	TollFree: regexp.MustCompile(`^80[08][0-9]{6,7}$`),
+}, {
+	A2: "PL", CallingCode: "48", IntlPrefix: "00",
+	Mobile:   regexp.MustCompile(`^(?:45|5[0137]|6[069]|7[2389]|88)[0-9]{7}$`),
+	Fixed:    regexp.MustCompile(`^(?:1[2-8]|2[2-69]|3[2-4]|4[1-468]|5[24-689]|6[1-3578]|7[14-7]|8[1-79]|9[145])[0-9]{7}$`),
+	TollFree: regexp.MustCompile(`^800[0-9]{6}$`),
+}, {
+	A2: "PT", CallingCode: "351", IntlPrefix: "00",
+	Mobile:   regexp.MustCompile(`^9[1236][0-9]{7}$`),
+	Fixed:    regexp.MustCompile(`^2[0-9]{8}$`),
+	TollFree: regexp.MustCompile(`^80[02][0-9]{6}$`),
+}, {
+	A2: "RU", CallingCode: "7", IntlPrefix: "810", NationalPrefix: "8",
+	Mobile:   regexp.MustCompile(`^9[0-9]{9}$`),
+	Fixed:    regexp.MustCompile(`^[348][0-9]{9}$`),
+	TollFree: regexp.MustCompile(`^800[0-9]{7}$`),
+}, {
+	A2: "SE", CallingCode: "46", IntlPrefix: "00", NationalPrefix: "0",
+	Mobile:   regexp.MustCompile(`^7[02369][0-9]{7}$`),
+	Fixed:    regexp.MustCompile(`^(?:[1-6][0-9]{6,8}|8[0-9]{5,8}|9[0-9]{6,8})$`),
+	TollFree: regexp.MustCompile(`^20[0-9]{4,7}$`),
+}, {
+	A2: "US", CallingCode: "1", IntlPrefix: "011", NationalPrefix: "1", Main: true,
+	Mobile:   StringMatcherFunc(usPhone),
+	Fixed:    StringMatcherFunc(usPhone),
+	TollFree: rxNANPTollFree,
+}, {
+	A2: "ZA", CallingCode: "27", IntlPrefix: "00", NationalPrefix: "0",
+	Mobile:   regexp.MustCompile(`^(?:6[0-9]|7[0-46-9]|8[1-5])[0-9]{7}$`),
+	Fixed:    regexp.MustCompile(`^(?:1[0-8]|2[1-4]|3[1-9]|4[1-9]|5[1-8])[0-9]{7}$`),
+	TollFree: regexp.MustCompile(`^80[0-9]{7}$`),
+}}
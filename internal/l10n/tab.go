@@ -9,6 +9,7 @@ var ISO31661A_3 = make(map[string]Country)
 
 func init() {
 	for _, c := range cctab {
+		c.PhoneMeta = phonemeta[c.A2]
 		ISO31661A_2[c.A2] = c
 		ISO31661A_3[c.A3] = c
 	}
@@ -52,7 +53,8 @@ var cctab = []Country{{
 	VAT: regexp.MustCompile(`^[JKL][0-9]{8}[A-Z]$`),
 }, {
 	A2: "AM", A3: "ARM", Num: "051", Zip: rxZip4Digits,
-	Phone: regexp.MustCompile(`^(?:\+?374|0)(?:(?:10|[9|7][0-9])[0-9]{6}|[2-4][0-9]{7})$`),
+	Phone:    regexp.MustCompile(`^(?:\+?374|0)(?:(?:10|[9|7][0-9])[0-9]{6}|[2-4][0-9]{7})$`),
+	Passport: regexp.MustCompile(`^[A-Z]{2}[0-9]{7}$`),
 }, {
 	A2: "AO", A3: "AGO", Num: "024",
 	Phone: regexp.MustCompile(`^(?:\+244)[0-9]{9}$`),
@@ -61,9 +63,10 @@ var cctab = []Country{{
 	Zip: regexp.MustCompile(`^BIQQ 1ZZ$`),
 }, {
 	A2: "AR", A3: "ARG", Num: "032",
-	Zip:   regexp.MustCompile(`^(?:[0-9]{4})|(?:[A-Z][0-9]{4}[A-Z]{3})$`),
-	Phone: regexp.MustCompile(`^\+?549(?:11|[2368][0-9])[0-9]{8}$`),
-	VAT:   regexp.MustCompile(`^[0-9]{11}$`),
+	Zip:      regexp.MustCompile(`^(?:[0-9]{4})|(?:[A-Z][0-9]{4}[A-Z]{3})$`),
+	Phone:    regexp.MustCompile(`^\+?549(?:11|[2368][0-9])[0-9]{8}$`),
+	VAT:      StringMatcherFunc(arVAT),
+	Passport: regexp.MustCompile(`^[A-Z]{3}[0-9]{6}$`),
 }, {
 	A2: "AS", A3: "ASM", Num: "016",
 	Zip: regexp.MustCompile(`^[0-9]{5}(?:-?[0-9]{4})?$`),
@@ -72,11 +75,13 @@ var cctab = []Country{{
 	Zip:   rxZip3Digits,
 	Phone: regexp.MustCompile(`^(?:\+43|0)[0-9]{1,4}[0-9]{3,12}$`),
 	// 'AT'+U+8 digits, – e.g. ATU99999999
-	VAT: regexp.MustCompile(`^ATU[0-9]{8}$`),
+	VAT:      regexp.MustCompile(`^ATU[0-9]{8}$`),
+	Passport: regexp.MustCompile(`^[A-Z][0-9]{7}$`),
 }, {
 	A2: "AU", A3: "AUS", Num: "036", Zip: rxZip4Digits,
-	Phone: regexp.MustCompile(`^(?:\+?61|0)4[0-9]{8}$`),
-	VAT:   StringMatcherFunc(auVAT),
+	Phone:    regexp.MustCompile(`^(?:\+?61|0)4[0-9]{8}$`),
+	VAT:      StringMatcherFunc(auVAT),
+	Passport: regexp.MustCompile(`^[A-Z][0-9]{7}$`),
 }, {
 	A2: "AW", A3: "ABW", Num: "533",
 }, {
@@ -84,8 +89,9 @@ var cctab = []Country{{
 	Zip: regexp.MustCompile(`^(?:AX-)?[0-9]{5}$`),
 }, {
 	A2: "AZ", A3: "AZE", Num: "031",
-	Zip:   regexp.MustCompile(`^AZ[0-9]{4}$`),
-	Phone: regexp.MustCompile(`^(?:\+994|0)(?:5[015]|7[07]|99)[0-9]{7}$`),
+	Zip:      regexp.MustCompile(`^AZ[0-9]{4}$`),
+	Phone:    regexp.MustCompile(`^(?:\+994|0)(?:5[015]|7[07]|99)[0-9]{7}$`),
+	Passport: regexp.MustCompile(`^[A-Z][0-9]{8}$`),
 }, {
 	A2: "BA", A3: "BIH", Num: "070",
 	Zip:   rxZip5Digits,
@@ -99,16 +105,18 @@ var cctab = []Country{{
 	Phone: regexp.MustCompile(`^(?:\+?880|0)1[13456789][0-9]{8}$`),
 }, {
 	A2: "BE", A3: "BEL", Num: "056",
-	Zip:   rxZip4Digits,
-	Phone: regexp.MustCompile(`^(?:\+?32|0)4?[0-9]{8}$`),
-	VAT:   StringMatcherFunc(beVAT),
+	Zip:      rxZip4Digits,
+	Phone:    regexp.MustCompile(`^(?:\+?32|0)4?[0-9]{8}$`),
+	VAT:      StringMatcherFunc(beVAT),
+	Passport: regexp.MustCompile(`^[A-Z]{2}[0-9]{6}$`),
 }, {
 	A2: "BF", A3: "BFA", Num: "854",
 }, {
 	A2: "BG", A3: "BGR", Num: "100",
-	Zip:   rxZip4Digits,
-	Phone: regexp.MustCompile(`^(?:\+?359|0)?8[789][0-9]{7}$`),
-	VAT:   regexp.MustCompile(`^BG[0-9]{9,10}$`),
+	Zip:      rxZip4Digits,
+	Phone:    regexp.MustCompile(`^(?:\+?359|0)?8[789][0-9]{7}$`),
+	VAT:      regexp.MustCompile(`^BG[0-9]{9,10}$`),
+	Passport: regexp.MustCompile(`^[0-9]{9}$`),
 }, {
 	A2: "BH", A3: "BHR", Num: "048",
 	Zip:   regexp.MustCompile(`^[0-9]{3,4}$`),
@@ -133,8 +141,10 @@ var cctab = []Country{{
 	A2: "BQ", A3: "BES", Num: "535",
 }, {
 	A2: "BR", A3: "BRA", Num: "076",
-	Zip:   regexp.MustCompile(`^[0-9]{5}-[0-9]{3}$`),
-	Phone: regexp.MustCompile(`^(?:(?:\+?55[ ]?[1-9]{2}[ ]?)|(?:\+?55[ ]?\([1-9]{2}\)[ ]?)|(?:0[1-9]{2}[ ]?)|(?:\([1-9]{2}\)[ ]?)|(?:[1-9]{2}[ ]?))(?:(?:[0-9]{4}-?[0-9]{4})|(?:9[2-9]{1}[0-9]{3}-?[0-9]{4}))$`),
+	Zip:      regexp.MustCompile(`^[0-9]{5}-[0-9]{3}$`),
+	Phone:    regexp.MustCompile(`^(?:(?:\+?55[ ]?[1-9]{2}[ ]?)|(?:\+?55[ ]?\([1-9]{2}\)[ ]?)|(?:0[1-9]{2}[ ]?)|(?:\([1-9]{2}\)[ ]?)|(?:[1-9]{2}[ ]?))(?:(?:[0-9]{4}-?[0-9]{4})|(?:9[2-9]{1}[0-9]{3}-?[0-9]{4}))$`),
+	VAT:      StringMatcherFunc(brVAT),
+	Passport: regexp.MustCompile(`^[A-Z]{2}[0-9]{6}$`),
 }, {
 	A2: "BS", A3: "BHS", Num: "044",
 }, {
@@ -149,15 +159,16 @@ var cctab = []Country{{
 	Zip:   regexp.MustCompile(`^2[1-4]{1}[0-9]{4}$`),
 	Phone: regexp.MustCompile(`^(?:\+?375)?(?:24|25|29|33|44)[0-9]{7}$`),
 	// 9 digit number
-	VAT: regexp.MustCompile(`^(?:УНП[ ]?)?[0-9]{9}$`),
+	VAT:      regexp.MustCompile(`^(?:УНП[ ]?)?[0-9]{9}$`),
+	Passport: regexp.MustCompile(`^[A-Z]{2}[0-9]{7}$`),
 }, {
 	A2: "BZ", A3: "BLZ", Num: "084",
 }, {
 	A2: "CA", A3: "CAN", Num: "124",
-	Zip:   regexp.MustCompile(`^(?i)[ABCEGHJKLMNPRSTVXY][0-9][ABCEGHJ-NPRSTV-Z][\s\-]?[0-9][ABCEGHJ-NPRSTV-Z][0-9]$`),
-	Phone: regexp.MustCompile(`^(?:(?:\+1|1)?(?: |-)?)?(?:\([2-9][0-9]{2}\)|[2-9][0-9]{2})(?: |-)?(?:[2-9][0-9]{2}(?: |-)?[0-9]{4})$`),
-	// 9 digit number (same as BN or GST/HST number)
-	VAT: regexp.MustCompile(`^[0-9]{9}$`),
+	Zip:      regexp.MustCompile(`^(?i)[ABCEGHJKLMNPRSTVXY][0-9][ABCEGHJ-NPRSTV-Z][\s\-]?[0-9][ABCEGHJ-NPRSTV-Z][0-9]$`),
+	Phone:    regexp.MustCompile(`^(?:(?:\+1|1)?(?: |-)?)?(?:\([2-9][0-9]{2}\)|[2-9][0-9]{2})(?: |-)?(?:[2-9][0-9]{2}(?: |-)?[0-9]{4})$`),
+	VAT:      StringMatcherFunc(caVAT),
+	Passport: regexp.MustCompile(`^[A-Z]{2}[0-9]{6}$|^[A-Z][0-9]{6}[A-Z]{2}$`),
 }, {
 	A2: "CC", A3: "CCK", Num: "166",
 	Zip: rxZip4Digits,
@@ -169,9 +180,10 @@ var cctab = []Country{{
 	A2: "CG", A3: "COG", Num: "178",
 }, {
 	A2: "CH", A3: "CHE", Num: "756",
-	Zip:   rxZip4Digits,
-	Phone: regexp.MustCompile(`^(?:\+41|0)7[5-9][0-9]{1,7}$`),
-	VAT:   StringMatcherFunc(chVAT),
+	Zip:      rxZip4Digits,
+	Phone:    regexp.MustCompile(`^(?:\+41|0)7[5-9][0-9]{1,7}$`),
+	VAT:      StringMatcherFunc(chVAT),
+	Passport: regexp.MustCompile(`^[A-Z][0-9]{7}$`),
 }, {
 	A2: "CI", A3: "CIV", Num: "384",
 }, {
@@ -184,8 +196,10 @@ var cctab = []Country{{
 	A2: "CM", A3: "CMR", Num: "120",
 }, {
 	A2: "CN", A3: "CHN", Num: "156",
-	Zip:   regexp.MustCompile(`^(?:0[1-7]|1[012356]|2[0-7]|3[0-6]|4[0-7]|5[1-7]|6[1-7]|7[1-5]|8[1345]|9[09])[0-9]{4}$`),
-	Phone: regexp.MustCompile(`^(?:(?:\+|00)86)?1(?:[3568][0-9]|4[579]|6[67]|7[01235678]|9[012356789])[0-9]{8}$`),
+	Zip:          regexp.MustCompile(`^(?:0[1-7]|1[012356]|2[0-7]|3[0-6]|4[0-7]|5[1-7]|6[1-7]|7[1-5]|8[1345]|9[09])[0-9]{4}$`),
+	Phone:        regexp.MustCompile(`^(?:(?:\+|00)86)?1(?:[3568][0-9]|4[579]|6[67]|7[01235678]|9[012356789])[0-9]{8}$`),
+	IdentityCard: StringMatcherFunc(cnIC),
+	Passport:     regexp.MustCompile(`^G[0-9]{8}$|^E[A-HJ-NP-Z0-9][0-9]{7}$`),
 }, {
 	A2: "CO", A3: "COL", Num: "170",
 	Zip:   rxZip6Digits,
@@ -209,26 +223,30 @@ var cctab = []Country{{
 	A2: "CY", A3: "CYP", Num: "196",
 	Zip: regexp.MustCompile(`^[0-9]{4,5}$`),
 	// 9 characters, last one must be a letter – e.g. CY99999999L
-	VAT: regexp.MustCompile(`^CY[0-9]{8}[A-Z]$`),
+	VAT:      regexp.MustCompile(`^CY[0-9]{8}[A-Z]$`),
+	Passport: regexp.MustCompile(`^[A-Z](?:[0-9]{6}|[0-9]{8})$`),
 }, {
 	A2: "CZ", A3: "CZE", Num: "203",
 	Zip:   regexp.MustCompile(`^[0-9]{3}[ ]?[0-9]{2}$`),
 	Phone: regexp.MustCompile(`^(?:\+?420)?[ ]?[1-9][0-9]{2}[ ]?[0-9]{3}[ ]?[0-9]{3}$`),
 	// 8, 9 or 10 characters -- i.e. CZ12345678, CZ123456789, CZ1234567890
-	VAT: regexp.MustCompile(`^CZ[0-9]{8,10}$`),
+	VAT:      regexp.MustCompile(`^CZ[0-9]{8,10}$`),
+	Passport: regexp.MustCompile(`^[0-9]{8}$`),
 }, {
 	A2: "DE", A3: "DEU", Num: "276",
 	Zip:   rxZip5Digits,
 	Phone: regexp.MustCompile(`^(?:\+49)?0?[1|3](?:[0|5][0-9]{2}|6(?:[23]|0[0-9]?)|7(?:[0-57-9]|6[0-9]))[0-9]{7}$`),
 	// 9 digits, e.g. DE999999999
-	VAT: regexp.MustCompile(`^DE[0-9]{9}$`),
+	VAT:      regexp.MustCompile(`^DE[0-9]{9}$`),
+	Passport: regexp.MustCompile(`^[CFGHJKLMNPRTVWXYZ0-9]{9}$`),
 }, {
 	A2: "DJ", A3: "DJI", Num: "262",
 }, {
 	A2: "DK", A3: "DNK", Num: "208",
-	Zip:   regexp.MustCompile(`^(?:DK-)?[0-9]{4}$`),
-	Phone: regexp.MustCompile(`^(?:\+?45)?(?:[ ]?[0-9]{2}){4}$`),
-	VAT:   StringMatcherFunc(dkVAT),
+	Zip:      regexp.MustCompile(`^(?:DK-)?[0-9]{4}$`),
+	Phone:    regexp.MustCompile(`^(?:\+?45)?(?:[ ]?[0-9]{2}){4}$`),
+	VAT:      StringMatcherFunc(dkVAT),
+	Passport: regexp.MustCompile(`^[0-9]{9}$`),
 }, {
 	A2: "DM", A3: "DMA", Num: "212",
 }, {
@@ -238,8 +256,9 @@ var cctab = []Country{{
 	VAT:   regexp.MustCompile(`^[0-9]{9}|[0-9]{11}$`),
 }, {
 	A2: "DZ", A3: "DZA", Num: "012",
-	Zip:   rxZip5Digits,
-	Phone: regexp.MustCompile(`^(?:\+?213|0)(?:5|6|7)[0-9]{8}$`),
+	Zip:      rxZip5Digits,
+	Phone:    regexp.MustCompile(`^(?:\+?213|0)(?:5|6|7)[0-9]{8}$`),
+	Passport: regexp.MustCompile(`^[0-9]{9}$`),
 }, {
 	A2: "EC", A3: "ECU", Num: "218",
 	Zip:   rxZip6Digits,
@@ -247,9 +266,10 @@ var cctab = []Country{{
 	VAT:   regexp.MustCompile(`^[0-9]{13}$`),
 }, {
 	A2: "EE", A3: "EST", Num: "233",
-	Zip:   rxZip5Digits,
-	Phone: regexp.MustCompile(`^(?:\+?372)?[ ]?(?:5|8[1-4])[ ]?(?:[0-9][ ]?){6,7}$`),
-	VAT:   regexp.MustCompile(`^EE[0-9]{9}$`),
+	Zip:      rxZip5Digits,
+	Phone:    regexp.MustCompile(`^(?:\+?372)?[ ]?(?:5|8[1-4])[ ]?(?:[0-9][ ]?){6,7}$`),
+	VAT:      regexp.MustCompile(`^EE[0-9]{9}$`),
+	Passport: regexp.MustCompile(`^[A-Z]{1,2}[0-9]{7}$`),
 }, {
 	A2: "EG", A3: "EGY", Num: "818",
 	Zip:   rxZip5Digits,
@@ -263,15 +283,19 @@ var cctab = []Country{{
 	Zip:   regexp.MustCompile(`^(?:5[0-2]{1}|[0-4]{1}[0-9]{1})[0-9]{3}$`),
 	Phone: regexp.MustCompile(`^(?:\+?34)?[6|7][0-9]{8}$`),
 	// 'ES'+letter+8 digits; or 'ES'+letter+7 digits+letter; or 'ES'+8 digits+letter
-	VAT: regexp.MustCompile(`^ES(?:[A-Z][0-9]{8}|[A-Z][0-9]{7}[A-Z]|[0-9]{8}[A-Z])$`),
+	VAT:          regexp.MustCompile(`^ES(?:[A-Z][0-9]{8}|[A-Z][0-9]{7}[A-Z]|[0-9]{8}[A-Z])$`),
+	IdentityCard: StringMatcherFunc(esIC),
+	Passport:     regexp.MustCompile(`^[A-Z0-9]{2}[A-Z0-9]?[0-9]{6}$`),
 }, {
 	A2: "ET", A3: "ETH", Num: "231",
 	Zip: rxZip4Digits,
 }, {
 	A2: "FI", A3: "FIN", Num: "246",
-	Zip:   rxZip5Digits,
-	Phone: regexp.MustCompile(`^(?:\+?358|0)[ ]?(?:4(?:0|1|2|4|5|6)?|50)[ ]?(?:[0-9][ ]?){4,8}[0-9]$`),
-	VAT:   StringMatcherFunc(fiVAT),
+	Zip:          rxZip5Digits,
+	Phone:        regexp.MustCompile(`^(?:\+?358|0)[ ]?(?:4(?:0|1|2|4|5|6)?|50)[ ]?(?:[0-9][ ]?){4,8}[0-9]$`),
+	VAT:          StringMatcherFunc(fiVAT),
+	IdentityCard: StringMatcherFunc(fiIC),
+	Passport:     regexp.MustCompile(`^[A-Z]{2}[0-9]{7}$`),
 }, {
 	A2: "FJ", A3: "FJI", Num: "242",
 	Phone: regexp.MustCompile(`^(?:\+?679)?[ ]?[0-9]{3}[ ]?[0-9]{4}$`),
@@ -287,15 +311,18 @@ var cctab = []Country{{
 	Phone: regexp.MustCompile(`^(?:\+?298)?(?:[ ]?[0-9]{2}){3}$`),
 }, {
 	A2: "FR", A3: "FRA", Num: "250",
-	Zip:   regexp.MustCompile(`^[0-9]{2}\s?[0-9]{3}$`),
-	Phone: regexp.MustCompile(`^(?:\+?33|0)[67][0-9]{8}$`),
-	VAT:   StringMatcherFunc(frVAT),
+	Zip:      regexp.MustCompile(`^[0-9]{2}\s?[0-9]{3}$`),
+	Phone:    regexp.MustCompile(`^(?:\+?33|0)[67][0-9]{8}$`),
+	VAT:      StringMatcherFunc(frVAT),
+	Passport: regexp.MustCompile(`^[0-9]{2}[A-Z]{2}[0-9]{5}$`),
 }, {
 	A2: "GA", A3: "GAB", Num: "266",
 }, {
 	A2: "GB", A3: "GBR", Num: "826",
-	Zip:   regexp.MustCompile(`^(?i)(?:gir\s?0aa|[a-z]{1,2}[0-9][0-9a-z]?\s?(?:[0-9][a-z]{2})?)$`),
-	Phone: regexp.MustCompile(`^(?:\+?44|0)7[0-9]{9}$`),
+	Zip:      regexp.MustCompile(`^(?i)(?:gir\s?0aa|[a-z]{1,2}[0-9][0-9a-z]?\s?(?:[0-9][a-z]{2})?)$`),
+	Phone:    regexp.MustCompile(`^(?:\+?44|0)7[0-9]{9}$`),
+	VAT:      StringMatcherFunc(gbVAT),
+	Passport: regexp.MustCompile(`^[0-9]{9}$`),
 }, {
 	A2: "GD", A3: "GRD", Num: "308",
 }, {
@@ -333,9 +360,10 @@ var cctab = []Country{{
 	A2: "GQ", A3: "GNQ", Num: "226",
 }, {
 	A2: "GR", A3: "GRC", Num: "300",
-	Zip:   regexp.MustCompile(`^[0-9]{3}[ ]?[0-9]{2}$`),
-	Phone: regexp.MustCompile(`^(?:\+?30|0)?(?:69[0-9]{8})$`),
-	VAT:   StringMatcherFunc(grVAT),
+	Zip:      regexp.MustCompile(`^[0-9]{3}[ ]?[0-9]{2}$`),
+	Phone:    regexp.MustCompile(`^(?:\+?30|0)?(?:69[0-9]{8})$`),
+	VAT:      StringMatcherFunc(grVAT),
+	Passport: regexp.MustCompile(`^[A-Z]{2}[0-9]{7}$`),
 }, {
 	A2: "GS", A3: "SGS", Num: "239",
 	Zip: regexp.MustCompile(`^SIQQ 1ZZ$`),
@@ -353,7 +381,8 @@ var cctab = []Country{{
 	A2: "GY", A3: "GUY", Num: "328",
 }, {
 	A2: "HK", A3: "HKG", Num: "344",
-	Phone: regexp.MustCompile(`^(?:\+?852[\- ]?)?[456789][0-9]{3}[\- ]?[0-9]{4}$`),
+	Phone:        regexp.MustCompile(`^(?:\+?852[\- ]?)?[456789][0-9]{3}[\- ]?[0-9]{4}$`),
+	IdentityCard: StringMatcherFunc(hkIC),
 }, {
 	A2: "HM", A3: "HMD", Num: "334",
 }, {
@@ -362,8 +391,9 @@ var cctab = []Country{{
 	Phone: regexp.MustCompile(`^(?:\+?504)?[9|8][0-9]{7}$`),
 }, {
 	A2: "HR", A3: "HRV", Num: "191",
-	Zip: regexp.MustCompile(`^(?:[1-5][0-9]{4}$)`),
-	VAT: StringMatcherFunc(hrVAT),
+	Zip:      regexp.MustCompile(`^(?:[1-5][0-9]{4}$)`),
+	VAT:      StringMatcherFunc(hrVAT),
+	Passport: regexp.MustCompile(`^[0-9]{9}$`),
 }, {
 	A2: "HT", A3: "HTI", Num: "332",
 	Zip: regexp.MustCompile(`^HT[0-9]{4}$`),
@@ -372,13 +402,15 @@ var cctab = []Country{{
 	Zip:   rxZip4Digits,
 	Phone: regexp.MustCompile(`^(?:\+?36)(?:20|30|70)[0-9]{7}$`),
 	// 8 digits (the first 8 digits of the national tax number) – e.g. HU12345678
-	VAT: regexp.MustCompile(`^HU[0-9]{8}$`),
+	VAT:      regexp.MustCompile(`^HU[0-9]{8}$`),
+	Passport: regexp.MustCompile(`^[A-Z]{2}[0-9]{6,7}$`),
 }, {
 	A2: "ID", A3: "IDN", Num: "360",
 	Zip:   rxZip5Digits,
 	Phone: regexp.MustCompile(`^(?:\+?62|0)8(?:1[123456789]|2[1238]|3[1238]|5[12356789]|7[78]|9[56789]|8[123456789])[ ?|0-9]{5,11}$`),
 	// 15 digit number (ex. 02.271.824.1-413.000)
-	VAT: regexp.MustCompile(`^(?:[0-9]{15})|(?:[0-9]{2}.[0-9]{3}.[0-9]{3}.[0-9][\-–][0-9]{3}.[0-9]{3})$`),
+	VAT:      regexp.MustCompile(`^(?:[0-9]{15})|(?:[0-9]{2}.[0-9]{3}.[0-9]{3}.[0-9][\-–][0-9]{3}.[0-9]{3})$`),
+	Passport: regexp.MustCompile(`^[A-C][0-9]{7}$`),
 }, {
 	A2: "IE", A3: "IRL", Num: "372",
 	// References:
@@ -390,12 +422,15 @@ var cctab = []Country{{
 	// 'IE'+7 digits and one letter, optionally followed by a 'W' for married women, e.g. IE1234567T or IE1234567TW
 	// or 'IE'+7 digits and two letters, e.g. IE1234567FA (since January 2013)
 	// or 'IE'+one digit, one letter/"+"/"*", 5 digits and one letter (old style, currently being phased out)
-	VAT: regexp.MustCompile(`^IE(?:[0-9]{7}[A-Z]{1,2}|[0-9][A-Z+*][0-9]{5}[A-Z])$`),
+	VAT:      regexp.MustCompile(`^IE(?:[0-9]{7}[A-Z]{1,2}|[0-9][A-Z+*][0-9]{5}[A-Z])$`),
+	Passport: regexp.MustCompile(`^[A-Z0-9]{2}[0-9]{7}$`),
 }, {
 	A2: "IL", A3: "ISR", Num: "376",
 	Zip:   regexp.MustCompile(`^(?:[0-9]{5}|[0-9]{7})$`),
 	Phone: regexp.MustCompile(`^(?:\+972|0)(?:[23489]|5[012345689]|77)[1-9][0-9]{6}$`),
 	VAT:   StringMatcherFunc(ilVAT),
+	// same format & check digit as the VAT number
+	IdentityCard: StringMatcherFunc(ilVAT),
 }, {
 	A2: "IM", A3: "IMN", Num: "833",
 	Zip: regexp.MustCompile(`^IM[0-9]{1,2} [0-9][A-Z]{2}$`),
@@ -404,8 +439,10 @@ var cctab = []Country{{
 	// References:
 	// - https://en.wikipedia.org/wiki/Postal_Index_Number
 	// - https://en.youbianku.com/India
-	Zip:   StringMatcherFunc(inZIP),
-	Phone: regexp.MustCompile(`^(?:\+?91|0)?[6789][0-9]{9}$`),
+	Zip:          StringMatcherFunc(inZIP),
+	Phone:        regexp.MustCompile(`^(?:\+?91|0)?[6789][0-9]{9}$`),
+	IdentityCard: StringMatcherFunc(inIC),
+	Passport:     regexp.MustCompile(`^[A-Z]-?[0-9]{7}$`),
 }, {
 	A2: "IO", A3: "IOT", Num: "086",
 	Zip: regexp.MustCompile(`^BBND 1ZZ$`),
@@ -415,32 +452,39 @@ var cctab = []Country{{
 	Phone: regexp.MustCompile(`^(?:\+?964|0)?7[0-9]{9}$`),
 }, {
 	A2: "IR", A3: "IRN", Num: "364",
-	Zip:   regexp.MustCompile(`^[0-9]{10}$`),
-	Phone: regexp.MustCompile(`^(?:\+?98[\- ]?|0)9[0-39][0-9][\- ]?[0-9]{3}[\- ]?[0-9]{4}$`),
+	Zip:          regexp.MustCompile(`^[0-9]{10}$`),
+	Phone:        regexp.MustCompile(`^(?:\+?98[\- ]?|0)9[0-39][0-9][\- ]?[0-9]{3}[\- ]?[0-9]{4}$`),
+	IdentityCard: StringMatcherFunc(irIC),
+	Passport:     regexp.MustCompile(`^[A-Z][0-9]{8}$`),
 }, {
 	A2: "IS", A3: "ISL", Num: "352",
-	Zip: rxZip3Digits,
-	// 5 or 6 characters depending on age of the company
-	VAT: regexp.MustCompile(`^[0-9]{5,6}$`),
+	Zip:      rxZip3Digits,
+	VAT:      StringMatcherFunc(isVAT),
+	Passport: regexp.MustCompile(`^A[0-9]{7}$`),
 }, {
 	A2: "IT", A3: "ITA", Num: "380",
-	Zip:   rxZip5Digits,
-	Phone: regexp.MustCompile(`^(?:\+?39)?[ ]?3[0-9]{2}[ ]?[0-9]{6,7}$`),
-	VAT:   StringMatcherFunc(itVAT),
+	Zip:          rxZip5Digits,
+	Phone:        regexp.MustCompile(`^(?:\+?39)?[ ]?3[0-9]{2}[ ]?[0-9]{6,7}$`),
+	VAT:          StringMatcherFunc(itVAT),
+	IdentityCard: StringMatcherFunc(itIC),
+	Passport:     regexp.MustCompile(`^[A-Z0-9]{2}[0-9]{7}$`),
 }, {
 	A2: "JE", A3: "JEY", Num: "832",
 	Zip: regexp.MustCompile(`^JE[0-9]{1,2} [0-9][A-Z]{2}$`),
 }, {
 	A2: "JM", A3: "JAM", Num: "388",
-	Zip: regexp.MustCompile(`^[1-9]|1[0-9]|20$`),
+	Zip:      regexp.MustCompile(`^[1-9]|1[0-9]|20$`),
+	Passport: regexp.MustCompile(`^A[0-9]{7}$`),
 }, {
 	A2: "JO", A3: "JOR", Num: "400",
 	Zip:   rxZip5Digits,
 	Phone: regexp.MustCompile(`^(?:\+?962|0)?7[789][0-9]{7}$`),
 }, {
 	A2: "JP", A3: "JPN", Num: "392",
-	Zip:   regexp.MustCompile(`^[0-9]{3}\-[0-9]{4}$`),
-	Phone: regexp.MustCompile(`^(?:\+81[ \-]?(?:\(0\))?|0)[6789]0(?:[ \-]?[0-9]{4}){2}$`),
+	Zip:      regexp.MustCompile(`^[0-9]{3}\-[0-9]{4}$`),
+	Phone:    regexp.MustCompile(`^(?:\+81[ \-]?(?:\(0\))?|0)[6789]0(?:[ \-]?[0-9]{4}){2}$`),
+	VAT:      StringMatcherFunc(jpVAT),
+	Passport: regexp.MustCompile(`^[A-Z]{2}[0-9]{7}$`),
 }, {
 	A2: "KE", A3: "KEN", Num: "404",
 	Zip:   rxZip5Digits,
@@ -461,8 +505,10 @@ var cctab = []Country{{
 	A2: "KP", A3: "PRK", Num: "408",
 }, {
 	A2: "KR", A3: "KOR", Num: "410",
-	Zip:   rxZip5Digits,
-	Phone: regexp.MustCompile(`^(?:(?:\+?82)[ \-]?)?0?1(?:[0|1|6|7|8|9]{1})[ \-]?[0-9]{3,4}[ \-]?[0-9]{4}$`),
+	Zip:      rxZip5Digits,
+	Phone:    regexp.MustCompile(`^(?:(?:\+?82)[ \-]?)?0?1(?:[0|1|6|7|8|9]{1})[ \-]?[0-9]{3,4}[ \-]?[0-9]{4}$`),
+	VAT:      StringMatcherFunc(krVAT),
+	Passport: regexp.MustCompile(`^[MS][0-9]{8}$`),
 }, {
 	A2: "KW", A3: "KWT", Num: "414",
 	Zip:   rxZip5Digits,
@@ -475,7 +521,8 @@ var cctab = []Country{{
 	Zip:   rxZip6Digits,
 	Phone: regexp.MustCompile(`^(?:\+?7|8)?7[0-9]{9}$`),
 	// 12 digits
-	VAT: regexp.MustCompile(`^[0-9]{12}$`),
+	VAT:      regexp.MustCompile(`^[0-9]{12}$`),
+	Passport: regexp.MustCompile(`^[A-Z][0-9]{7}$`),
 }, {
 	A2: "LA", A3: "LAO", Num: "418",
 	Zip: rxZip5Digits,
@@ -490,10 +537,13 @@ var cctab = []Country{{
 	Zip: regexp.MustCompile(`^LC[0-9]{2}[ ]{0,2}[0-9]{3}$`),
 }, {
 	A2: "LI", A3: "LIE", Num: "438",
-	Zip: regexp.MustCompile(`^(?:948[5-9]|949[0-7])$`),
+	Zip:      regexp.MustCompile(`^(?:948[5-9]|949[0-7])$`),
+	Passport: regexp.MustCompile(`^[A-Z][0-9]{5}$`),
 }, {
 	A2: "LK", A3: "LKA", Num: "144",
 	Zip: rxZip5Digits,
+	// old format: 9 digits + V or X, new format: 12 digits
+	IdentityCard: regexp.MustCompile(`^[1-9][0-9]{8}[VvXx]$|^[1-9][0-9]{11}$`),
 }, {
 	A2: "LR", A3: "LBR", Num: "430",
 	Zip: rxZip4Digits,
@@ -502,21 +552,26 @@ var cctab = []Country{{
 	Zip: regexp.MustCompile(`^[0-9]{3}$`),
 }, {
 	A2: "LT", A3: "LTU", Num: "440",
-	Zip:   regexp.MustCompile(`^LT\-[0-9]{5}$`),
-	Phone: regexp.MustCompile(`^(?:\+370|8)[0-9]{8}$`),
-	VAT:   regexp.MustCompile(`^LT[0-9]{9}(?:[0-9]{3})?$`),
+	Zip:      regexp.MustCompile(`^LT\-[0-9]{5}$`),
+	Phone:    regexp.MustCompile(`^(?:\+370|8)[0-9]{8}$`),
+	VAT:      regexp.MustCompile(`^LT[0-9]{9}(?:[0-9]{3})?$`),
+	Passport: regexp.MustCompile(`^[A-Z0-9]{8}$`),
 }, {
 	A2: "LU", A3: "LUX", Num: "442",
-	Zip:   rxZip4Digits,
-	Phone: regexp.MustCompile(`^(?:\+352)?(?:(?:6[0-9]1)[0-9]{6})$`),
-	VAT:   regexp.MustCompile(`^LU[0-9]{8}$`),
+	Zip:      rxZip4Digits,
+	Phone:    regexp.MustCompile(`^(?:\+352)?(?:(?:6[0-9]1)[0-9]{6})$`),
+	VAT:      regexp.MustCompile(`^LU[0-9]{8}$`),
+	Passport: regexp.MustCompile(`^[A-Z0-9]{8}$`),
 }, {
 	A2: "LV", A3: "LVA", Num: "428",
-	Zip: regexp.MustCompile(`^LV\-[0-9]{4}$`),
-	VAT: regexp.MustCompile(`^LV[0-9]{11}$`),
+	Zip:      regexp.MustCompile(`^LV\-[0-9]{4}$`),
+	VAT:      regexp.MustCompile(`^LV[0-9]{11}$`),
+	Passport: regexp.MustCompile(`^[A-Z0-9]{2}[0-9]{7}$`),
 }, {
 	A2: "LY", A3: "LBY", Num: "434",
-	Phone: regexp.MustCompile(`^(?:(?:\+?218)|0)?(?:9[1-6][0-9]{7}|[1-8][0-9]{7,9})$`),
+	Phone:        regexp.MustCompile(`^(?:(?:\+?218)|0)?(?:9[1-6][0-9]{7}|[1-8][0-9]{7,9})$`),
+	IdentityCard: regexp.MustCompile(`^[12][0-9]{11}$`),
+	Passport:     regexp.MustCompile(`^[A-Z0-9]{8}$`),
 }, {
 	A2: "MA", A3: "MAR", Num: "504",
 	Zip:   rxZip5Digits,
@@ -571,9 +626,10 @@ var cctab = []Country{{
 	Zip: regexp.MustCompile(`^MSR 1[1-3][0-9]{2}$`),
 }, {
 	A2: "MT", A3: "MLT", Num: "470",
-	Zip:   regexp.MustCompile(`^(?i)[a-z]{3}\s{0,1}[0-9]{4}$`),
-	Phone: regexp.MustCompile(`^(?:\+?356|0)?(?:99|79|77|21|27|22|25)[0-9]{6}$`),
-	VAT:   regexp.MustCompile(`^MT[0-9]{8}$`),
+	Zip:      regexp.MustCompile(`^(?i)[a-z]{3}\s{0,1}[0-9]{4}$`),
+	Phone:    regexp.MustCompile(`^(?:\+?356|0)?(?:99|79|77|21|27|22|25)[0-9]{6}$`),
+	VAT:      regexp.MustCompile(`^MT[0-9]{8}$`),
+	Passport: regexp.MustCompile(`^[0-9]{7}$`),
 }, {
 	A2: "MU", A3: "MUS", Num: "480",
 	Zip:   rxZip5Digits,
@@ -585,15 +641,19 @@ var cctab = []Country{{
 	A2: "MW", A3: "MWI", Num: "454",
 }, {
 	A2: "MX", A3: "MEX", Num: "484",
-	Zip:   rxZip5Digits,
-	Phone: regexp.MustCompile(`^(?:\+?52)?(?:1|01)?[0-9]{10,11}$`),
+	Zip:      rxZip5Digits,
+	Phone:    regexp.MustCompile(`^(?:\+?52)?(?:1|01)?[0-9]{10,11}$`),
+	VAT:      StringMatcherFunc(mxVAT),
+	Passport: regexp.MustCompile(`^[0-9]{10,11}$`),
 }, {
 	A2: "MY", A3: "MYS", Num: "458",
-	Zip:   rxZip5Digits,
-	Phone: regexp.MustCompile(`^(?:\+?6?01){1}(?:(?:[0145]{1}(?:-| )?[0-9]{7,8})|(?:[236789]{1}(?:-| )?[0-9]{7}))$`),
+	Zip:      rxZip5Digits,
+	Phone:    regexp.MustCompile(`^(?:\+?6?01){1}(?:(?:[0145]{1}(?:-| )?[0-9]{7,8})|(?:[236789]{1}(?:-| )?[0-9]{7}))$`),
+	Passport: regexp.MustCompile(`^[AHK][0-9]{8}$`),
 }, {
 	A2: "MZ", A3: "MOZ", Num: "508",
-	Zip: rxZip4Digits,
+	Zip:      rxZip4Digits,
+	Passport: regexp.MustCompile(`^(?:[A-Z]{2}[0-9]{7}|[0-9]{2}[A-Z]{2}[0-9]{5})$`),
 }, {
 	A2: "NA", A3: "NAM", Num: "516",
 }, {
@@ -621,11 +681,14 @@ var cctab = []Country{{
 	Zip:   regexp.MustCompile(`^(?i)[0-9]{4}\s?[a-z]{2}$`),
 	Phone: regexp.MustCompile(`^(?:(?:(?:\+|00)?31\(0\))|(?:(?:\+|00)?31)|0)6{1}[0-9]{8}$`),
 	// 'NL'+9 digits+B+2-digit company index – e.g. NL999999999B01
-	VAT: regexp.MustCompile(`^NL[0-9]{9}B[0-9]{2}$`),
+	VAT:      regexp.MustCompile(`^NL[0-9]{9}B[0-9]{2}$`),
+	Passport: regexp.MustCompile(`^[A-Z]{2}[A-Z0-9]{6}[0-9]$`),
 }, {
 	A2: "NO", A3: "NOR", Num: "578",
-	Zip:   rxZip4Digits,
-	Phone: regexp.MustCompile(`^(?:\+?47)?[49][0-9]{7}$`),
+	Zip:          rxZip4Digits,
+	Phone:        regexp.MustCompile(`^(?:\+?47)?[49][0-9]{7}$`),
+	VAT:          StringMatcherFunc(noVAT),
+	IdentityCard: StringMatcherFunc(noIC),
 }, {
 	A2: "NP", A3: "NPL", Num: "524",
 	Zip:   regexp.MustCompile(`^(?:10|21|22|32|33|34|44|45|56|57)[0-9]{3}$|^(?:977)$`),
@@ -636,9 +699,10 @@ var cctab = []Country{{
 	A2: "NU", A3: "NIU", Num: "570",
 }, {
 	A2: "NZ", A3: "NZL", Num: "554",
-	Zip:   rxZip4Digits,
-	Phone: regexp.MustCompile(`^(?:\+?64|0)[28][0-9]{7,9}$`),
-	VAT:   regexp.MustCompile(`^[0-9]{9}$`),
+	Zip:      rxZip4Digits,
+	Phone:    regexp.MustCompile(`^(?:\+?64|0)[28][0-9]{7,9}$`),
+	VAT:      StringMatcherFunc(nzVAT),
+	Passport: regexp.MustCompile(`^(?:L[ADFH]|E[AP]|N)[0-9]{6}$`),
 }, {
 	A2: "OM", A3: "OMN", Num: "512",
 	Zip:   rxZip3Digits,
@@ -660,17 +724,21 @@ var cctab = []Country{{
 	Zip: regexp.MustCompile(`^[0-9]{3}$`),
 }, {
 	A2: "PH", A3: "PHL", Num: "608",
-	Zip:   rxZip4Digits,
-	Phone: regexp.MustCompile(`^(?:09|\+639)[0-9]{9}$`),
+	Zip:      rxZip4Digits,
+	Phone:    regexp.MustCompile(`^(?:09|\+639)[0-9]{9}$`),
+	Passport: regexp.MustCompile(`^(?:[A-Z](?:[0-9]{6}|[0-9]{7}[A-Z])|[A-Z]{2}(?:[0-9]{6}|[0-9]{7}))$`),
 }, {
 	A2: "PK", A3: "PAK", Num: "586",
-	Zip:   rxZip5Digits,
-	Phone: regexp.MustCompile(`^(?:(?:\+92)|(?:0092))-?[0-9]{3}-?[0-9]{7}$|^[0-9]{11}$|^[0-9]{4}-[0-9]{7}$`),
+	Zip:      rxZip5Digits,
+	Phone:    regexp.MustCompile(`^(?:(?:\+92)|(?:0092))-?[0-9]{3}-?[0-9]{7}$|^[0-9]{11}$|^[0-9]{4}-[0-9]{7}$`),
+	Passport: regexp.MustCompile(`^[A-Z]{2}[0-9]{7}$`),
 }, {
 	A2: "PL", A3: "POL", Num: "616",
-	Zip:   regexp.MustCompile(`^[0-9]{2}-[0-9]{3}$`),
-	Phone: regexp.MustCompile(`^(?:\+?48)?[ ]?[5-8][0-9][ ]?[0-9]{3}(?:[ ]?[0-9]{2}){2}$`),
-	VAT:   StringMatcherFunc(plVAT),
+	Zip:          regexp.MustCompile(`^[0-9]{2}-[0-9]{3}$`),
+	Phone:        regexp.MustCompile(`^(?:\+?48)?[ ]?[5-8][0-9][ ]?[0-9]{3}(?:[ ]?[0-9]{2}){2}$`),
+	VAT:          StringMatcherFunc(plVAT),
+	IdentityCard: StringMatcherFunc(plIC),
+	Passport:     regexp.MustCompile(`^[A-Z]{2}[0-9]{7}$`),
 }, {
 	A2: "PM", A3: "SPM", Num: "666",
 	Zip: regexp.MustCompile(`^97500$`),
@@ -684,9 +752,10 @@ var cctab = []Country{{
 	A2: "PS", A3: "PSE", Num: "275",
 }, {
 	A2: "PT", A3: "PRT", Num: "620",
-	Zip:   regexp.MustCompile(`^[0-9]{4}\-[0-9]{3}?$`),
-	Phone: regexp.MustCompile(`^(?:\+?351)?9[1236][0-9]{7}$`),
-	VAT:   StringMatcherFunc(ptVAT),
+	Zip:      regexp.MustCompile(`^[0-9]{4}\-[0-9]{3}?$`),
+	Phone:    regexp.MustCompile(`^(?:\+?351)?9[1236][0-9]{7}$`),
+	VAT:      StringMatcherFunc(ptVAT),
+	Passport: regexp.MustCompile(`^[A-Z][0-9]{6}$`),
 }, {
 	A2: "PW", A3: "PLW", Num: "585",
 	Zip: regexp.MustCompile(`^[0-9]{5}(?:-[0-9]{4})?$`),
@@ -703,9 +772,10 @@ var cctab = []Country{{
 	Phone: regexp.MustCompile(`^(?:\+?262|0|00262)[67][0-9]{8}$`),
 }, {
 	A2: "RO", A3: "ROU", Num: "642",
-	Zip:   rxZip6Digits,
-	Phone: regexp.MustCompile(`^(?:\+?4?0)[ ]?7[0-9]{2}(?:\/| |\.|\-)?[0-9]{3}(?: |\.|\-)?[0-9]{3}$`),
-	VAT:   regexp.MustCompile(`^RO[0-9]{8}$`),
+	Zip:      rxZip6Digits,
+	Phone:    regexp.MustCompile(`^(?:\+?4?0)[ ]?7[0-9]{2}(?:\/| |\.|\-)?[0-9]{3}(?: |\.|\-)?[0-9]{3}$`),
+	VAT:      regexp.MustCompile(`^RO[0-9]{8}$`),
+	Passport: regexp.MustCompile(`^[0-9]{8,9}$`),
 }, {
 	A2: "RS", A3: "SRB", Num: "688",
 	Zip:   rxZip5Digits,
@@ -713,8 +783,10 @@ var cctab = []Country{{
 	VAT:   StringMatcherFunc(rsVAT),
 }, {
 	A2: "RU", A3: "RUS", Num: "643",
-	Zip:   rxZip6Digits,
-	Phone: regexp.MustCompile(`^(?:\+?7|8)?9[0-9]{9}$`),
+	Zip:      rxZip6Digits,
+	Phone:    regexp.MustCompile(`^(?:\+?7|8)?9[0-9]{9}$`),
+	VAT:      StringMatcherFunc(ruVAT),
+	Passport: regexp.MustCompile(`^[0-9]{9}$`),
 }, {
 	A2: "RW", A3: "RWA", Num: "646",
 	Phone: regexp.MustCompile(`^(?:\+?250|0)?[7][0-9]{8}$`),
@@ -730,9 +802,10 @@ var cctab = []Country{{
 	A2: "SD", A3: "SDN", Num: "729", Zip: rxZip5Digits,
 }, {
 	A2: "SE", A3: "SWE", Num: "752",
-	Zip:   regexp.MustCompile(`^[1-9][0-9]{2}\s?[0-9]{2}$`),
-	Phone: regexp.MustCompile(`^(?:\+?46|0)[ \-]?7[ \-]?[02369](?:[ \-]?[0-9]){7}$`),
-	VAT:   StringMatcherFunc(seVAT),
+	Zip:      regexp.MustCompile(`^[1-9][0-9]{2}\s?[0-9]{2}$`),
+	Phone:    regexp.MustCompile(`^(?:\+?46|0)[ \-]?7[ \-]?[02369](?:[ \-]?[0-9]){7}$`),
+	VAT:      StringMatcherFunc(seVAT),
+	Passport: regexp.MustCompile(`^[0-9]{8}$`),
 }, {
 	A2: "SG", A3: "SGP", Num: "702",
 	Zip:   rxZip6Digits,
@@ -742,16 +815,18 @@ var cctab = []Country{{
 	Zip: regexp.MustCompile(`^(?:STHL|ASCN|TDCU) 1ZZ$`),
 }, {
 	A2: "SI", A3: "SVN", Num: "705",
-	Zip:   rxZip4Digits,
-	Phone: regexp.MustCompile(`^(?:\+386[ ]?|0)(?:(?:[0-9]{1}[ ]?[0-9]{3}(?:[ ]?[0-9]{2}){2})|(?:[0-9]{2}(?:[ ]?[0-9]{3}){2}))$`),
-	VAT:   StringMatcherFunc(siVAT),
+	Zip:      rxZip4Digits,
+	Phone:    regexp.MustCompile(`^(?:\+386[ ]?|0)(?:(?:[0-9]{1}[ ]?[0-9]{3}(?:[ ]?[0-9]{2}){2})|(?:[0-9]{2}(?:[ ]?[0-9]{3}){2}))$`),
+	VAT:      StringMatcherFunc(siVAT),
+	Passport: regexp.MustCompile(`^P[A-Z][0-9]{7}$`),
 }, {
 	A2: "SJ", A3: "SJM", Num: "744", Zip: rxZip4Digits,
 }, {
 	A2: "SK", A3: "SVK", Num: "703",
-	Zip:   regexp.MustCompile(`^[0-9]{3}\s?[0-9]{2}$`),
-	Phone: regexp.MustCompile(`^(?:\+?421)?[ ]?[1-9][0-9]{2}(?:[ ]?[0-9]{3}){2}$`),
-	VAT:   StringMatcherFunc(skVAT),
+	Zip:      regexp.MustCompile(`^[0-9]{3}\s?[0-9]{2}$`),
+	Phone:    regexp.MustCompile(`^(?:\+?421)?[ ]?[1-9][0-9]{2}(?:[ ]?[0-9]{3}){2}$`),
+	VAT:      StringMatcherFunc(skVAT),
+	Passport: regexp.MustCompile(`^[0-9A-Z][0-9]{7}$`),
 }, {
 	A2: "SL", A3: "SLE", Num: "694",
 	Phone: regexp.MustCompile(`^(?:0|94|\+94)?(?:7(?:0|1|2|5|6|7|8)(?: |-)?[0-9])[0-9]{6}$`),
@@ -794,8 +869,10 @@ var cctab = []Country{{
 	A2: "TG", A3: "TGO", Num: "768",
 }, {
 	A2: "TH", A3: "THA", Num: "764",
-	Zip:   rxZip5Digits,
-	Phone: regexp.MustCompile(`^(?:\+66|66|0)[0-9]{9}$`),
+	Zip:          rxZip5Digits,
+	Phone:        regexp.MustCompile(`^(?:\+66|66|0)[0-9]{9}$`),
+	IdentityCard: StringMatcherFunc(thIC),
+	Passport:     regexp.MustCompile(`^[A-Z]{1,2}[0-9]{6,7}$`),
 }, {
 	A2: "TJ", A3: "TJK", Num: "762",
 	Zip: rxZip6Digits,
@@ -808,15 +885,17 @@ var cctab = []Country{{
 	Zip: rxZip6Digits,
 }, {
 	A2: "TN", A3: "TUN", Num: "788",
-	Zip:   rxZip4Digits,
-	Phone: regexp.MustCompile(`^(?:\+?216)?[2459][0-9]{7}$`),
+	Zip:          rxZip4Digits,
+	Phone:        regexp.MustCompile(`^(?:\+?216)?[2459][0-9]{7}$`),
+	IdentityCard: regexp.MustCompile(`^[0-9]{8}$`),
 }, {
 	A2: "TO", A3: "TON", Num: "776",
 }, {
 	A2: "TR", A3: "TUR", Num: "792",
-	Zip:   rxZip5Digits,
-	Phone: regexp.MustCompile(`^(?:\+?90|0)?5[0-9]{9}$`),
-	VAT:   regexp.MustCompile(`^[0-9]{10}$`),
+	Zip:      rxZip5Digits,
+	Phone:    regexp.MustCompile(`^(?:\+?90|0)?5[0-9]{9}$`),
+	VAT:      StringMatcherFunc(trVAT),
+	Passport: regexp.MustCompile(`^[A-Z][0-9]{8}$`),
 }, {
 	A2: "TT", A3: "TTO", Num: "780",
 	Zip: rxZip6Digits,
@@ -824,17 +903,19 @@ var cctab = []Country{{
 	A2: "TV", A3: "TUV", Num: "798",
 }, {
 	A2: "TW", A3: "TWN", Num: "158",
-	Zip:   regexp.MustCompile(`^[0-9]{3}(?:[0-9]{2})?$`),
-	Phone: regexp.MustCompile(`^(?:\+?886-?|0)?9[0-9]{8}$`),
+	Zip:          regexp.MustCompile(`^[0-9]{3}(?:[0-9]{2})?$`),
+	Phone:        regexp.MustCompile(`^(?:\+?886-?|0)?9[0-9]{8}$`),
+	IdentityCard: StringMatcherFunc(twIC),
 }, {
 	A2: "TZ", A3: "TZA", Num: "834",
 	Zip:   rxZip5Digits,
 	Phone: regexp.MustCompile(`^(?:\+?255|0)?[67][0-9]{8}$`),
 }, {
 	A2: "UA", A3: "UKR", Num: "804",
-	Zip:   rxZip5Digits,
-	Phone: regexp.MustCompile(`^(?:\+?38|8)?0[0-9]{9}$`),
-	VAT:   regexp.MustCompile(`^[0-9]{12}$`),
+	Zip:      rxZip5Digits,
+	Phone:    regexp.MustCompile(`^(?:\+?38|8)?0[0-9]{9}$`),
+	VAT:      regexp.MustCompile(`^[0-9]{12}$`),
+	Passport: regexp.MustCompile(`^[A-Z]{2}[0-9]{6}$`),
 }, {
 	A2: "UG", A3: "UGA", Num: "800",
 	Phone: regexp.MustCompile(`^(?:\+?256|0)?7[0-9]{8}$`),
@@ -847,6 +928,7 @@ var cctab = []Country{{
 	Phone: regexp.MustCompile(`^(?:(?:\+?1)?[ -]?)?` +
 		`(?:\([2-9][0-9]{2}\)|[2-9][0-9]{2})` +
 		`[ -]?(?:[2-9][0-9]{2}[ -]?[0-9]{4})$`),
+	Passport: regexp.MustCompile(`^[0-9]{9}$`),
 }, {
 	A2: "UY", A3: "URY", Num: "858",
 	Zip:   rxZip5Digits,
@@ -894,8 +976,10 @@ var cctab = []Country{{
 	Zip: regexp.MustCompile(`^976(?:[0-8][0-9]|90)$`),
 }, {
 	A2: "ZA", A3: "ZAF", Num: "710",
-	Zip:   rxZip4Digits,
-	Phone: regexp.MustCompile(`^(?:\+?27|0)[0-9]{9}$`),
+	Zip:      rxZip4Digits,
+	Phone:    regexp.MustCompile(`^(?:\+?27|0)[0-9]{9}$`),
+	VAT:      StringMatcherFunc(zaVAT),
+	Passport: regexp.MustCompile(`^[TAMD][0-9]{8}$`),
 }, {
 	A2: "ZM", A3: "ZMB", Num: "894",
 	Zip:   rxZip5Digits,
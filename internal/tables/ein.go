@@ -0,0 +1,90 @@
+package tables
+
+// Map of valid Employer Identification Number prefixes with the
+// corresponding IRS campus that assigns them.
+// Reference: https://www.irs.gov/businesses/small-businesses-self-employed/how-eins-are-assigned-and-valid-ein-prefixes
+var EINPrefix = map[string]string{
+	"01": "brookhaven",
+	"02": "brookhaven",
+	"03": "brookhaven",
+	"04": "brookhaven",
+	"05": "brookhaven",
+	"06": "brookhaven",
+	"10": "andover",
+	"11": "brookhaven",
+	"12": "andover",
+	"13": "brookhaven",
+	"14": "brookhaven",
+	"15": "fresno",
+	"16": "brookhaven",
+	"20": "internet",
+	"21": "brookhaven",
+	"22": "brookhaven",
+	"23": "brookhaven",
+	"24": "fresno",
+	"25": "brookhaven",
+	"26": "internet",
+	"27": "internet",
+	"30": "cincinnati",
+	"31": "sba",
+	"32": "cincinnati",
+	"33": "philadelphia",
+	"34": "brookhaven",
+	"35": "cincinnati",
+	"36": "cincinnati",
+	"37": "cincinnati",
+	"38": "cincinnati",
+	"39": "philadelphia",
+	"40": "kansas",
+	"41": "philadelphia",
+	"42": "philadelphia",
+	"43": "philadelphia",
+	"44": "kansas",
+	"45": "internet",
+	"46": "internet",
+	"47": "internet",
+	"48": "philadelphia",
+	"50": "austin",
+	"51": "brookhaven",
+	"52": "brookhaven",
+	"53": "austin",
+	"54": "brookhaven",
+	"55": "brookhaven",
+	"56": "brookhaven",
+	"57": "brookhaven",
+	"58": "brookhaven",
+	"59": "brookhaven",
+	"60": "atlanta",
+	"61": "cincinnati",
+	"62": "philadelphia",
+	"63": "philadelphia",
+	"64": "philadelphia",
+	"65": "brookhaven",
+	"66": "philadelphia",
+	"67": "atlanta",
+	"68": "philadelphia",
+	"71": "philadelphia",
+	"72": "philadelphia",
+	"73": "philadelphia",
+	"74": "philadelphia",
+	"75": "philadelphia",
+	"76": "philadelphia",
+	"77": "philadelphia",
+	"80": "ogden",
+	"81": "philadelphia",
+	"82": "philadelphia",
+	"83": "philadelphia",
+	"84": "philadelphia",
+	"85": "philadelphia",
+	"86": "philadelphia",
+	"87": "philadelphia",
+	"88": "philadelphia",
+	"90": "ogden",
+	"91": "philadelphia",
+	"92": "philadelphia",
+	"93": "philadelphia",
+	"94": "memphis",
+	"95": "memphis",
+	"98": "philadelphia",
+	"99": "philadelphia",
+}
@@ -0,0 +1,366 @@
+// Package tag implements the parser of the "rule" struct tags, i.e. the
+// "is" and "pre" tags, that is shared by the cmd/validgen tool and by the
+// runtime validator of the github.com/frk/valid/dynamic package.
+package tag
+
+import (
+	"strings"
+
+	"github.com/frk/valid"
+)
+
+// A Tag is a tree-like representation of a parsed "rule" struct tag.
+type Tag struct {
+	// The list of rules present at this level of the tree.
+	Rules []*Rule
+	// Key and Elem are child nodes of this Tag.
+	Key, Elem *Tag
+}
+
+// AddRule adds the given rule to the Tag. If the Tag already
+// contains a rule with the same name and group, then the
+// given rule will be ignored.
+func (t *Tag) AddRule(r *Rule) {
+	if t != nil {
+		for i := range t.Rules {
+			if t.Rules[i].TagName() == r.TagName() && t.Rules[i].Group == r.Group {
+				return
+			}
+		}
+		t.Rules = append(t.Rules, r)
+	}
+}
+
+// GetRules returns the Tag's rules, or nil if the Tag is nil.
+func (t *Tag) GetRules() []*Rule {
+	if t != nil {
+		return t.Rules
+	}
+	return nil
+}
+
+// Rule represents the rule as parsed from a struct tag.
+type Rule struct {
+	// The name of the rule.
+	Name string
+	// The arguments of the rule.
+	Args []*Arg
+	// The name of the validation group to which the rule is
+	// restricted, or empty if the rule applies to all groups.
+	Group string
+	// Indicates that the rule is negated, e.g. `is:"!contains:foo"`.
+	Negated bool
+	// If the rule is an alternation group, e.g. `is:"(ip|fqdn)"`,
+	// then Alt holds the group's rules.
+	Alt []*Rule
+}
+
+// TagName returns the name of the rule as it appears in the
+// struct tag, i.e. prefixed with "!" if the rule is negated.
+func (r Rule) TagName() string {
+	if r.Negated {
+		return "!" + r.Name
+	}
+	return r.Name
+}
+
+// Arg represents an argument for a rule.
+type Arg struct {
+	// The type of the argument.
+	Type ArgType
+	// The literal string representation of the value.
+	Value string
+}
+
+// ArgType indicates the type of a rule argument value.
+type ArgType uint
+
+func (t ArgType) String() string {
+	if int(t) < len(_argtypestring) {
+		return _argtypestring[t]
+	}
+	return "<invalid>"
+}
+
+const (
+	ARG_UNKNOWN ArgType = iota
+	ARG_BOOL
+	ARG_INT
+	ARG_FLOAT
+	ARG_STRING
+	ARG_FIELD_ABS
+	ARG_FIELD_REL
+)
+
+var _argtypestring = [...]string{
+	ARG_UNKNOWN:   "<unknown>",
+	ARG_BOOL:      "bool",
+	ARG_INT:       "int",
+	ARG_FLOAT:     "float",
+	ARG_STRING:    "string",
+	ARG_FIELD_ABS: "<field_abs>",
+	ARG_FIELD_REL: "<field_rel>",
+}
+
+// Parse parses the given rule string, i.e. the value of
+// a "rule" struct tag, and returns the resulting AST.
+//
+// Following is a description of the rule syntax using EBNF:
+//
+//	node      = rule | [ "[" [ node ] "]" ] [ ( node | rule "," node ) ] .
+//	rule      = ( rule_item | alt_group ) { "," rule } .
+//	rule_item = [ "!" ] rule_name [ "@" group ] [ { ":" rule_arg } ] .
+//	alt_group = "(" rule_item { "|" rule_item } ")" [ "@" group ] .
+//	rule_name = identifier .
+//	group     = identifier .
+//	rule_arg  = | boolean_lit | integer_lit | float_lit | string_lit | quoted_string_lit | field_reference .
+//
+//	boolean_lit       = "true" | "false" .
+//	integer_lit       = "0" | [ "-" ] "1"…"9" { "0"…"9" } .
+//	float_lit         = [ "-" ] ( "0" | "1"…"9" { "0"…"9" } ) "." "0"…"9" { "0"…"9" } .
+//	string_lit        = .
+//	quoted_string_lit = `"` `"` .
+//
+//	field_reference = field_ref_abs | field_ref_rel
+//	field_ref_abs   = "&" field_key .
+//	field_ref_rel   = "." field_key .
+//	field_key       = identifier { field_key_separator identifier } .
+//	field_key_sep   = "." | (* optionally specified by the user *)
+//
+//	identifier = letter { letter } .
+//	letter     = "A"…"Z" | "a"…"z" | "_" .
+func Parse(str string) *Tag {
+	tag := &Tag{}
+	for str != "" {
+		// skip leading space
+		i := 0
+		for i < len(str) && str[i] == ' ' {
+			i++
+		}
+		str = str[i:]
+		if str == "" {
+			break
+		}
+
+		// parse bracketed nodes
+		if str[0] == '[' {
+
+			// scan up to the *matching* closing bracket
+			i, n := 1, 0
+			for i < len(str) && (str[i] != ']' || n > 0) {
+				// adjust nesting level
+				if str[i] == '[' {
+					n++
+				} else if str[i] == ']' {
+					n--
+				}
+				i++
+
+				// scan quoted string, ignoring brackets inside quotes
+				if str[i-1] == '"' {
+					for i < len(str) && str[i] != '"' {
+						if str[i] == '\\' {
+							i++
+						}
+						i++
+					}
+
+					// keep the closing double quote, or
+					// else the subsequent parser calls
+					// will be confused without it
+					if i < len(str) {
+						i++
+					}
+				}
+			}
+
+			// recursively invoke parser for key
+			if ktag := str[1:i]; len(ktag) > 0 {
+				tag.Key = Parse(ktag)
+			}
+			// recursively invoke parser for elem
+			if etag := str[i:]; len(etag) > 1 {
+				etag = etag[1:] // drop the leading ']'
+				tag.Elem = Parse(etag)
+			}
+
+			// done; exit
+			return tag
+		}
+
+		// parse alternation groups
+		if str[0] == '(' {
+			// scan up to the closing parenthesis, splitting
+			// the group's rules at the "|" separators
+			var alts []string
+			i, j := 1, 1
+			for i < len(str) && str[i] != ')' {
+				if str[i] == '|' {
+					alts = append(alts, str[j:i])
+					j = i + 1
+				}
+				i++
+
+				// scan quoted string, ignoring parentheses
+				// and separators inside quotes
+				if str[i-1] == '"' {
+					for i < len(str) && str[i] != '"' {
+						if str[i] == '\\' {
+							i++
+						}
+						i++
+					}
+					if i < len(str) {
+						i++
+					}
+				}
+			}
+			alts = append(alts, str[j:min(i, len(str))])
+
+			rule := &Rule{}
+			names := []string{}
+			for _, a := range alts {
+				for _, r := range Parse(a).GetRules() {
+					rule.Alt = append(rule.Alt, r)
+					names = append(names, r.TagName())
+				}
+			}
+			rule.Name = strings.Join(names, "|")
+
+			// drop the closing parenthesis
+			if str = str[min(i+1, len(str)):]; len(str) > 0 && str[0] == '@' {
+				i := 0
+				for i < len(str) && str[i] != ',' {
+					i++
+				}
+				rule.Group = str[1:i]
+				str = str[i:]
+			}
+			if len(rule.Alt) > 0 {
+				tag.AddRule(rule)
+			}
+
+			// drop rule separator
+			if len(str) > 0 && str[0] == ',' {
+				str = str[1:]
+			}
+			continue
+		}
+
+		// scan to the end of a rule's name
+		i = 0
+		for i < len(str) && str[i] != ',' && str[i] != ':' {
+			i++
+		}
+
+		// empty name's no good; next
+		if str[:i] == "" {
+			str = str[1:]
+			continue
+		}
+
+		rule := &Rule{Name: str[:i]}
+		if j := strings.IndexByte(rule.Name, '@'); j > -1 {
+			rule.Name, rule.Group = rule.Name[:j], rule.Name[j+1:]
+		}
+		if len(rule.Name) > 1 && rule.Name[0] == '!' {
+			rule.Name, rule.Negated = rule.Name[1:], true
+		}
+		tag.AddRule(rule)
+
+		// this rule's done; next or exit
+		if str = str[i:]; str == "" {
+			break
+		} else if str[0] == ',' {
+			str = str[1:]
+			continue
+		}
+
+		// scan the rule's arguments
+		for str != "" {
+			str = str[1:] // drop the leading ':'
+
+			// quoted argument value; scan to the end quote
+			if len(str) > 0 && str[0] == '"' {
+				i := 1
+				for i < len(str) && str[i] != '"' {
+					if str[i] == '\\' {
+						i++
+					}
+					i++
+				}
+
+				a := &Arg{}
+				a.Type = ARG_STRING
+				a.Value = str[1:i]
+				rule.Args = append(rule.Args, a)
+
+				str = str[i:]
+
+				// drop the closing quote
+				if len(str) > 0 && str[0] == '"' {
+					str = str[1:]
+				}
+
+				// next argument?
+				if len(str) > 0 && str[0] == ':' {
+					continue
+				}
+
+				// drop rule separator
+				if len(str) > 0 && str[0] == ',' {
+					str = str[1:]
+				}
+
+				// this rule's done; exit
+				break
+			}
+
+			// scan to the end of a rule's argument
+			i := 0
+			for i < len(str) && str[i] != ':' && str[i] != ',' {
+				i++
+			}
+
+			astr := str[:i]
+			arg := ParseArg(astr)
+			rule.Args = append(rule.Args, arg)
+
+			str = str[i:]
+			if str == "" {
+				break
+			} else if str[0] == ',' {
+				str = str[1:]
+				break
+			}
+		}
+	}
+	return tag
+}
+
+// ParseArg parses the given string as an Arg and returns the result.
+func ParseArg(str string) (a *Arg) {
+	a = &Arg{}
+	if len(str) > 0 {
+		if str[0] == '&' {
+			a.Type = ARG_FIELD_ABS
+			a.Value = str[1:]
+		} else if str[0] == '.' {
+			a.Type = ARG_FIELD_REL
+			a.Value = str[1:]
+		} else {
+			switch {
+			case valid.Int(str):
+				a.Type = ARG_INT
+			case valid.Float(str):
+				a.Type = ARG_FLOAT
+			case str == "true" || str == "false":
+				a.Type = ARG_BOOL
+			case str != `nil`:
+				a.Type = ARG_STRING
+			}
+			a.Value = str
+		}
+	}
+	return a
+}
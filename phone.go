@@ -0,0 +1,180 @@
+package valid
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/frk/valid/internal/l10n"
+)
+
+var (
+	// ErrPhoneInvalid is returned by ParsePhone if the input
+	// cannot be parsed as a phone number.
+	ErrPhoneInvalid = errors.New("valid: invalid phone number")
+	// ErrPhoneCountry is returned by ParsePhone if the input is not in
+	// international format and the default country code is unknown or
+	// if the country has no phone number metadata.
+	ErrPhoneCountry = errors.New("valid: unknown or unsupported phone number country")
+)
+
+// PhoneType identifies the type of a phone number.
+type PhoneType uint8
+
+const (
+	PhoneUnknown       PhoneType = iota // the type could not be determined
+	PhoneFixed                          // fixed-line number
+	PhoneMobile                         // mobile number
+	PhoneFixedOrMobile                  // the country does not distinguish fixed-line & mobile numbers
+	PhoneTollFree                       // toll-free number
+)
+
+func (t PhoneType) String() string {
+	switch t {
+	case PhoneFixed:
+		return "fixed"
+	case PhoneMobile:
+		return "mobile"
+	case PhoneFixedOrMobile:
+		return "fixed-or-mobile"
+	case PhoneTollFree:
+		return "toll-free"
+	}
+	return "unknown"
+}
+
+// PhoneNumber is the result of a successfully parsed phone number.
+type PhoneNumber struct {
+	// The ISO 3166-1 alpha-2 code of the country to which the number belongs.
+	Country string
+	// The country calling code, without the leading "+", e.g. "1" or "44".
+	CallingCode string
+	// The national significant number, i.e. the number without
+	// the calling code, the national prefix, and any formatting.
+	NationalNumber string
+	// The type of the number.
+	Type PhoneType
+	// The number in E.164 format, e.g. "+442079460958".
+	E164 string
+}
+
+// ParsePhone parses v as a phone number and returns the result. If v is not
+// in international format, i.e. it does not begin with "+" or with the default
+// country's international dialing prefix, then v is interpreted as a national
+// number of the country identified by defaultCC.
+//
+// The country code defaultCC can be an ISO 3166-1 alpha-2 or alpha-3 code.
+// Only countries for which the internal/l10n package provides phone number
+// metadata are supported.
+func ParsePhone(v string, defaultCC string) (PhoneNumber, error) {
+	digits, intl, ok := phoneDigits(v)
+	if !ok {
+		return PhoneNumber{}, ErrPhoneInvalid
+	}
+
+	var def *l10n.PhoneMeta
+	if c, ok := l10n.Get(defaultCC); ok {
+		def = c.PhoneMeta
+	}
+
+	if !intl && def != nil && len(def.IntlPrefix) > 0 && strings.HasPrefix(digits, def.IntlPrefix) {
+		digits, intl = digits[len(def.IntlPrefix):], true
+	}
+	if intl {
+		// Country calling codes are prefix-free and at most
+		// three digits long, the first one found is the one.
+		for i := 1; i <= 3 && i < len(digits); i++ {
+			if list := l10n.PhoneCallingCode(digits[:i]); list != nil {
+				return parsePhoneNSN(digits[i:], list, def)
+			}
+		}
+		return PhoneNumber{}, ErrPhoneInvalid
+	}
+
+	if def == nil {
+		return PhoneNumber{}, ErrPhoneCountry
+	}
+	list := l10n.PhoneCallingCode(def.CallingCode)
+	if p := def.NationalPrefix; len(p) > 0 && strings.HasPrefix(digits, p) {
+		if num, err := parsePhoneNSN(digits[len(p):], list, def); err == nil {
+			return num, nil
+		}
+	}
+	return parsePhoneNSN(digits, list, def)
+}
+
+// parsePhoneNSN classifies the national significant number nsn against
+// the metadata of the countries in list, which are expected to share the
+// same calling code. The metadata of the default country def, if present
+// in the list, is tried first.
+func parsePhoneNSN(nsn string, list []*l10n.PhoneMeta, def *l10n.PhoneMeta) (PhoneNumber, error) {
+	if len(list) == 0 || len(nsn) == 0 || len(list[0].CallingCode)+len(nsn) > 15 {
+		return PhoneNumber{}, ErrPhoneInvalid
+	}
+
+	for i := -1; i < len(list); i++ {
+		m := def
+		if i >= 0 {
+			if m = list[i]; m == def {
+				continue
+			}
+		}
+		if m == nil || m.CallingCode != list[0].CallingCode {
+			continue
+		}
+
+		if typ := phoneType(nsn, m); typ != PhoneUnknown {
+			return PhoneNumber{
+				Country:        m.A2,
+				CallingCode:    m.CallingCode,
+				NationalNumber: nsn,
+				Type:           typ,
+				E164:           "+" + m.CallingCode + nsn,
+			}, nil
+		}
+	}
+	return PhoneNumber{}, ErrPhoneInvalid
+}
+
+// phoneType returns the type of the national significant number nsn
+// as determined by the given metadata.
+func phoneType(nsn string, m *l10n.PhoneMeta) PhoneType {
+	if m.TollFree != nil && m.TollFree.MatchString(nsn) {
+		return PhoneTollFree
+	}
+
+	mobile := m.Mobile != nil && m.Mobile.MatchString(nsn)
+	fixed := m.Fixed != nil && m.Fixed.MatchString(nsn)
+	switch {
+	case mobile && fixed:
+		return PhoneFixedOrMobile
+	case mobile:
+		return PhoneMobile
+	case fixed:
+		return PhoneFixed
+	}
+	return PhoneUnknown
+}
+
+// phoneDigits returns the digits of the phone number v with the formatting
+// characters removed. The intl result reports whether v starts with a "+".
+// If v contains characters that are not allowed in a phone number then
+// ok will be false.
+func phoneDigits(v string) (digits string, intl bool, ok bool) {
+	v = strings.TrimSpace(v)
+	if len(v) > 0 && v[0] == '+' {
+		v, intl = v[1:], true
+	}
+
+	b := make([]byte, 0, len(v))
+	for i := 0; i < len(v); i++ {
+		switch c := v[i]; {
+		case c >= '0' && c <= '9':
+			b = append(b, c)
+		case c == ' ' || c == '-' || c == '.' || c == '(' || c == ')' || c == '/':
+			// formatting, ignore
+		default:
+			return "", false, false
+		}
+	}
+	return string(b), intl, len(b) > 0
+}
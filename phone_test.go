@@ -0,0 +1,95 @@
+package valid
+
+import (
+	"testing"
+)
+
+func TestParsePhone(t *testing.T) {
+	tests := []struct {
+		v    string
+		cc   string
+		want PhoneNumber
+		err  error
+	}{
+		// international format
+		{v: "+1 (202) 555-0123", cc: "", want: PhoneNumber{"US", "1", "2025550123", PhoneFixedOrMobile, "+12025550123"}},
+		{v: "+1 613 555 0123", cc: "", want: PhoneNumber{"CA", "1", "6135550123", PhoneFixedOrMobile, "+16135550123"}},
+		{v: "+1 800 555 0123", cc: "", want: PhoneNumber{"US", "1", "8005550123", PhoneTollFree, "+18005550123"}},
+		{v: "+1 800 555 0123", cc: "ca", want: PhoneNumber{"CA", "1", "8005550123", PhoneTollFree, "+18005550123"}},
+		{v: "+44 7911 123456", cc: "us", want: PhoneNumber{"GB", "44", "7911123456", PhoneMobile, "+447911123456"}},
+		{v: "+44 20 7946 0958", cc: "", want: PhoneNumber{"GB", "44", "2079460958", PhoneFixed, "+442079460958"}},
+		{v: "+49 30 123456", cc: "", want: PhoneNumber{"DE", "49", "30123456", PhoneFixed, "+4930123456"}},
+		{v: "+39 06 6982", cc: "", want: PhoneNumber{"IT", "39", "066982", PhoneFixed, "+39066982"}},
+		{v: "+385 91 234 5678", cc: "", want: PhoneNumber{"HR", "385", "912345678", PhoneMobile, "+385912345678"}},
+		{v: "+7 912 345-67-89", cc: "", want: PhoneNumber{"RU", "7", "9123456789", PhoneMobile, "+79123456789"}},
+		{v: "+45 32 12 34 56", cc: "", want: PhoneNumber{"DK", "45", "32123456", PhoneFixedOrMobile, "+4532123456"}},
+
+		// international dialing prefix
+		{v: "0044 7911 123456", cc: "de", want: PhoneNumber{"GB", "44", "7911123456", PhoneMobile, "+447911123456"}},
+		{v: "011 44 7911 123456", cc: "us", want: PhoneNumber{"GB", "44", "7911123456", PhoneMobile, "+447911123456"}},
+
+		// national format
+		{v: "(202) 555-0123", cc: "us", want: PhoneNumber{"US", "1", "2025550123", PhoneFixedOrMobile, "+12025550123"}},
+		{v: "1-202-555-0123", cc: "usa", want: PhoneNumber{"US", "1", "2025550123", PhoneFixedOrMobile, "+12025550123"}},
+		{v: "613.555.0123", cc: "us", want: PhoneNumber{"CA", "1", "6135550123", PhoneFixedOrMobile, "+16135550123"}},
+		{v: "07911 123456", cc: "gb", want: PhoneNumber{"GB", "44", "7911123456", PhoneMobile, "+447911123456"}},
+		{v: "0800 123 4567", cc: "GB", want: PhoneNumber{"GB", "44", "8001234567", PhoneTollFree, "+448001234567"}},
+		{v: "06 12 34 56 78", cc: "fr", want: PhoneNumber{"FR", "33", "612345678", PhoneMobile, "+33612345678"}},
+		{v: "01 23 45 67 89", cc: "fr", want: PhoneNumber{"FR", "33", "123456789", PhoneFixed, "+33123456789"}},
+		{v: "06 6982 0000", cc: "it", want: PhoneNumber{"IT", "39", "0669820000", PhoneFixed, "+390669820000"}},
+		{v: "090-1234-5678", cc: "jp", want: PhoneNumber{"JP", "81", "9012345678", PhoneMobile, "+819012345678"}},
+		{v: "0120-123-456", cc: "jp", want: PhoneNumber{"JP", "81", "120123456", PhoneTollFree, "+81120123456"}},
+		{v: "8 (912) 345-67-89", cc: "ru", want: PhoneNumber{"RU", "7", "9123456789", PhoneMobile, "+79123456789"}},
+		{v: "612 345 678", cc: "es", want: PhoneNumber{"ES", "34", "612345678", PhoneMobile, "+34612345678"}},
+		{v: "0412 345 678", cc: "au", want: PhoneNumber{"AU", "61", "412345678", PhoneMobile, "+61412345678"}},
+
+		// errors
+		{v: "", cc: "us", err: ErrPhoneInvalid},
+		{v: "+", cc: "us", err: ErrPhoneInvalid},
+		{v: "202-555-0123 ext. 5", cc: "us", err: ErrPhoneInvalid},
+		{v: "202+555+0123", cc: "us", err: ErrPhoneInvalid},
+		{v: "+1 202 555 012", cc: "", err: ErrPhoneInvalid},
+		{v: "+1 102 555 0123", cc: "", err: ErrPhoneInvalid},
+		{v: "+376 312345", cc: "", err: ErrPhoneInvalid},
+		{v: "+44 7911 123456 123456", cc: "", err: ErrPhoneInvalid},
+		{v: "07911 12345", cc: "gb", err: ErrPhoneInvalid},
+		{v: "2025550123", cc: "", err: ErrPhoneCountry},
+		{v: "2025550123", cc: "xx", err: ErrPhoneCountry},
+		{v: "312345", cc: "ad", err: ErrPhoneCountry},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.cc+"/"+tt.v, func(t *testing.T) {
+			got, err := ParsePhone(tt.v, tt.cc)
+			if err != tt.err {
+				t.Errorf("err got=%v; want=%v", err, tt.err)
+			}
+			if got != tt.want {
+				t.Errorf("got=%+v; want=%+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPhoneE164(t *testing.T) {
+	tests := []struct {
+		v    string
+		cc   string
+		want string
+	}{
+		{v: "(202) 555-0123", cc: "us", want: "+12025550123"},
+		{v: "+44 20 7946 0958", cc: "us", want: "+442079460958"},
+		{v: "020 7946 0958", cc: "gb", want: "+442079460958"},
+		{v: "not a number", cc: "us", want: "not a number"},
+		{v: "020 7946 0958", cc: "us", want: "020 7946 0958"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.cc+"/"+tt.v, func(t *testing.T) {
+			got := PhoneE164(tt.v, tt.cc)
+			if got != tt.want {
+				t.Errorf("got=%q; want=%q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package valid
+
+// Unique reports whether or not the elements of s are unique. If they are
+// not, the index of the first element that is equal to one of the elements
+// preceding it is returned as well. Unique is used by the generated code to
+// implement the "unique" rule.
+func Unique[S ~[]E, E comparable](s S) (int, bool) {
+	seen := make(map[E]struct{}, len(s))
+	for i, e := range s {
+		if _, ok := seen[e]; ok {
+			return i, false
+		}
+		seen[e] = struct{}{}
+	}
+	return -1, true
+}
+
+// UniqueFunc is like Unique but it compares the values returned
+// by the key function for each of the indexes from 0 to n-1.
+func UniqueFunc[K comparable](n int, key func(i int) K) (int, bool) {
+	seen := make(map[K]struct{}, n)
+	for i := 0; i < n; i++ {
+		k := key(i)
+		if _, ok := seen[k]; ok {
+			return i, false
+		}
+		seen[k] = struct{}{}
+	}
+	return -1, true
+}
+
+// UniqueMap reports whether or not the values of m are unique. If they are
+// not, the key of one of the duplicate values is returned as well. Since
+// the iteration order of maps is not specified, neither is which one of
+// the duplicate values' keys is returned.
+func UniqueMap[M ~map[K]V, K, V comparable](m M) (K, bool) {
+	seen := make(map[V]struct{}, len(m))
+	for k, v := range m {
+		if _, ok := seen[v]; ok {
+			return k, false
+		}
+		seen[v] = struct{}{}
+	}
+	var zero K
+	return zero, true
+}
+
+// UniqueMapFunc is like UniqueMap but it compares the values
+// returned by the key function for each of the keys of m.
+func UniqueMapFunc[M ~map[K]V, K comparable, V any, F comparable](m M, key func(k K) F) (K, bool) {
+	seen := make(map[F]struct{}, len(m))
+	for k := range m {
+		f := key(k)
+		if _, ok := seen[f]; ok {
+			return k, false
+		}
+		seen[f] = struct{}{}
+	}
+	var zero K
+	return zero, true
+}
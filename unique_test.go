@@ -0,0 +1,72 @@
+package valid
+
+import (
+	"testing"
+)
+
+func TestUnique(t *testing.T) {
+	tests := []struct {
+		s    []string
+		idx  int
+		want bool
+	}{
+		{s: nil, idx: -1, want: true},
+		{s: []string{"a"}, idx: -1, want: true},
+		{s: []string{"a", "b", "c"}, idx: -1, want: true},
+		{s: []string{"a", "b", "a"}, idx: 2, want: false},
+		{s: []string{"a", "b", "b", "a"}, idx: 2, want: false},
+	}
+	for _, tt := range tests {
+		idx, ok := Unique(tt.s)
+		if idx != tt.idx || ok != tt.want {
+			t.Errorf("Unique(%q) got=(%d, %t) want=(%d, %t)", tt.s, idx, ok, tt.idx, tt.want)
+		}
+	}
+}
+
+func TestUniqueFunc(t *testing.T) {
+	type T struct {
+		ID   int
+		Name string
+	}
+	tests := []struct {
+		s    []T
+		idx  int
+		want bool
+	}{
+		{s: nil, idx: -1, want: true},
+		{s: []T{{1, "a"}, {2, "a"}}, idx: -1, want: true},
+		{s: []T{{1, "a"}, {2, "b"}, {1, "c"}}, idx: 2, want: false},
+	}
+	for _, tt := range tests {
+		idx, ok := UniqueFunc(len(tt.s), func(i int) int { return tt.s[i].ID })
+		if idx != tt.idx || ok != tt.want {
+			t.Errorf("UniqueFunc(%v) got=(%d, %t) want=(%d, %t)", tt.s, idx, ok, tt.idx, tt.want)
+		}
+	}
+}
+
+func TestUniqueMap(t *testing.T) {
+	tests := []struct {
+		m    map[string]int
+		want bool
+	}{
+		{m: nil, want: true},
+		{m: map[string]int{"a": 1, "b": 2}, want: true},
+		{m: map[string]int{"a": 1, "b": 2, "c": 1}, want: false},
+	}
+	for _, tt := range tests {
+		k, ok := UniqueMap(tt.m)
+		if ok != tt.want {
+			t.Errorf("UniqueMap(%v) got=%t want=%t", tt.m, ok, tt.want)
+		}
+		if !ok && k != "a" && k != "c" {
+			t.Errorf("UniqueMap(%v) got key %q, want one of the duplicates' keys", tt.m, k)
+		}
+	}
+
+	m := map[int]struct{ Name string }{1: {"a"}, 2: {"b"}, 3: {"b"}}
+	if k, ok := UniqueMapFunc(m, func(k int) string { return m[k].Name }); ok || (k != 2 && k != 3) {
+		t.Errorf("UniqueMapFunc(%v) got=(%d, %t) want=(2|3, false)", m, k, ok)
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 	"unicode"
 	"unicode/utf8"
 
@@ -394,6 +395,24 @@ func DataURI(v string) bool {
 	return true
 }
 
+// Date reports whether or not v is a valid date, or time, in the format specified
+// by layout. The layout can be either a Go time layout, e.g. "2006-01-02", or the
+// case-insensitive name of one of the layouts predefined by the standard "time"
+// package, e.g. "rfc1123" or "datetime".
+//
+// valid:rule.yaml
+//
+//	name: date
+//	args: [{ default: "2006-01-02" }]
+//	error: { text: "must be a valid date" }
+func Date(v string, layout string) bool {
+	if l, ok := tables.TimeLayout[strings.ToLower(layout)]; ok {
+		layout = l
+	}
+	_, err := time.Parse(layout, v)
+	return err == nil
+}
+
 // Decimal reports whether or not v represents a valid decimal number.
 //
 // valid:rule.yaml
@@ -528,15 +547,22 @@ func EAN(v string) bool {
 	return check == btoi(v[length-1])
 }
 
+var rxEIN = regexp.MustCompile(`^[0-9]{2}[- ]?[0-9]{7}$`)
+
 // EIN reports whether or not v is a valid Employer Identification Number.
+// The number may optionally have a hyphen, or a space, after the prefix, and
+// the prefix must be one of the prefixes assigned by the IRS campuses.
 //
 // valid:rule.yaml
 //
 //	name: ein
 //	error: { text: "must be a valid EIN" }
-func todo_EIN(v string) bool {
-	// TODO
-	return false
+func EIN(v string) bool {
+	if !rxEIN.MatchString(v) {
+		return false
+	}
+	_, ok := tables.EINPrefix[v[:2]]
+	return ok
 }
 
 var rxETH = regexp.MustCompile(`^0x[0-9a-fA-F]{40}$`)
@@ -579,6 +605,15 @@ var rxFQDNPartIllegal = regexp.MustCompile(`[\x{ff01}-\x{ff5e}]`)
 //	name: fqdn
 //	error: { text: "must be a valid FQDN" }
 func FQDN(v string) bool {
+	return fqdn(v, true, false, false)
+}
+
+// fqdn implements FQDN with the additional options used by URL.
+func fqdn(v string, requireTLD, allowUnderscores, allowTrailingDot bool) bool {
+	if allowTrailingDot && len(v) > 0 && v[len(v)-1] == '.' {
+		v = v[:len(v)-1]
+	}
+
 	parts := strings.Split(v, ".")
 	for _, part := range parts {
 		if len(part) > 63 {
@@ -587,11 +622,16 @@ func FQDN(v string) bool {
 	}
 
 	// tld must be present, must match pattern, must not contain illegal chars, must not be all digits
-	if len(parts) < 2 {
-		return false
-	}
 	tld := parts[len(parts)-1]
-	if !rxTLD.MatchString(tld) || rxTLDIllegal.MatchString(tld) || rxDigits.MatchString(tld) {
+	if requireTLD {
+		if len(parts) < 2 {
+			return false
+		}
+		if !rxTLD.MatchString(tld) || rxTLDIllegal.MatchString(tld) {
+			return false
+		}
+	}
+	if rxDigits.MatchString(tld) {
 		return false
 	}
 
@@ -599,6 +639,11 @@ func FQDN(v string) bool {
 		if len(part) > 0 && (part[0] == '-' || part[len(part)-1] == '-') {
 			return false
 		}
+		if allowUnderscores {
+			// underscores are the only chars allowed in
+			// addition to those matched by rxFQDNPart
+			part = strings.ReplaceAll(part, "_", "x")
+		}
 		if !rxFQDNPart.MatchString(part) || rxFQDNPartIllegal.MatchString(part) {
 			return false
 		}
@@ -730,17 +775,6 @@ func IBAN(v string) bool {
 	return (atoi(d) % 97) == 1
 }
 
-// IC reports whether or not v is an Identity Card number.
-//
-// valid:rule.yaml
-//
-//	name: ic
-//	error: { text: "must be a valid identity card number" }
-func todo_IC(v string) bool {
-	// TODO
-	return false
-}
-
 var rxIMEI = regexp.MustCompile(`^[0-9]{15}$`)
 var rxIMEIHyphenated = regexp.MustCompile(`^\d{2}-\d{6}-\d{6}-\d{1}$`)
 
@@ -1125,6 +1159,33 @@ func ISO31661A(v string, num int) bool {
 	return false
 }
 
+// ISO31662 reports whether or not v is a valid country subdivision code as
+// defined by the ISO 3166-2 standard, e.g. "US-CA". If the country code cc is
+// not empty, then v is required to be a subdivision of that country, and in
+// that case v may also be specified without the country prefix, e.g. "CA".
+// The cc argument can be either an ISO 3166-1 Alpha-2 or Alpha-3 code.
+//
+// valid:rule.yaml
+//
+//	name: iso31662
+//	args: [{ default: "" }]
+//	error: { text: "must be a valid ISO 3166-2 subdivision code", with_field_args: true }
+func ISO31662(v string, cc string) bool {
+	v = strings.ToUpper(v)
+	if len(cc) > 0 {
+		c, ok := l10n.Get(cc)
+		if !ok {
+			return false
+		}
+		if !strings.HasPrefix(v, c.A2+"-") {
+			v = c.A2 + "-" + v
+		}
+	}
+
+	_, ok := tables.ISO31662[v]
+	return ok
+}
+
 // ISO4217 reports whether or not v is a valid currency code
 // as defined by the ISO 4217 standard.
 //
@@ -1142,6 +1203,206 @@ func ISO4217(v string) bool {
 	return false
 }
 
+// ISO8601 reports whether or not v is a valid ISO 8601 date, or date and time,
+// string. Calendar dates, e.g. "2021-03-14", week dates, e.g. "2021-W10-7", and
+// ordinal dates, e.g. "2021-073", in both the basic and the extended formats are
+// accepted, optionally followed by the time of day and a time zone designator.
+//
+// If strict is true, then the date is additionally required to exist, e.g.
+// "2021-02-29" is rejected, and the time must be separated from the date by
+// the "T" designator instead of a space.
+//
+// valid:rule.yaml
+//
+//	name: iso8601
+//	args:
+//	  - default: false
+//	    options: [{ value: true, alias: strict }]
+//	error: { text: "must be a valid ISO 8601 date" }
+func ISO8601(v string, strict bool) bool {
+	s := v
+	if len(s) > 0 && (s[0] == '+' || s[0] == '-') {
+		s = s[1:]
+	}
+
+	year, s, ok := isoNum(s, 4)
+	if !ok {
+		return false
+	}
+
+	// YYYYMM is not allowed since it could be confused with YYMMDD
+	if n := isoDigits(s); n == 2 && (len(s) == 2 || !isWordChar(s[2])) {
+		return false
+	}
+
+	// the date
+	var month, day, yday int
+	if len(s) > 0 && !isISOTimeSep(s[0]) {
+		sep := s[0] == '-'
+		if sep {
+			s = s[1:]
+		}
+
+		switch n := isoDigits(s); {
+		case len(s) > 0 && s[0] == 'W':
+			var week int
+			if week, s, ok = isoNum(s[1:], 2); !ok || week > 53 {
+				return false
+			}
+			if len(s) > 1 && s[0] == '-' && s[1] >= '1' && s[1] <= '7' {
+				s = s[2:]
+			} else if len(s) > 0 && s[0] >= '1' && s[0] <= '7' {
+				s = s[1:]
+			}
+		case n == 3:
+			yday, s, _ = isoNum(s, 3)
+			if yday < 1 || yday > 366 {
+				return false
+			}
+		case n == 2 || (n == 4 && !sep):
+			month, s, _ = isoNum(s, 2)
+			if month < 1 || month > 12 {
+				return false
+			}
+			hasDay := n == 4
+			if sep && len(s) > 0 && s[0] == '-' {
+				hasDay, s = true, s[1:]
+			}
+			if hasDay {
+				if day, s, ok = isoNum(s, 2); !ok || day < 1 || day > 31 {
+					return false
+				}
+			}
+		default:
+			return false
+		}
+	}
+
+	if strict {
+		if month > 0 && day > 0 {
+			t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+			if t.Day() != day {
+				return false
+			}
+		}
+		if yday == 366 && time.Date(year, 12, 31, 0, 0, 0, 0, time.UTC).YearDay() != 366 {
+			return false
+		}
+	}
+	if s == "" {
+		return true
+	}
+
+	// the time
+	if !isISOTimeSep(s[0]) || (strict && s[0] != 'T') {
+		return false
+	}
+	if s = s[1:]; s == "" {
+		return true
+	}
+
+	hour, s, ok := isoNum(s, 2)
+	if !ok || hour > 24 {
+		return false
+	}
+	colon, hasMin := false, false
+	if len(s) > 0 && s[0] == ':' {
+		colon, s = true, s[1:]
+	}
+	if min, rest, ok := isoNum(s, 2); ok {
+		if min > 59 || (hour == 24 && min != 0) {
+			return false
+		}
+		s, hasMin = rest, true
+	} else if colon || hour == 24 {
+		return false
+	}
+	if s, ok = isoFrac(s); !ok {
+		return false
+	}
+	if hasMin && len(s) > 0 && (s[0] == ':') == colon && (colon || isoDigits(s) >= 2) {
+		if colon {
+			s = s[1:]
+		}
+		sec, rest, ok := isoNum(s, 2)
+		if !ok || sec > 59 {
+			return false
+		}
+		if s, ok = isoFrac(rest); !ok {
+			return false
+		}
+	}
+
+	// the time zone designator
+	if len(s) > 0 && (s[0] == 'Z' || s[0] == 'z') {
+		s = s[1:]
+	} else if len(s) > 0 && (s[0] == '+' || s[0] == '-') {
+		hh, rest, ok := isoNum(s[1:], 2)
+		if !ok || hh > 23 {
+			return false
+		}
+		if s = rest; len(s) > 0 && s[0] == ':' {
+			s = s[1:]
+			if mm, rest, ok := isoNum(s, 2); !ok || mm > 59 {
+				return false
+			} else {
+				s = rest
+			}
+		} else if mm, rest, ok := isoNum(s, 2); ok {
+			if mm > 59 {
+				return false
+			}
+			s = rest
+		}
+	}
+	return s == ""
+}
+
+// isoNum parses the first n characters of s as a decimal number
+// and returns the number together with the rest of s.
+func isoNum(s string, n int) (num int, rest string, ok bool) {
+	if len(s) < n {
+		return 0, s, false
+	}
+	for i := 0; i < n; i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return 0, s, false
+		}
+		num = num*10 + int(s[i]-'0')
+	}
+	return num, s[n:], true
+}
+
+// isoDigits returns the number of leading decimal digits in s.
+func isoDigits(s string) (n int) {
+	for n < len(s) && s[n] >= '0' && s[n] <= '9' {
+		n++
+	}
+	return n
+}
+
+// isoFrac skips the decimal fraction, if any, at the beginning of s.
+// A fraction followed by a colon is not allowed.
+func isoFrac(s string) (string, bool) {
+	if len(s) > 1 && (s[0] == '.' || s[0] == ',') {
+		if n := isoDigits(s[1:]); n > 0 {
+			s = s[1+n:]
+			return s, !(len(s) > 0 && s[0] == ':')
+		}
+	}
+	return s, true
+}
+
+// isISOTimeSep reports whether or not c separates the date from the time.
+func isISOTimeSep(c byte) bool {
+	return c == 'T' || c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f' || c == '\v'
+}
+
+// isWordChar reports whether or not c is an ASCII letter, digit, or underscore.
+func isWordChar(c byte) bool {
+	return c == '_' || (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
 var rxISRC = regexp.MustCompile(`^[A-Z]{2}[0-9A-Z]{3}\d{2}\d{5}$`)
 
 // ISRC reports whether or not v is a valid International Standard Recording Code.
@@ -1187,6 +1448,20 @@ func ISSN(v string, requireHyphen, caseSensitive bool) bool {
 	return sum%11 == 0
 }
 
+// IdentityCard reports whether or not v is a valid identity card number
+// in the country identified by the given country code cc.
+//
+// valid:rule.yaml
+//
+//	name: ic
+//	error: { text: "must be a valid identity card number", with_field_args: true }
+func IdentityCard(v string, cc string) bool {
+	if c, ok := l10n.Get(cc); ok && c.IdentityCard != nil {
+		return c.IdentityCard.MatchString(v)
+	}
+	return false
+}
+
 // In reports whether or not v is in the provided list.
 //
 // valid:rule.yaml
@@ -1479,14 +1754,20 @@ func PAN(v string) bool {
 	return algo.Luhn(v)
 }
 
-// PassportNumber reports whether or not v is a valid passport number.
+// PassportNumber reports whether or not v is a valid passport number in
+// the country identified by the given country code cc. Whitespace in v is
+// ignored and letters are matched case-insensitively.
 //
 // valid:rule.yaml
 //
 //	name: passport
-//	error: { text: "must be a valid passport number" }
-func todo_PassportNumber(v string) bool {
-	// TODO
+//	args: [{ default: us }]
+//	error: { text: "must be a valid passport number", with_field_args: true }
+func PassportNumber(v string, cc string) bool {
+	if c, ok := l10n.Get(cc); ok && c.Passport != nil {
+		v = rmchar(v, unicode.IsSpace)
+		return c.Passport.MatchString(strings.ToUpper(v))
+	}
 	return false
 }
 
@@ -1497,7 +1778,7 @@ func todo_PassportNumber(v string) bool {
 //
 //	name: phone
 //	args: [{ default: us }]
-//	error: { text: "must be a valid phone number" }
+//	error: { text: "must be a valid phone number", with_field_args: true }
 func Phone(v string, cc string) bool {
 	if c, ok := l10n.Get(cc); ok && c.Phone != nil {
 		return c.Phone.MatchString(v)
@@ -1505,6 +1786,22 @@ func Phone(v string, cc string) bool {
 	return false
 }
 
+// PhoneE164 returns the phone number v in E.164 format. If v is not in
+// international format it is interpreted as a national number of the country
+// identified by the given country code cc. If v cannot be parsed as a phone
+// number it is returned unchanged. See ParsePhone for more details.
+//
+// valid:rule.yaml
+//
+//	name: "pre:e164"
+//	args: [{ default: us }]
+func PhoneE164(v string, cc string) string {
+	if num, err := ParsePhone(v, cc); err == nil {
+		return num.E164
+	}
+	return v
+}
+
 // Port reports whether or not v is a valid port number.
 //
 // valid:rule.yaml
@@ -1516,6 +1813,19 @@ func Port(v string) bool {
 	return err == nil
 }
 
+var rxRFC3339 = regexp.MustCompile(`^\d{4}-(?:0[1-9]|1[0-2])-(?:[12]\d|0[1-9]|3[01])[ tT]` +
+	`(?:[01]\d|2[0-3]):[0-5]\d:(?:[0-5]\d|60)(?:\.\d+)?(?:[zZ]|[\+-](?:[01]\d|2[0-3]):[0-5]\d)$`)
+
+// RFC3339 reports whether or not v is a valid RFC 3339 date and time string.
+//
+// valid:rule.yaml
+//
+//	name: rfc3339
+//	error: { text: "must be a valid RFC 3339 date" }
+func RFC3339(v string) bool {
+	return rxRFC3339.MatchString(v)
+}
+
 var rxRGB = regexp.MustCompile(`^rgb\((?:(?:[0-9]|[1-9][0-9]|1[0-9][0-9]|2[0-4][0-9]|25[0-5]),){2}(?:[0-9]|[1-9][0-9]|1[0-9][0-9]|2[0-4][0-9]|25[0-5])\)$`)
 var rxRGBA = regexp.MustCompile(`^rgba\((?:(?:[0-9]|[1-9][0-9]|1[0-9][0-9]|2[0-4][0-9]|25[0-5]),){3}(?:0?\.\d|1(?:\.0)?|0(?:\.0)?)\)$`)
 var rxRGBPercent = regexp.MustCompile(`^rgb\((?:(?:[0-9]%|[1-9][0-9]%|100%),){2}(?:[0-9]%|[1-9][0-9]%|100%)\)`)
@@ -1662,15 +1972,180 @@ func StrongPassword(v string, opts *StrongPasswordOpts) bool {
 		num >= opts.MinNumbers && sym >= opts.MinSymbols
 }
 
+// TimeZone reports whether or not v is a valid IANA time zone name, e.g.
+// "Europe/Berlin". The names are checked against an embedded copy of the
+// time zone database's names, therefore the result does not depend on the
+// time zone database installed on the host system.
+//
+// valid:rule.yaml
+//
+//	name: timezone
+//	error: { text: "must be a valid time zone" }
+func TimeZone(v string) bool {
+	_, ok := tables.TimeZone[v]
+	return ok
+}
+
+type URLOpts struct {
+	// The list of accepted protocols. If empty any protocol is accepted.
+	Protocols []string
+	// If set, the URL must start with a protocol.
+	RequireProtocol bool
+	// If set, the URL must have a host.
+	RequireHost bool
+	// If set, the URL must have a port.
+	RequirePort bool
+	// If set, the host must have a top-level domain.
+	RequireTLD bool
+	// If set, the host may be an IPv4 or an IPv6 address.
+	AllowIPHost bool
+	// If set, the host may contain underscores.
+	AllowUnderscores bool
+	// If set, the host may end with a dot.
+	AllowTrailingDot bool
+	// If set, the URL may be protocol relative, e.g. "//example.com".
+	AllowProtocolRelative bool
+	// If set, the URL may contain user info, e.g. "user:pass@".
+	AllowUserInfo bool
+	// If set, the URL may contain a query.
+	AllowQuery bool
+	// If set, the URL may contain a fragment.
+	AllowFragment bool
+	// The maximum length of the URL. If 0 the length is not checked.
+	MaxLength int
+}
+
+var URLOptsDefault = URLOpts{
+	Protocols:     []string{"http", "https", "ftp"},
+	RequireHost:   true,
+	RequireTLD:    true,
+	AllowIPHost:   true,
+	AllowUserInfo: true,
+	AllowQuery:    true,
+	AllowFragment: true,
+	MaxLength:     2084,
+}
+
+var rxURLWrappedIPv6 = regexp.MustCompile(`^\[([^\]]+)\](?::([0-9]+))?$`)
+
 // URL reports whether or not v is a valid Uniform Resource Locator.
 //
+// The opts argument can be used to tweak the validation, if opts is
+// nil then URLOptsDefault will be used.
+//
 // valid:rule.yaml
 //
 //	name: url
+//	args: [{ default: null }]
 //	error: { text: "must be a valid URL" }
-func todo_URL(v string) bool {
-	// TODO consider implementing a parser, maybe port https://github.com/servo/rust-url to Go
-	return false
+func URL(v string, opts *URLOpts) bool {
+	if opts == nil {
+		opts = &URLOptsDefault
+	}
+
+	if len(v) == 0 || strings.ContainsAny(v, " \t\n\v\f\r<>") || strings.HasPrefix(v, "mailto:") {
+		return false
+	}
+	if opts.MaxLength > 0 && len(v) > opts.MaxLength {
+		return false
+	}
+	if !opts.AllowFragment && strings.ContainsRune(v, '#') {
+		return false
+	}
+	if !opts.AllowQuery && strings.ContainsAny(v, "?&") {
+		return false
+	}
+
+	// drop fragment & query
+	if i := strings.IndexByte(v, '#'); i > -1 {
+		v = v[:i]
+	}
+	if i := strings.IndexByte(v, '?'); i > -1 {
+		v = v[:i]
+	}
+
+	// protocol
+	if i := strings.Index(v, "://"); i > -1 {
+		if len(opts.Protocols) > 0 {
+			proto := strings.ToLower(v[:i])
+			found := false
+			for _, p := range opts.Protocols {
+				if proto == p {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		v = v[i+3:]
+	} else if opts.RequireProtocol {
+		return false
+	} else if strings.HasPrefix(v, "//") {
+		if !opts.AllowProtocolRelative {
+			return false
+		}
+		v = v[2:]
+	}
+	if len(v) == 0 {
+		return false
+	}
+
+	// drop path
+	if i := strings.IndexByte(v, '/'); i > -1 {
+		v = v[:i]
+	}
+	if len(v) == 0 && !opts.RequireHost {
+		return true
+	}
+
+	// user info
+	if i := strings.IndexByte(v, '@'); i > -1 {
+		if !opts.AllowUserInfo || i == 0 {
+			return false
+		}
+		info := strings.Split(v[:i], ":")
+		if len(info) > 2 || (len(info) == 2 && len(info[0]) == 0 && len(info[1]) == 0) {
+			return false
+		}
+		v = v[i+1:]
+	}
+
+	// host & port
+	var host, ipv6, port string
+	if m := rxURLWrappedIPv6.FindStringSubmatch(v); m != nil {
+		ipv6, port = m[1], m[2]
+	} else if i := strings.IndexByte(v, ':'); i > -1 {
+		host, port = v[:i], v[i+1:]
+		if len(port) == 0 {
+			return false
+		}
+	} else {
+		host = v
+	}
+
+	if len(port) > 0 {
+		if !rxDigits.MatchString(port) {
+			return false
+		}
+		if p, err := strconv.Atoi(port); err != nil || p <= 0 || p > 65535 {
+			return false
+		}
+	} else if opts.RequirePort {
+		return false
+	}
+
+	if len(ipv6) > 0 {
+		return opts.AllowIPHost && IP(ipv6, 6)
+	}
+	if len(host) == 0 {
+		return !opts.RequireHost
+	}
+	if IP(host, 4) {
+		return opts.AllowIPHost
+	}
+	return fqdn(host, opts.RequireTLD, opts.AllowUnderscores, opts.AllowTrailingDot)
 }
 
 var rxUUIDv3 = regexp.MustCompile(`^(?i)[0-9A-F]{8}-[0-9A-F]{4}-3[0-9A-F]{3}-[0-9A-F]{4}-[0-9A-F]{12}$`)
@@ -1731,7 +2206,7 @@ func UpperCase(v string) bool {
 //
 //	name: vat
 //	args: [{ default: us }]
-//	error: { text: "must be a valid VAT number" }
+//	error: { text: "must be a valid VAT number", with_field_args: true }
 func VAT(v string, cc string) bool {
 	if c, ok := l10n.Get(cc); ok && c.VAT != nil {
 		return c.VAT.MatchString(v)
@@ -1746,7 +2221,7 @@ func VAT(v string, cc string) bool {
 //
 //	name: zip
 //	args: [{ default: us }]
-//	error: { text: "must be a valid zip code" }
+//	error: { text: "must be a valid zip code", with_field_args: true }
 func Zip(v string, cc string) bool {
 	if c, ok := l10n.Get(cc); ok && c.Zip != nil {
 		return c.Zip.MatchString(v)